@@ -29,11 +29,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/api"
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/grpcapi"
 	"github.com/banzaicloud/cloudinfo/internal/platform/buildinfo"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/alibaba"
@@ -41,6 +44,7 @@ import (
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/azure"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/google"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/oracle"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 	"github.com/banzaicloud/go-gin-prometheus"
 	"github.com/gin-gonic/gin"
@@ -56,6 +60,7 @@ const (
 	logLevelFlag               = "log-level"
 	logFormatFlag              = "log-format"
 	listenAddressFlag          = "listen-address"
+	grpcListenAddressFlag      = "grpc-listen-address"
 	prodInfRenewalIntervalFlag = "product-info-renewal-interval"
 	prometheusAddressFlag      = "prometheus-address"
 	prometheusQueryFlag        = "prometheus-query"
@@ -63,6 +68,11 @@ const (
 	helpFlag                   = "help"
 	metricsEnabledFlag         = "metrics-enabled"
 	metricsAddressFlag         = "metrics-address"
+	providerHttpModeFlag       = "provider-http-mode"
+	providerHttpFixturesFlag   = "provider-http-fixtures"
+	exchangeRatesFileFlag      = "exchange-rates-file"
+	networkCategoriesFileFlag  = "network-categories-file"
+	spotHistoryWindowFlag      = "spot-history-window"
 
 	//temporary flags
 	gceApiKeyFlag          = "gce-api-key"
@@ -91,17 +101,23 @@ func defineFlags() {
 	flag.String(logLevelFlag, "info", "log level")
 	flag.String(logFormatFlag, "", "log format")
 	flag.String(listenAddressFlag, ":9090", "the address the cloudinfo app listens to HTTP requests.")
+	flag.String(grpcListenAddressFlag, ":9092", "the address the cloudinfo app listens to gRPC requests. The gRPC API is disabled if empty")
 	flag.Duration(prodInfRenewalIntervalFlag, 24*time.Hour, "duration (in go syntax) between renewing the product information. Example: 2h30m")
 	flag.String(prometheusAddressFlag, "", "http address of a Prometheus instance that has AWS spot "+
 		"price metrics via banzaicloud/spot-price-exporter. If empty, the cloudinfo app will use current spot prices queried directly from the AWS API.")
 	flag.String(prometheusQueryFlag, "avg_over_time(aws_spot_current_price{region=\"%s\", product_description=\"Linux/UNIX\"}[1w])",
 		"advanced configuration: change the query used to query spot price info from Prometheus.")
+	flag.Duration(spotHistoryWindowFlag, 24*time.Hour, "the look-back window of the Amazon and Alibaba spot price statistics, must be 0 with a Prometheus address. Only the current spot prices are queried if 0")
 	flag.String(gceApiKeyFlag, "", "GCE API key to use for getting SKUs")
 	flag.String(gceApplicationCred, "", "google application credentials location")
 	flag.StringSlice(providerFlag, []string{Amazon, Google, Azure, Oracle, Alibaba}, "Providers that will be used with the cloudinfo application.")
 	flag.Bool(helpFlag, false, "print usage")
 	flag.Bool(metricsEnabledFlag, false, "internal metrics are exposed if enabled")
 	flag.String(metricsAddressFlag, ":9900", "the address where internal metrics are exposed")
+	flag.String(providerHttpModeFlag, "", "record the HTTP traffic of the providers into fixtures, or replay it from fixtures (record|replay). Disabled if empty")
+	flag.String(providerHttpFixturesFlag, "fixtures", "the directory of the recorded HTTP fixtures, with a subdirectory per provider")
+	flag.String(exchangeRatesFileFlag, "", "json file holding the exchange rates the prices can be converted with. Only USD prices are served if empty")
+	flag.String(networkCategoriesFileFlag, "", "json file holding the lower bandwidth bounds (Gbps) of the network performance categories. The defaults are used if empty")
 	flag.String(azureAuthLocation, "", "azure authentication file location")
 	flag.String(alibabaRegionId, "", "alibaba region id")
 	flag.String(alibabaAccessKeyId, "", "alibaba access key id")
@@ -156,8 +172,11 @@ func main() {
 
 	logger.Extract(ctx).WithField("version", Version).WithField("commit_hash", CommitHash).WithField("build_date", BuildDate).Info("cloudinfo initialization")
 
+	networkCategories, err := cloudinfo.NewNetworkCategories(viper.GetString(networkCategoriesFileFlag))
+	quitOnError(ctx, "error encountered", err)
+
 	prodInfo, err := cloudinfo.NewCachingCloudInfo(viper.GetDuration(prodInfRenewalIntervalFlag),
-		cache.New(cache.NoExpiration, 24.*time.Hour), infoers(ctx))
+		cache.New(cache.NoExpiration, 24.*time.Hour), infoers(ctx), networkCategories)
 	quitOnError(ctx, "error encountered", err)
 
 	go prodInfo.Start(ctx)
@@ -168,8 +187,18 @@ func main() {
 	err = api.ConfigureValidator(ctx, viper.GetStringSlice(providerFlag), prodInfo)
 	quitOnError(ctx, "error encountered", err)
 
+	exchangeRates, err := cloudinfo.NewStaticExchangeRates(viper.GetString(exchangeRatesFileFlag))
+	quitOnError(ctx, "error encountered", err)
+
+	if address := viper.GetString(grpcListenAddressFlag); address != "" {
+		grpcServer := grpcapi.NewServer(prodInfo, exchangeRates)
+		go func() {
+			quitOnError(ctx, "could not serve the grpc api", grpcServer.ListenAndServe(ctx, address))
+		}()
+	}
+
 	buildInfo := buildinfo.New(Version, CommitHash, BuildDate)
-	routeHandler := api.NewRouteHandler(prodInfo, buildInfo)
+	routeHandler := api.NewRouteHandler(prodInfo, exchangeRates, buildInfo)
 
 	// new default gin engine (recovery, logger middleware)
 	router := gin.Default()
@@ -203,17 +232,20 @@ func infoers(ctx context.Context) map[string]cloudinfo.CloudInfoer {
 		var err error
 		pctx := logger.ToContext(ctx, logger.NewLogCtxBuilder().WithProvider(p).Build())
 
+		transport, err := providerTransport(p)
+		quitOnError(pctx, "could not initialize the HTTP recorder", err)
+
 		switch p {
 		case Amazon:
-			infoer, err = amazon.NewEc2Infoer(pctx, viper.GetString(prometheusAddressFlag), viper.GetString(prometheusQueryFlag))
+			infoer, err = amazon.NewEc2Infoer(pctx, viper.GetString(prometheusAddressFlag), viper.GetString(prometheusQueryFlag), viper.GetDuration(spotHistoryWindowFlag), transport)
 		case Google:
-			infoer, err = google.NewGceInfoer(viper.GetString(gceApplicationCred), viper.GetString(gceApiKeyFlag))
+			infoer, err = google.NewGceInfoer(viper.GetString(gceApplicationCred), viper.GetString(gceApiKeyFlag), transport)
 		case Azure:
-			infoer, err = azure.NewAzureInfoer(viper.GetString(azureAuthLocation))
+			infoer, err = azure.NewAzureInfoer(viper.GetString(azureAuthLocation), transport)
 		case Oracle:
-			infoer, err = oracle.NewInfoer(viper.GetString(oracleConfigLocation))
+			infoer, err = oracle.NewInfoer(viper.GetString(oracleConfigLocation), transport)
 		case Alibaba:
-			infoer, err = alibaba.NewAlibabaInfoer(viper.GetString(alibabaRegionId), viper.GetString(alibabaAccessKeyId), viper.GetString(alibabaAccessKeySecret))
+			infoer, err = alibaba.NewAlibabaInfoer(viper.GetString(alibabaRegionId), viper.GetString(alibabaAccessKeyId), viper.GetString(alibabaAccessKeySecret), viper.GetDuration(spotHistoryWindowFlag), transport)
 		default:
 			logger.Extract(pctx).Fatal("provider is not supported")
 		}
@@ -226,6 +258,22 @@ func infoers(ctx context.Context) map[string]cloudinfo.CloudInfoer {
 	return infoers
 }
 
+// providerTransport creates the HTTP transport of the provider; nil if recording / replaying is disabled
+func providerTransport(provider string) (http.RoundTripper, error) {
+	if viper.GetString(providerHttpModeFlag) == "" {
+		return nil, nil
+	}
+	mode, err := recorder.ParseMode(viper.GetString(providerHttpModeFlag))
+	if err != nil {
+		return nil, err
+	}
+	transport, err := recorder.NewTransport(mode, filepath.Join(viper.GetString(providerHttpFixturesFlag), provider), nil)
+	if err != nil {
+		return nil, err
+	}
+	return transport, nil
+}
+
 func quitOnError(ctx context.Context, msg string, err error) {
 	if err != nil {
 		logger.Extract(ctx).WithError(err).Error(msg)
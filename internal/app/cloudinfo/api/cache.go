@@ -0,0 +1,162 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// CacheHandler is a gin middleware that makes the successful responses of the provider related GET requests cacheable
+// The ETag is derived from the scrape generation of the provider and the content of the response, Last-Modified is the
+// last change of the cached information of the provider, and the max-age of Cache-Control lasts until its next
+// scheduled renewal. Conditional requests (If-None-Match or If-Modified-Since) are answered with 304 Not Modified.
+func CacheHandler(ctx context.Context, cpi *cloudinfo.CachingCloudInfo) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		provider := c.Param(providerParam)
+		if provider == "" || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		writer := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		c.Writer = writer.ResponseWriter
+
+		if len(c.Errors) > 0 {
+			// the errors are rendered by the error handlers
+			return
+		}
+		generation, err := cpi.GetStatus(provider)
+		if writer.Status() != http.StatusOK || err != nil {
+			writer.flush()
+			return
+		}
+
+		header := c.Writer.Header()
+		etag := fmt.Sprintf(`"%s-%x"`, generation, writer.hash())
+		header.Set("ETag", etag)
+		lastModified, err := cpi.LastModified(provider)
+		if err == nil {
+			header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+		now := time.Now()
+		if next := cpi.NextRenewal(provider, now); next.After(now) {
+			header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(math.Ceil(next.Sub(now).Seconds()))))
+		} else {
+			header.Set("Cache-Control", "no-cache")
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+				WithCorrelationId(logger.GetCorrelationId(c)).
+				WithProvider(provider).
+				Build())
+			logger.Extract(ctxLog).Debug("resource not modified")
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		writer.flush()
+	}
+}
+
+// notModified evaluates the conditional headers of the request, If-Modified-Since is ignored if If-None-Match is present
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			// weak comparison
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+	if ifModifiedSince := r.Header.Get("If-Modified-Since"); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		// the Last-Modified header is given in seconds
+		return err == nil && !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// bufferedWriter holds the response of the handlers until its cache headers are set
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.status != 0 || w.body.Len() > 0
+}
+
+// hash returns the hash of the buffered response body
+func (w *bufferedWriter) hash() uint64 {
+	h := fnv.New64a()
+	h.Write(w.body.Bytes())
+	return h.Sum64()
+}
+
+// flush writes the buffered response to the underlying writer
+func (w *bufferedWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.Status())
+	w.ResponseWriter.WriteHeaderNow()
+	if w.body.Len() > 0 {
+		w.ResponseWriter.Write(w.body.Bytes())
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// ErrorHandler is a gin middleware that renders the errors registered by the handlers (through gin.Context.Error)
+// Typed errors of the cloudinfo package are mapped to their HTTP status code and stable error code
+func ErrorHandler(ctx context.Context) gin.HandlerFunc {
+	return renderErrors(ctx, func(c *gin.Context, status int, err error) {
+		c.JSON(status, NewErrorResponse(cloudinfo.ErrorCode(err), err.Error()))
+	})
+}
+
+// EnvelopeErrorHandler is a gin middleware that renders the errors registered by the v2 handlers in the response envelope
+// It takes precedence over the ErrorHandler middleware as the response is already written when the latter runs
+func EnvelopeErrorHandler(ctx context.Context) gin.HandlerFunc {
+	return renderErrors(ctx, func(c *gin.Context, status int, err error) {
+		c.JSON(status, ErrorEnvelope{
+			Errors: []ErrorResponse{NewErrorResponse(cloudinfo.ErrorCode(err), err.Error())},
+		})
+	})
+}
+
+// renderErrors creates a middleware rendering the last error registered by the handlers with the render function
+func renderErrors(ctx context.Context, render func(c *gin.Context, status int, err error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		status := errorStatus(err)
+
+		logger.Extract(ctx).WithField("correlation-id", logger.GetCorrelationId(c)).WithError(err).
+			Debugf("request failed with status: %d", status)
+
+		if e, ok := err.(cloudinfo.NotYetAvailableError); ok {
+			c.Header("Retry-After", fmt.Sprintf("%d", int(math.Ceil(e.RetryAfter.Seconds()))))
+		}
+
+		render(c, status, err)
+	}
+}
+
+// errorStatus maps the error to the HTTP status code of the response
+func errorStatus(err error) int {
+	switch err.(type) {
+	case cloudinfo.NotFoundError:
+		return http.StatusNotFound
+	case cloudinfo.NotYetAvailableError:
+		return http.StatusServiceUnavailable
+	case cloudinfo.ProviderUnavailableError:
+		return http.StatusBadGateway
+	case cloudinfo.InvalidArgumentError:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
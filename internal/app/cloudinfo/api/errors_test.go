@@ -0,0 +1,154 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// newErrorRouter creates a gin engine with the given error middleware serving a handler failing with the given errors
+func newErrorRouter(middleware gin.HandlerFunc, errs ...error) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware)
+	router.GET("/fail", func(c *gin.Context) {
+		for _, err := range errs {
+			c.Error(err)
+		}
+	})
+	router.GET("/written", func(c *gin.Context) {
+		c.Error(cloudinfo.NewNotFoundError("not found"))
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+	return router
+}
+
+// errorCases are the errors the middlewares are tested with
+var errorCases = []struct {
+	name       string
+	err        error
+	status     int
+	code       string
+	retryAfter string
+}{
+	{
+		name:   "not found",
+		err:    cloudinfo.NewNotFoundError("unknown instance type: [%s]", "medium"),
+		status: http.StatusNotFound,
+		code:   cloudinfo.ErrCodeNotFound,
+	},
+	{
+		name:       "not yet available",
+		err:        cloudinfo.NewNotYetAvailableError("products are not yet available: [%s]", "region-2"),
+		status:     http.StatusServiceUnavailable,
+		code:       cloudinfo.ErrCodeNotYetAvailable,
+		retryAfter: "60",
+	},
+	{
+		name:       "not yet available with a custom retry hint",
+		err:        cloudinfo.NotYetAvailableError{RetryAfter: 5 * time.Minute},
+		status:     http.StatusServiceUnavailable,
+		code:       cloudinfo.ErrCodeNotYetAvailable,
+		retryAfter: "300",
+	},
+	{
+		name:       "not yet available with a retry hint rounded up to seconds",
+		err:        cloudinfo.NotYetAvailableError{RetryAfter: 90*time.Second + time.Millisecond},
+		status:     http.StatusServiceUnavailable,
+		code:       cloudinfo.ErrCodeNotYetAvailable,
+		retryAfter: "91",
+	},
+	{
+		name:   "provider unavailable",
+		err:    cloudinfo.NewProviderUnavailableError("dummy", errors.New("connection refused")),
+		status: http.StatusBadGateway,
+		code:   cloudinfo.ErrCodeProviderUnavailable,
+	},
+	{
+		name:   "invalid argument",
+		err:    cloudinfo.NewInvalidArgumentError("invalid limit: [%s]", "x"),
+		status: http.StatusBadRequest,
+		code:   cloudinfo.ErrCodeInvalidArgument,
+	},
+	{
+		name:   "untyped error",
+		err:    errors.New("something went wrong"),
+		status: http.StatusInternalServerError,
+		code:   cloudinfo.ErrCodeInternal,
+	},
+}
+
+func TestErrorHandler(t *testing.T) {
+	for _, test := range errorCases {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(newErrorRouter(ErrorHandler(context.Background()), test.err), http.MethodGet, "/fail", nil)
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.retryAfter, w.Header().Get("Retry-After"))
+
+			var response ErrorResponse
+			assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, NewErrorResponse(test.code, test.err.Error()), response)
+		})
+	}
+}
+
+func TestEnvelopeErrorHandler(t *testing.T) {
+	for _, test := range errorCases {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(newErrorRouter(EnvelopeErrorHandler(context.Background()), test.err), http.MethodGet, "/fail", nil)
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.retryAfter, w.Header().Get("Retry-After"))
+
+			envelope := decodeEnvelope(t, w.Body.Bytes())
+			assert.Equal(t, "null", string(envelope.Data))
+			assert.Equal(t, []ErrorResponse{NewErrorResponse(test.code, test.err.Error())}, envelope.Errors)
+		})
+	}
+}
+
+func TestErrorHandler_lastError(t *testing.T) {
+	router := newErrorRouter(ErrorHandler(context.Background()),
+		cloudinfo.NewNotFoundError("not found"), cloudinfo.NewInvalidArgumentError("invalid argument"))
+
+	w := serve(router, http.MethodGet, "/fail", nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "the last error should be rendered")
+}
+
+func TestErrorHandler_written(t *testing.T) {
+	for name, middleware := range map[string]gin.HandlerFunc{
+		"error handler":          ErrorHandler(context.Background()),
+		"envelope error handler": EnvelopeErrorHandler(context.Background()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := serve(newErrorRouter(middleware), http.MethodGet, "/written", nil)
+			assert.Equal(t, http.StatusOK, w.Code, "the written response should be left untouched")
+			assert.JSONEq(t, `{"status": "ok"}`, w.Body.String())
+
+			w = serve(newErrorRouter(middleware), http.MethodGet, "/fail", nil)
+			assert.Equal(t, http.StatusOK, w.Code, "a request without errors should be left untouched")
+			assert.Empty(t, w.Body.String())
+		})
+	}
+}
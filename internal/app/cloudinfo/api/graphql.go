@@ -0,0 +1,261 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// graphqlMaxDepth limits the nesting of the queries, the deepest field of the schema is at depth 7
+const graphqlMaxDepth = 8
+
+// graphqlSchema describes the providers, services, regions and products served by the GraphQL endpoint
+// Only the selected fields are resolved, so a query pays only for the data it asks for
+const graphqlSchema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# the supported providers, all of them if no names are given
+	providers(names: [String!]): [Provider!]!
+	provider(name: String!): Provider
+}
+
+type Provider {
+	name: String!
+	# the services of the provider, all of them if no names are given
+	services(names: [String!]): [Service!]!
+	service(name: String!): Service
+}
+
+type Service {
+	name: String!
+	# the fee of the managed kubernetes control plane, null if the service has no control plane
+	controlPlane(currency: String): ControlPlaneFee
+	# the regions of the service, all of them if no ids are given
+	regions(ids: [String!], geography: String): [Region!]!
+	region(id: String!): Region
+}
+
+type ControlPlaneFee {
+	model: String!
+	pricePerHour: Float!
+	freeClusters: Int!
+	currency: String!
+}
+
+type Region {
+	id: String!
+	name: String!
+	geography: String!
+	zones: [String!]!
+	# a page of the products of the region; the filter, sort and page arguments work as the query parameters of the
+	# products endpoint: the nextCursor of a page is to be passed as the cursor of the following page
+	products(filter: ProductFilter, currency: String, sort: String, order: String, limit: Int, cursor: String): ProductPage!
+	images: [String!]!
+	versions: [String!]!
+}
+
+input ProductFilter {
+	types: [String!]
+	category: String
+	family: String
+	generation: Int
+	size: String
+	burst: Boolean
+	minBandwidth: Float
+	# resource bounds are quantities, eg.: 2, 500m, 16Gi
+	minCpu: String
+	maxCpu: String
+	minMem: String
+	maxMem: String
+	minGpu: String
+	maxGpu: String
+	networkCategory: String
+	currentGen: Boolean
+	zone: String
+	os: String
+	pricingModel: String
+	paymentOption: String
+	maxPrice: Float
+	maxSpotPrice: Float
+}
+
+type ProductPage {
+	items: [Product!]!
+	# the cursor of the next page, null on the last page
+	nextCursor: String
+}
+
+type Product {
+	type: String!
+	category: String!
+	family: String!
+	generation: Int!
+	size: String!
+	cpus: Float!
+	cpuCores: Float!
+	mem: Float!
+	gpus: Float!
+	gpuModel: String!
+	gpuMem: Float!
+	arch: String!
+	processorFamily: String!
+	ntwPerf: String!
+	ntwPerfCategory: String!
+	bandwidth: Float!
+	bandwidthUpTo: Boolean!
+	zones: [String!]!
+	currentGen: Boolean!
+	burst: Boolean!
+	burstInfo: BurstInfo
+	localDisks: Int!
+	localDiskSize: Float!
+	localDiskType: String!
+	premiumStorage: Boolean!
+	maxNics: Int!
+	hypervisor: String!
+	attributes: [Attribute!]!
+	currency: String!
+	onDemandPrice: Float!
+	spotPrices: [ZonePrice!]!
+	spotStats: [ZoneSpotStats!]!
+	osPrices: [OsPrice!]!
+	commitments: [Commitment!]!
+}
+
+type BurstInfo {
+	baselineCpuPercent: Float!
+	creditsPerHour: Float!
+	unlimited: Boolean!
+}
+
+type Attribute {
+	key: String!
+	value: String!
+}
+
+type ZonePrice {
+	zone: String!
+	price: Float!
+}
+
+type ZoneSpotStats {
+	zone: String!
+	min: Float!
+	max: Float!
+	mean: Float!
+	p50: Float!
+	p90: Float!
+	volatility: Float!
+	samples: Int!
+}
+
+type OsPrice {
+	os: String!
+	price: Float!
+}
+
+type Commitment {
+	term: String!
+	paymentOption: String!
+	upfront: Float!
+	hourly: Float!
+	effectiveHourly: Float!
+}
+`
+
+// graphqlError exposes the error code of the cloudinfo errors in the extensions of the GraphQL errors
+type graphqlError struct {
+	error
+}
+
+// Extensions returns the additional fields of the GraphQL error
+func (e graphqlError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": cloudinfo.ErrorCode(e.error)}
+}
+
+// resolverError wraps the errors returned by the resolvers
+func resolverError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return graphqlError{err}
+}
+
+// newGraphQLSchema parses the GraphQL schema with the resolvers backed by the route handler
+func (r *RouteHandler) newGraphQLSchema() *graphql.Schema {
+	return graphql.MustParseSchema(graphqlSchema, &queryResolver{r: r}, graphql.MaxDepth(graphqlMaxDepth))
+}
+
+// swagger:route POST /graphql graphql queryGraphQL
+//
+// Executes a GraphQL query over the providers, services, regions and products.
+// The query can be sent as well in the query, operationName and variables query parameters of a GET request.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: GraphQLResponse
+//       400: ErrorResponse
+func (r *RouteHandler) queryGraphQL(ctx context.Context) gin.HandlerFunc {
+	schema := r.newGraphQLSchema()
+
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("executing graphql query")
+
+		var req GraphQLRequest
+		if c.Request.Method == http.MethodGet {
+			req.Query = c.Query("query")
+			req.OperationName = c.Query("operationName")
+			if variables := c.Query("variables"); variables != "" {
+				if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+					c.Error(cloudinfo.NewInvalidArgumentError("invalid variables: %s", err))
+					return
+				}
+			}
+			if req.Query == "" {
+				c.Error(cloudinfo.NewInvalidArgumentError("missing query"))
+				return
+			}
+		} else if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		response := schema.Exec(ctxLog, req.Query, req.OperationName, req.Variables)
+
+		log.Debugf("graphql query executed with %d errors", len(response.Errors))
+		c.JSON(http.StatusOK, GraphQLResponse{Data: response.Data, Errors: response.Errors})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// queryGraphQL posts the query to the GraphQL endpoint and decodes the response
+func queryGraphQL(t *testing.T, router http.Handler, query string, variables map[string]interface{}) GraphQLResponse {
+	body, err := json.Marshal(GraphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := serve(router, http.MethodPost, "/api/v1/graphql", bytes.NewReader(body))
+	assert.Equal(t, http.StatusOK, w.Code, "the queries should be answered with 200")
+
+	var response GraphQLResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("could not decode the response: %s", err)
+	}
+	return response
+}
+
+func TestRouteHandler_queryGraphQL(t *testing.T) {
+	router := newTestRouter(t)
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		data      string
+	}{
+		{
+			name:  "providers",
+			query: `{ providers { name services { name } } }`,
+			data:  `{"providers":[{"name":"broken","services":[]},{"name":"dummy","services":[{"name":"compute"}]}]}`,
+		},
+		{
+			name:  "providers by name",
+			query: `{ providers(names: ["dummy"]) { name } }`,
+			data:  `{"providers":[{"name":"dummy"}]}`,
+		},
+		{
+			name:  "regions",
+			query: `{ provider(name: "dummy") { service(name: "compute") { regions { id name zones } } } }`,
+			data: `{"provider":{"service":{"regions":[` +
+				`{"id":"region-1","name":"Region 1","zones":["region-1a","region-1b"]},` +
+				`{"id":"region-2","name":"Region 2","zones":["region-2a","region-2b"]}]}}}`,
+		},
+		{
+			name: "products",
+			query: `query products($region: String!) {
+				provider(name: "dummy") { service(name: "compute") { region(id: $region) {
+					products(sort: "price", order: "desc") { items { type cpus onDemandPrice spotPrices { zone price } } nextCursor }
+				} } }
+			}`,
+			variables: map[string]interface{}{"region": testRegion},
+			data: `{"provider":{"service":{"region":{"products":{"items":[` +
+				`{"type":"large","cpus":8,"onDemandPrice":0.4,"spotPrices":[{"zone":"region-1a","price":0.12}]},` +
+				`{"type":"small","cpus":2,"onDemandPrice":0.1,"spotPrices":[]}],"nextCursor":null}}}}}`,
+		},
+		{
+			name: "filtered products",
+			query: `{ provider(name: "dummy") { service(name: "compute") { region(id: "region-1") {
+				products(filter: {types: ["small", "large"], maxCpu: "4", maxPrice: 0.1}, currency: "EUR", limit: 1) { items { type currency onDemandPrice } }
+			} } } }`,
+			data: `{"provider":{"service":{"region":{"products":{"items":[{"type":"small","currency":"EUR","onDemandPrice":0.05}]}}}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := queryGraphQL(t, router, test.query, test.variables)
+			assert.Empty(t, response.Errors, "the errors should be empty")
+			assert.JSONEq(t, test.data, string(response.Data))
+		})
+	}
+}
+
+func TestRouteHandler_queryGraphQLProductPages(t *testing.T) {
+	router := newTestRouter(t)
+	query := `query products($cursor: String) {
+		provider(name: "dummy") { service(name: "compute") { region(id: "region-1") {
+			products(sort: "price", limit: 1, cursor: $cursor) { items { type } nextCursor }
+		} } }
+	}`
+	var data struct {
+		Provider struct {
+			Service struct {
+				Region struct {
+					Products struct {
+						Items []struct {
+							Type string
+						}
+						NextCursor *string
+					}
+				}
+			}
+		}
+	}
+
+	response := queryGraphQL(t, router, query, nil)
+	assert.Empty(t, response.Errors, "the errors should be empty")
+	if err := json.Unmarshal(response.Data, &data); err != nil {
+		t.Fatalf("could not decode the data: %s", err)
+	}
+	page := data.Provider.Service.Region.Products
+	if assert.Len(t, page.Items, 1) {
+		assert.Equal(t, "small", page.Items[0].Type)
+	}
+	if !assert.NotNil(t, page.NextCursor, "the cursor of the next page should be returned") {
+		return
+	}
+
+	response = queryGraphQL(t, router, query, map[string]interface{}{"cursor": *page.NextCursor})
+	assert.Empty(t, response.Errors, "the errors should be empty")
+	assert.JSONEq(t, `{"provider":{"service":{"region":{"products":{"items":[{"type":"large"}],"nextCursor":null}}}}}`,
+		string(response.Data), "the last page should have no next cursor")
+}
+
+func TestRouteHandler_queryGraphQLErrors(t *testing.T) {
+	router := newTestRouter(t)
+	tests := []struct {
+		name  string
+		query string
+		code  string
+	}{
+		{
+			name:  "unknown provider",
+			query: `{ provider(name: "unknown") { name } }`,
+			code:  cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:  "unknown service",
+			query: `{ provider(name: "dummy") { service(name: "unknown") { name } } }`,
+			code:  cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:  "unknown geography",
+			query: `{ provider(name: "dummy") { service(name: "compute") { regions(geography: "unknown") { id } } } }`,
+			code:  cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name: "invalid product filter",
+			query: `{ provider(name: "dummy") { service(name: "compute") { region(id: "region-1") {
+				products(filter: {os: "windows", pricingModel: "commitment-1yr"}) { items { type } }
+			} } } }`,
+			code: cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name: "unknown zone",
+			query: `{ provider(name: "dummy") { service(name: "compute") { region(id: "region-1") {
+				products(filter: {zone: "region-2a"}) { items { type } }
+			} } } }`,
+			code: cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name: "invalid cursor",
+			query: `{ provider(name: "dummy") { service(name: "compute") { region(id: "region-1") {
+				products(cursor: "invalid") { items { type } }
+			} } } }`,
+			code: cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name: "products not yet cached",
+			query: `{ provider(name: "dummy") { service(name: "compute") { region(id: "region-2") {
+				products { items { type } }
+			} } } }`,
+			code: cloudinfo.ErrCodeNotYetAvailable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := queryGraphQL(t, router, test.query, nil)
+			if assert.Len(t, response.Errors, 1) {
+				assert.Equal(t, test.code, response.Errors[0].Extensions["code"])
+				assert.NotEmpty(t, response.Errors[0].Path, "the path of the failed field should be set")
+			}
+		})
+	}
+
+	t.Run("syntax error", func(t *testing.T) {
+		response := queryGraphQL(t, router, `{ providers { name }`, nil)
+		assert.NotEmpty(t, response.Errors, "the errors should not be empty")
+		assert.Empty(t, response.Data, "the data should be missing")
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		w := serve(router, http.MethodGet, "/api/v1/graphql", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid variables", func(t *testing.T) {
+		w := serve(router, http.MethodGet, "/api/v1/graphql?query="+url.QueryEscape("{ providers { name } }")+"&variables=x", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -16,12 +16,15 @@ package api
 
 import (
 	"context"
-	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mitchellh/mapstructure"
 
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 )
 
@@ -38,6 +41,7 @@ import (
 //
 //     Responses:
 //       200: ProvidersResponse
+//       500: ErrorResponse
 func (r *RouteHandler) getProviders(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 
@@ -47,7 +51,8 @@ func (r *RouteHandler) getProviders(ctx context.Context) gin.HandlerFunc {
 
 		providers := r.prod.GetProviders(ctxLog)
 		if len(providers) < 1 {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": "no providers are configured"})
+			c.Error(cloudinfo.ErrNoProviders)
+			return
 		}
 
 		c.JSON(http.StatusOK, ProvidersResponse{
@@ -69,11 +74,13 @@ func (r *RouteHandler) getProviders(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: ProviderResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
 func (r *RouteHandler) getProvider(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetProviderPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -84,9 +91,8 @@ func (r *RouteHandler) getProvider(ctx context.Context) gin.HandlerFunc {
 
 		provider, err := r.prod.GetProvider(ctxLog, pathParams.Provider)
 		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"status": http.StatusNotFound, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
-
 		}
 
 		c.JSON(http.StatusOK, ProviderResponse{
@@ -108,26 +114,26 @@ func (r *RouteHandler) getProvider(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: ServicesResponse
-//       503: ErrorResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
+//       502: ErrorResponse
 func (r *RouteHandler) getServices(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetProviderPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
 		infoer, err := r.prod.GetInfoer(pathParams.Provider)
 		if err != nil {
-			er := NewErrorResponse(fmt.Sprintf("%d", http.StatusInternalServerError), fmt.Sprintf("error while retrieving services: %v", err))
-			c.JSON(http.StatusInternalServerError, er)
+			c.Error(err)
 			return
 		}
 
 		services, err := infoer.GetServices()
 		if err != nil {
-			er := NewErrorResponse(fmt.Sprintf("%d", http.StatusServiceUnavailable), fmt.Sprintf("error while retrieving service: %v", err))
-			c.JSON(http.StatusServiceUnavailable, er)
+			c.Error(cloudinfo.NewProviderUnavailableError(pathParams.Provider, err))
 			return
 		}
 
@@ -148,13 +154,14 @@ func (r *RouteHandler) getServices(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: ServiceResponse
-//       503: ErrorResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
 func (r *RouteHandler) getService(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// bind the path parameters
 		pathParams := GetServicesPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -166,15 +173,13 @@ func (r *RouteHandler) getService(ctx context.Context) gin.HandlerFunc {
 
 		infoer, err := r.prod.GetInfoer(pathParams.Provider)
 		if err != nil {
-			er := NewErrorResponse(fmt.Sprintf("%d", http.StatusInternalServerError), fmt.Sprintf("error while retrieving service: %v", err))
-			c.JSON(http.StatusInternalServerError, er)
+			c.Error(err)
 			return
 		}
 
 		service, err := infoer.GetService(ctxLog, pathParams.Service)
 		if err != nil {
-			er := NewErrorResponse(fmt.Sprintf("%d", http.StatusServiceUnavailable), fmt.Sprintf("error while retrieving service: %v", err))
-			c.JSON(http.StatusServiceUnavailable, er)
+			c.Error(err)
 			return
 		}
 
@@ -195,12 +200,13 @@ func (r *RouteHandler) getService(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: RegionsResponse
-//
+//       400: ErrorResponse
+//       502: ErrorResponse
 func (r *RouteHandler) getRegions(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetServicesPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -212,7 +218,7 @@ func (r *RouteHandler) getRegions(ctx context.Context) gin.HandlerFunc {
 
 		regions, err := r.prod.GetRegions(ctxLog, pathParams.Provider, pathParams.Service)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 		var response RegionsResponse
@@ -236,11 +242,13 @@ func (r *RouteHandler) getRegions(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: RegionResponse
+//       400: ErrorResponse
+//       502: ErrorResponse
 func (r *RouteHandler) getRegion(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -253,12 +261,12 @@ func (r *RouteHandler) getRegion(ctx context.Context) gin.HandlerFunc {
 
 		regions, err := r.prod.GetRegions(ctxLog, pathParams.Provider, pathParams.Service)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 		zones, err := r.prod.GetZones(ctxLog, pathParams.Provider, pathParams.Region)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 		c.JSON(http.StatusOK, GetRegionResp{pathParams.Region, regions[pathParams.Region], zones})
@@ -268,6 +276,9 @@ func (r *RouteHandler) getRegion(ctx context.Context) gin.HandlerFunc {
 // swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products products getProducts
 //
 // Provides a list of available machine types on a given provider in a specific region.
+// The on demand prices are given for linux unless another operating system / license is selected with the os query parameter.
+// The pricingModel and paymentOption query parameters price the products with the effective hourly price of a commitment instead.
+// The products can be filtered by their resources, network, generation, zone and price, ordered by price or price per vCPU and paged with a cursor.
 //
 //     Produces:
 //     - application/json
@@ -278,11 +289,13 @@ func (r *RouteHandler) getRegion(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: ProductDetailsResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
 func (r *RouteHandler) getProducts(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -298,20 +311,646 @@ func (r *RouteHandler) getProducts(ctx context.Context) gin.HandlerFunc {
 
 		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
-		details, err := r.prod.GetProductDetails(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Region)
+		page, err := r.queryProducts(ctxLog, c, pathParams)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 
 		log.Debug("successfully retrieved product details")
-		c.JSON(http.StatusOK, ProductDetailsResponse{details, scrapingTime})
+		c.JSON(http.StatusOK, ProductDetailsResponse{page.products, scrapingTime, cloudinfo.Units(), page.next})
+	}
+}
+
+// productPage is a page of the products matching the query parameters of a request
+type productPage struct {
+	products []cloudinfo.ProductDetails
+	// total is the number of the matching products on every page
+	total int
+	next  string
+}
+
+// queryProducts retrieves the products of a region filtered, converted and paged by the query parameters of the request
+func (r *RouteHandler) queryProducts(ctx context.Context, c *gin.Context, pathParams GetRegionPathParams) (productPage, error) {
+	details, err := r.prod.QueryProducts(ctx, pathParams.Provider, pathParams.Service, pathParams.Region, cloudinfo.ProductQuery{
+		Category:     c.Query(categoryQueryParam),
+		Family:       c.Query(familyQueryParam),
+		Generation:   c.Query(generationQueryParam),
+		Size:         c.Query(sizeQueryParam),
+		Burst:        c.Query(burstQueryParam),
+		MinBandwidth: c.Query(minBandwidthQueryParam),
+		Resources: cloudinfo.ResourceFilter{
+			MinCpu: c.Query(minCpuQueryParam),
+			MaxCpu: c.Query(maxCpuQueryParam),
+			MinMem: c.Query(minMemQueryParam),
+			MaxMem: c.Query(maxMemQueryParam),
+			MinGpu: c.Query(minGpuQueryParam),
+			MaxGpu: c.Query(maxGpuQueryParam),
+		},
+		NetworkCategory: c.Query(networkCategoryQueryParam),
+		CurrentGen:      c.Query(currentGenQueryParam),
+		Zone:            c.Query(zoneQueryParam),
+		Os:              c.Query(osQueryParam),
+		PricingModel:    c.Query(pricingModelQueryParam),
+		PaymentOption:   c.Query(paymentOptionQueryParam),
+		Currency:        c.Query(currencyQueryParam),
+		MaxPrice:        c.Query(maxPriceQueryParam),
+		MaxSpotPrice:    c.Query(maxSpotPriceQueryParam),
+	}, r.exchangeRates)
+	if err != nil {
+		return productPage{}, err
+	}
+
+	total := len(details)
+	details, next, err := cloudinfo.PageProducts(details, c.Query(sortQueryParam), c.Query(orderQueryParam),
+		c.Query(cursorQueryParam), c.Query(limitQueryParam))
+	if err != nil {
+		return productPage{}, err
+	}
+	return productPage{products: details, total: total, next: next}, nil
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/storage storage getStorage
+//
+// Provides the block storage volume types and their prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: StorageResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) getStorage(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("getting storage details")
+
+		storage, err := r.regionStorage(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully retrieved storage details")
+		c.JSON(http.StatusOK, StorageResponse{storage})
+	}
+}
+
+// regionStorage retrieves the block storage prices of a region in the requested currency
+func (r *RouteHandler) regionStorage(ctx context.Context, c *gin.Context, pathParams GetRegionPathParams) ([]cloudinfo.StorageInfo, error) {
+	storage, err := r.prod.GetStorage(ctx, pathParams.Provider, pathParams.Region)
+	if err != nil {
+		return nil, err
+	}
+	return cloudinfo.ConvertStorageCurrency(ctx, storage, c.Query(currencyQueryParam), r.exchangeRates)
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/network network getNetworkPrices
+//
+// Provides the load balancer and data transfer prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: NetworkPricesResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) getNetworkPrices(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("getting network prices")
+
+		prices, err := r.regionNetworkPrices(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully retrieved network prices")
+		c.JSON(http.StatusOK, NetworkPricesResponse{prices})
+	}
+}
+
+// regionNetworkPrices retrieves the network prices of a region in the requested currency
+func (r *RouteHandler) regionNetworkPrices(ctx context.Context, c *gin.Context, pathParams GetRegionPathParams) (cloudinfo.NetworkPrices, error) {
+	prices, err := r.prod.GetNetworkPrices(ctx, pathParams.Provider, pathParams.Region)
+	if err != nil {
+		return cloudinfo.NetworkPrices{}, err
+	}
+	return cloudinfo.ConvertNetworkCurrency(ctx, prices, c.Query(currencyQueryParam), r.exchangeRates)
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType} spot getSpotPrice
+//
+// Provides the spot price of an instance type aggregated over the zones of a region, with the contributing zones and the age of their prices.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: SpotPriceResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) getSpotPrice(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetSpotPricePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("getting spot price")
+
+		spot, err := r.spotPrice(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully retrieved spot price")
+		c.JSON(http.StatusOK, SpotPriceResponse{spot})
+	}
+}
+
+// spotPrice aggregates the spot price of an instance type over the requested zones of a region
+func (r *RouteHandler) spotPrice(ctx context.Context, c *gin.Context, pathParams GetSpotPricePathParams) (cloudinfo.SpotAggregate, error) {
+	var zones []string
+	if z := c.Query(zonesQueryParam); z != "" {
+		zones = strings.Split(z, ",")
+	}
+	count := 0
+	if cnt := c.Query(countQueryParam); cnt != "" {
+		var err error
+		if count, err = strconv.Atoi(cnt); err != nil {
+			return cloudinfo.SpotAggregate{}, cloudinfo.NewInvalidArgumentError("invalid count: [%s]", cnt)
+		}
+	}
+
+	spot, err := r.prod.GetSpotPrice(ctx, pathParams.Provider, pathParams.Region, pathParams.InstanceType, zones,
+		c.Query(strategyQueryParam), count)
+	if err != nil {
+		return cloudinfo.SpotAggregate{}, err
+	}
+	return cloudinfo.ConvertSpotCurrency(ctx, spot, c.Query(currencyQueryParam), r.exchangeRates)
+}
+
+// swagger:route POST /providers/{provider}/services/{service}/regions/{region}/recommendations recommendations getRecommendations
+//
+// Recommends node pools providing the requested vCPUs and memory, ranked by their hourly on demand and spot cost.
+// The instance types left out of the recommendations are listed with the reason.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: RecommendationsResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) getRecommendations(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("recommending node pools")
+
+		recommendations, err := r.recommend(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully recommended node pools")
+		c.JSON(http.StatusOK, recommendations)
 	}
 }
 
+// recommend ranks the node pools providing the resources of the recommendation request in the body of the request
+func (r *RouteHandler) recommend(ctx context.Context, c *gin.Context, pathParams GetRegionPathParams) (RecommendationsResponse, error) {
+	req := cloudinfo.RecommendationRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return RecommendationsResponse{}, cloudinfo.NewInvalidArgumentError("invalid recommendation request: %s", err)
+	}
+	if err := r.prod.ValidateZones(ctx, pathParams.Provider, pathParams.Region, req.Zones); err != nil {
+		return RecommendationsResponse{}, err
+	}
+
+	details, err := r.prod.GetProductDetails(ctx, pathParams.Provider, pathParams.Service, pathParams.Region)
+	if err != nil {
+		return RecommendationsResponse{}, err
+	}
+	details, err = cloudinfo.ConvertCurrency(ctx, details, c.Query(currencyQueryParam), r.exchangeRates)
+	if err != nil {
+		return RecommendationsResponse{}, err
+	}
+	recommendations, rejected, err := cloudinfo.Recommend(details, req)
+	if err != nil {
+		return RecommendationsResponse{}, err
+	}
+
+	currency := c.Query(currencyQueryParam)
+	if currency == "" && len(details) > 0 {
+		currency = details[0].Currency
+	}
+	return RecommendationsResponse{recommendations, rejected, currency}, nil
+}
+
+// swagger:route POST /providers/{provider}/services/{service}/regions/{region}/estimate estimate estimateCost
+//
+// Estimates the hourly and monthly cost of a cluster described by its node pools, disks, load balancers, data transfer
+// and control plane, broken down per node pool and per line item, with the savings of the spot nodes.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CostEstimateResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) estimateCost(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("estimating cluster cost")
+
+		estimate, err := r.estimate(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully estimated cluster cost")
+		c.JSON(http.StatusOK, CostEstimateResponse{estimate})
+	}
+}
+
+// estimate estimates the cost of the cluster described in the body of the request
+func (r *RouteHandler) estimate(ctx context.Context, c *gin.Context, pathParams GetRegionPathParams) (cloudinfo.CostEstimate, error) {
+	cluster := cloudinfo.ClusterDescription{}
+	if err := c.ShouldBindJSON(&cluster); err != nil {
+		return cloudinfo.CostEstimate{}, cloudinfo.NewInvalidArgumentError("invalid cluster description: %s", err)
+	}
+	currency := c.Query(currencyQueryParam)
+	if currency == "" {
+		// the costs of the line items are summed up in the same currency
+		currency = cloudinfo.CurrencyUSD
+	}
+
+	// only the prices of the described resources are retrieved, the rest may not be available
+	prices := cloudinfo.ClusterPrices{Currency: strings.ToUpper(currency)}
+	var err error
+	if len(cluster.NodePools) > 0 {
+		if prices.Products, err = r.prod.GetProductDetails(ctx, pathParams.Provider, pathParams.Service, pathParams.Region); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+		if prices.Products, err = cloudinfo.ConvertCurrency(ctx, prices.Products, currency, r.exchangeRates); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+	}
+	if len(cluster.Disks) > 0 {
+		if prices.Storage, err = r.prod.GetStorage(ctx, pathParams.Provider, pathParams.Region); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+		if prices.Storage, err = cloudinfo.ConvertStorageCurrency(ctx, prices.Storage, currency, r.exchangeRates); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+	}
+	if len(cluster.LoadBalancers) > 0 || cluster.InterZoneGbPerMonth > 0 || cluster.EgressGbPerMonth > 0 {
+		if prices.Network, err = r.prod.GetNetworkPrices(ctx, pathParams.Provider, pathParams.Region); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+		if prices.Network, err = cloudinfo.ConvertNetworkCurrency(ctx, prices.Network, currency, r.exchangeRates); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+	}
+	if cluster.ControlPlane {
+		infoer, err := r.prod.GetInfoer(pathParams.Provider)
+		if err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+		service, err := infoer.GetService(ctx, pathParams.Service)
+		if err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+		if prices.ControlPlane, err = cloudinfo.ConvertControlPlaneCurrency(ctx, service.ControlPlane(), currency, r.exchangeRates); err != nil {
+			return cloudinfo.CostEstimate{}, err
+		}
+	}
+
+	return cloudinfo.EstimateCost(cluster, prices)
+}
+
+// swagger:route GET /compare compare compare
+//
+// Lists the closest matches of a reference shape (cpu, mem, gpu) or a reference instance type (provider, region,
+// instanceType) in every region of the providers, with their similarity to the reference and their price deltas.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CompareResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) compare(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("comparing instance types")
+
+		comparison, err := r.compareProducts(ctxLog, c)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully compared instance types")
+		c.JSON(http.StatusOK, comparison)
+	}
+}
+
+// compareProducts matches the reference shape or instance type given in the query parameters in every provider region
+func (r *RouteHandler) compareProducts(ctx context.Context, c *gin.Context) (CompareResponse, error) {
+	currency := c.Query(currencyQueryParam)
+	if currency == "" {
+		// the prices of the providers are compared in the same currency
+		currency = cloudinfo.CurrencyUSD
+	}
+	count := 0
+	if cnt := c.Query(countQueryParam); cnt != "" {
+		var err error
+		if count, err = strconv.Atoi(cnt); err != nil {
+			return CompareResponse{}, cloudinfo.NewInvalidArgumentError("invalid count: [%s]", cnt)
+		}
+	}
+
+	var reference cloudinfo.Shape
+	var referencePrice float64
+	if instanceType := c.Query(instanceTypeQueryParam); instanceType != "" {
+		if _, err := r.prod.GetInfoer(c.Query(providerQueryParam)); err != nil {
+			return CompareResponse{}, err
+		}
+		details, err := r.prod.GetProductDetails(ctx, c.Query(providerQueryParam), "compute", c.Query(regionQueryParam))
+		if err != nil {
+			return CompareResponse{}, err
+		}
+		details, err = cloudinfo.ConvertCurrency(ctx, details, currency, r.exchangeRates)
+		if err != nil {
+			return CompareResponse{}, err
+		}
+		found := false
+		for _, d := range details {
+			if d.Type == instanceType {
+				reference, referencePrice, found = cloudinfo.ShapeOf(d), d.OnDemandPrice, true
+				break
+			}
+		}
+		if !found {
+			return CompareResponse{}, cloudinfo.NewNotFoundError("unknown instance type: [%s]", instanceType)
+		}
+	} else {
+		for _, resource := range []struct {
+			param     string
+			attribute string
+			value     *float64
+		}{
+			{cpuQueryParam, cloudinfo.Cpu, &reference.Cpus},
+			{memQueryParam, cloudinfo.Memory, &reference.Mem},
+			{gpuQueryParam, gpuQueryParam, &reference.Gpus},
+		} {
+			q := c.Query(resource.param)
+			if q == "" {
+				continue
+			}
+			var err error
+			if *resource.value, err = cloudinfo.ParseResource(resource.attribute, q); err != nil {
+				return CompareResponse{}, cloudinfo.NewInvalidArgumentError("invalid %s: [%s]", resource.param, q)
+			}
+		}
+	}
+
+	regions, err := r.allProducts(ctx, c, currency)
+	if err != nil {
+		return CompareResponse{}, err
+	}
+	matches, err := cloudinfo.CompareProducts(reference, referencePrice, regions, count)
+	if err != nil {
+		return CompareResponse{}, err
+	}
+	return CompareResponse{reference, referencePrice, strings.ToUpper(currency), matches}, nil
+}
+
+// swagger:route GET /cheapest-regions regions getCheapestRegions
+//
+// Ranks the regions of the providers by the lowest on demand or spot price of an instance type, or of the instance
+// types providing the minimum resources. The regions can be filtered by geography and by the age of their prices.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CheapestRegionsResponse
+//       400: ErrorResponse
+//       404: ErrorResponse
+//       503: ErrorResponse
+func (r *RouteHandler) getCheapestRegions(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("ranking regions by price")
+
+		ranked, err := r.cheapestRegions(ctxLog, c)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		log.Debug("successfully ranked regions by price")
+		c.JSON(http.StatusOK, CheapestRegionsResponse{ranked})
+	}
+}
+
+// cheapestRegions ranks the provider regions by the price of the instance type or resources given in the query parameters
+func (r *RouteHandler) cheapestRegions(ctx context.Context, c *gin.Context) ([]cloudinfo.RegionPrice, error) {
+	query := cloudinfo.RegionQuery{
+		InstanceType: c.Query(instanceTypeQueryParam),
+		PriceType:    c.Query(priceTypeQueryParam),
+	}
+	for _, resource := range []struct {
+		param     string
+		attribute string
+		value     *float64
+	}{
+		{minCpuQueryParam, cloudinfo.Cpu, &query.MinShape.Cpus},
+		{minMemQueryParam, cloudinfo.Memory, &query.MinShape.Mem},
+		{minGpuQueryParam, gpuQueryParam, &query.MinShape.Gpus},
+	} {
+		q := c.Query(resource.param)
+		if q == "" {
+			continue
+		}
+		var err error
+		if *resource.value, err = cloudinfo.ParseResource(resource.attribute, q); err != nil {
+			return nil, cloudinfo.NewInvalidArgumentError("invalid %s: [%s]", resource.param, q)
+		}
+	}
+	if g := c.Query(geographyQueryParam); g != "" {
+		query.Geographies = strings.Split(g, ",")
+	}
+	if maxAge := c.Query(maxAgeQueryParam); maxAge != "" {
+		var err error
+		if query.MaxAge, err = time.ParseDuration(maxAge); err != nil {
+			return nil, cloudinfo.NewInvalidArgumentError("invalid maximum age: [%s]", maxAge)
+		}
+	}
+	limit := 0
+	if l := c.Query(limitQueryParam); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			return nil, cloudinfo.NewInvalidArgumentError("invalid limit: [%s]", l)
+		}
+	}
+	currency := c.Query(currencyQueryParam)
+	if currency == "" {
+		// the prices of the regions are compared in the same currency
+		currency = cloudinfo.CurrencyUSD
+	}
+
+	regions, err := r.allProducts(ctx, c, currency)
+	if err != nil {
+		return nil, err
+	}
+	ranked, err := cloudinfo.CheapestRegions(regions, query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked, nil
+}
+
+// allProducts retrieves the compute products of every region of the providers selected by the query parameters
+func (r *RouteHandler) allProducts(ctx context.Context, c *gin.Context, currency string) ([]cloudinfo.RegionProducts, error) {
+	var providers []string
+	if p := c.Query(providersQueryParam); p != "" {
+		providers = strings.Split(p, ",")
+	}
+	regions, err := r.prod.GetAllProductDetails(ctx, "compute", providers)
+	if err != nil {
+		return nil, err
+	}
+	for i := range regions {
+		if regions[i].Products, err = cloudinfo.ConvertCurrency(ctx, regions[i].Products, currency, r.exchangeRates); err != nil {
+			return nil, err
+		}
+	}
+	return regions, nil
+}
+
 // swagger:route GET /providers/{provider}/services/{service}/regions/{region}/images images getImages
 //
 // Provides a list of available images on a given provider in a specific region for a service.
@@ -325,11 +964,13 @@ func (r *RouteHandler) getProducts(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: ImagesResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
 func (r *RouteHandler) getImages(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -345,7 +986,7 @@ func (r *RouteHandler) getImages(ctx context.Context) gin.HandlerFunc {
 
 		images, err := r.prod.GetServiceImages(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Region)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 
@@ -367,11 +1008,13 @@ func (r *RouteHandler) getImages(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: VersionsResponse
+//       400: ErrorResponse
+//       503: ErrorResponse
 func (r *RouteHandler) getVersions(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -387,7 +1030,7 @@ func (r *RouteHandler) getVersions(ctx context.Context) gin.HandlerFunc {
 
 		versions, err := r.prod.GetVersions(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Region)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 
@@ -409,11 +1052,13 @@ func (r *RouteHandler) getVersions(ctx context.Context) gin.HandlerFunc {
 //
 //     Responses:
 //       200: AttributeResponse
+//       400: ErrorResponse
+//       502: ErrorResponse
 func (r *RouteHandler) getAttrValues(ctx context.Context) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetAttributeValuesPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
 			return
 		}
 
@@ -429,12 +1074,12 @@ func (r *RouteHandler) getAttrValues(ctx context.Context) gin.HandlerFunc {
 
 		attributes, err := r.prod.GetAttrValues(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Attribute)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"status": http.StatusInternalServerError, "message": fmt.Sprintf("%s", err)})
+			c.Error(err)
 			return
 		}
 		log.Debugf("successfully retrieved %s attribute values", pathParams.Attribute)
 
-		c.JSON(http.StatusOK, AttributeResponse{pathParams.Attribute, attributes})
+		c.JSON(http.StatusOK, AttributeResponse{pathParams.Attribute, attributes, cloudinfo.AttrUnit(pathParams.Attribute)})
 	}
 }
 
@@ -0,0 +1,816 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// The v2 handlers respond with the same data as the v1 ones, wrapped in an envelope holding the metadata of the
+// response. The errors are rendered in the envelope by the EnvelopeErrorHandler middleware.
+
+// providerMeta assembles the metadata of a response built from the cached data of a provider
+func (r *RouteHandler) providerMeta(provider string) Meta {
+	status, err := r.prod.GetStatus(provider)
+	if err != nil {
+		return Meta{}
+	}
+	generation, err := strconv.ParseInt(status, 10, 64)
+	if err != nil {
+		return Meta{}
+	}
+	scrapedAt := time.Unix(0, generation*int64(time.Millisecond)).UTC()
+	return Meta{
+		Generation: generation,
+		Freshness: &Freshness{
+			ScrapedAt:  scrapedAt,
+			AgeSeconds: int64(time.Since(scrapedAt).Seconds()),
+		},
+	}
+}
+
+// swagger:route GET /providers providers v2 getProvidersV2
+//
+// Returns the supported providers
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ProvidersEnvelope
+//       500: ErrorEnvelope
+func (r *RouteHandler) getProvidersV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		providers := r.prod.GetProviders(ctxLog)
+		if len(providers) < 1 {
+			c.Error(cloudinfo.ErrNoProviders)
+			return
+		}
+
+		c.JSON(http.StatusOK, ProvidersEnvelope{
+			Data: providers,
+			Meta: Meta{Pagination: &Pagination{Total: len(providers)}},
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider} provider v2 getProviderV2
+//
+// Returns the requested provider
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ProviderEnvelope
+//       404: ErrorEnvelope
+func (r *RouteHandler) getProviderV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProviderPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		provider, err := r.prod.GetProvider(ctxLog, pathParams.Provider)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ProviderEnvelope{
+			Data: provider,
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services services v2 getServicesV2
+//
+// Provides a list with the available services for the provider
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ServicesEnvelope
+//       404: ErrorEnvelope
+//       502: ErrorEnvelope
+func (r *RouteHandler) getServicesV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProviderPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		infoer, err := r.prod.GetInfoer(pathParams.Provider)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		services, err := infoer.GetServices()
+		if err != nil {
+			c.Error(cloudinfo.NewProviderUnavailableError(pathParams.Provider, err))
+			return
+		}
+
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Pagination = &Pagination{Total: len(services)}
+		c.JSON(http.StatusOK, ServicesEnvelope{
+			Data: NewServicesResponse(services).Services,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service} service v2 getServiceV2
+//
+// Provides service details for the given service on the provider
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ServiceEnvelope
+//       404: ErrorEnvelope
+func (r *RouteHandler) getServiceV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetServicesPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		infoer, err := r.prod.GetInfoer(pathParams.Provider)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		service, err := infoer.GetService(ctxLog, pathParams.Service)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ServiceEnvelope{
+			Data: cloudinfo.ServiceOf(service),
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions regions v2 getRegionsV2
+//
+// Provides the list of available regions of a cloud provider, ordered by their id
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: RegionsEnvelope
+//       404: ErrorEnvelope
+//       502: ErrorEnvelope
+func (r *RouteHandler) getRegionsV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetServicesPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		regions, err := r.prod.GetRegions(ctxLog, pathParams.Provider, pathParams.Service)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		data := make([]Region, 0, len(regions))
+		for id, name := range regions {
+			data = append(data, Region{id, name})
+		}
+		sort.Slice(data, func(i, j int) bool {
+			return data[i].Id < data[j].Id
+		})
+
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Pagination = &Pagination{Total: len(data)}
+		c.JSON(http.StatusOK, RegionsEnvelope{
+			Data: data,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region} regions v2 getRegionV2
+//
+// Provides the detailed info of a specific region of a cloud provider
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: RegionEnvelope
+//       404: ErrorEnvelope
+//       502: ErrorEnvelope
+func (r *RouteHandler) getRegionV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		regions, err := r.prod.GetRegions(ctxLog, pathParams.Provider, pathParams.Service)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		zones, err := r.prod.GetZones(ctxLog, pathParams.Provider, pathParams.Region)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, RegionEnvelope{
+			Data: GetRegionResp{pathParams.Region, regions[pathParams.Region], zones},
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/images images v2 getImagesV2
+//
+// Provides a list of available images on a given provider in a specific region for a service.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ImagesEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getImagesV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		images, err := r.prod.GetServiceImages(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		data := make([]cloudinfo.Image, len(images))
+		for i, image := range images {
+			data[i] = cloudinfo.Image{Image: image.ImageName()}
+		}
+
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Pagination = &Pagination{Total: len(data)}
+		c.JSON(http.StatusOK, ImagesEnvelope{
+			Data: data,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/versions versions v2 getVersionsV2
+//
+// Provides a list of available versions on a given provider in a specific region for a service.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: VersionsEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getVersionsV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		versions, err := r.prod.GetVersions(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		if versions == nil {
+			versions = []string{}
+		}
+
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Pagination = &Pagination{Total: len(versions)}
+		c.JSON(http.StatusOK, VersionsEnvelope{
+			Data: versions,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products products v2 getProductsV2
+//
+// Provides a page of the machine types available on a given provider in a specific region.
+// The products are filtered, priced, ordered and paged by the same query parameters as in v1; the cursor of the next
+// page and the number of the matching products are returned in the pagination metadata.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ProductsEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getProductsV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		log := logger.Extract(ctxLog)
+		log.Info("getting product details")
+
+		page, err := r.queryProducts(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		// the limit has been validated by the paging of the products
+		limit, _ := strconv.Atoi(c.Query(limitQueryParam))
+
+		units := cloudinfo.Units()
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Units = &units
+		meta.Pagination = &Pagination{Limit: limit, Total: page.total, NextCursor: page.next}
+		if updatedAt := r.prod.PricesUpdatedAt(pathParams.Provider, pathParams.Region, page.products); meta.Freshness != nil && !updatedAt.IsZero() {
+			meta.Freshness.PricesUpdatedAt = &updatedAt
+		}
+
+		log.Debug("successfully retrieved product details")
+		c.JSON(http.StatusOK, ProductsEnvelope{
+			Data: page.products,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute} attributes v2 getAttrValuesV2
+//
+// Provides a list of available attribute values in a provider's region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: AttributeEnvelope
+//       404: ErrorEnvelope
+//       502: ErrorEnvelope
+func (r *RouteHandler) getAttrValuesV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetAttributeValuesPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		attributes, err := r.prod.GetAttrValues(ctxLog, pathParams.Provider, pathParams.Service, pathParams.Attribute)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, AttributeEnvelope{
+			Data: AttributeResponse{pathParams.Attribute, attributes, cloudinfo.AttrUnit(pathParams.Attribute)},
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/storage storage v2 getStorageV2
+//
+// Provides the block storage volume types and their prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: StorageEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getStorageV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		storage, err := r.regionStorage(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		meta := r.providerMeta(pathParams.Provider)
+		meta.Pagination = &Pagination{Total: len(storage)}
+		c.JSON(http.StatusOK, StorageEnvelope{
+			Data: storage,
+			Meta: meta,
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/network network v2 getNetworkPricesV2
+//
+// Provides the load balancer and data transfer prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: NetworkPricesEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getNetworkPricesV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		prices, err := r.regionNetworkPrices(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, NetworkPricesEnvelope{
+			Data: prices,
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType} spot v2 getSpotPriceV2
+//
+// Provides the spot price of an instance type aggregated over the zones of a region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: SpotPriceEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getSpotPriceV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetSpotPricePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		spot, err := r.spotPrice(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, SpotPriceEnvelope{
+			Data: spot,
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route POST /providers/{provider}/services/{service}/regions/{region}/recommendations recommendations v2 getRecommendationsV2
+//
+// Recommends node pools providing the requested vCPUs and memory, ranked by their hourly on demand and spot cost.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: RecommendationsEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getRecommendationsV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		recommendations, err := r.recommend(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, RecommendationsEnvelope{
+			Data: recommendations,
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route POST /providers/{provider}/services/{service}/regions/{region}/estimate estimate v2 estimateCostV2
+//
+// Estimates the hourly and monthly cost of a cluster described by its node pools, disks, load balancers, data transfer
+// and control plane.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CostEstimateEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) estimateCostV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			return
+		}
+
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithProvider(pathParams.Provider).
+			WithService(pathParams.Service).
+			WithRegion(pathParams.Region).
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		estimate, err := r.estimate(ctxLog, c, pathParams)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CostEstimateEnvelope{
+			Data: estimate,
+			Meta: r.providerMeta(pathParams.Provider),
+		})
+	}
+}
+
+// swagger:route GET /compare compare v2 compareV2
+//
+// Lists the closest matches of a reference shape or a reference instance type in every region of the providers.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CompareEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) compareV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		comparison, err := r.compareProducts(ctxLog, c)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		// the matches come from several providers, there is no single generation
+		c.JSON(http.StatusOK, CompareEnvelope{
+			Data: comparison,
+			Meta: Meta{Pagination: &Pagination{Total: len(comparison.Matches)}},
+		})
+	}
+}
+
+// swagger:route GET /cheapest-regions regions v2 getCheapestRegionsV2
+//
+// Ranks the regions of the providers by the lowest on demand or spot price of an instance type, or of the instance
+// types providing the minimum resources.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: CheapestRegionsEnvelope
+//       400: ErrorEnvelope
+//       404: ErrorEnvelope
+//       503: ErrorEnvelope
+func (r *RouteHandler) getCheapestRegionsV2(ctx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctxLog := logger.ToContext(ctx, logger.NewLogCtxBuilder().
+			WithCorrelationId(logger.GetCorrelationId(c)).
+			Build())
+
+		ranked, err := r.cheapestRegions(ctxLog, c)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CheapestRegionsEnvelope{
+			Data: ranked,
+			Meta: Meta{Pagination: &Pagination{Total: len(ranked)}},
+		})
+	}
+}
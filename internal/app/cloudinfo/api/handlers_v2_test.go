@@ -0,0 +1,221 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// testEnvelope is the envelope of the v2 responses with the data left undecoded
+type testEnvelope struct {
+	Data   json.RawMessage `json:"data"`
+	Meta   Meta            `json:"meta"`
+	Errors []ErrorResponse `json:"errors"`
+}
+
+func decodeEnvelope(t *testing.T, body []byte) testEnvelope {
+	var envelope testEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		t.Fatalf("could not decode the envelope: %s", err)
+	}
+	return envelope
+}
+
+func TestRouteHandler_V2Envelope(t *testing.T) {
+	router := newTestRouter(t)
+	tests := []struct {
+		name    string
+		path    string
+		checker func(envelope testEnvelope)
+	}{
+		{
+			name: "providers",
+			path: "/api/v2/providers",
+			checker: func(envelope testEnvelope) {
+				var providers []cloudinfo.Provider
+				assert.Nil(t, json.Unmarshal(envelope.Data, &providers))
+				assert.Len(t, providers, 2)
+				assert.Equal(t, &Pagination{Total: 2}, envelope.Meta.Pagination)
+			},
+		},
+		{
+			name: "provider",
+			path: "/api/v2/providers/dummy",
+			checker: func(envelope testEnvelope) {
+				var provider cloudinfo.Provider
+				assert.Nil(t, json.Unmarshal(envelope.Data, &provider))
+				assert.Equal(t, testProvider, provider.Provider)
+				assert.Equal(t, testScrapedAt.UnixNano()/1e6, envelope.Meta.Generation)
+				if assert.NotNil(t, envelope.Meta.Freshness, "the freshness should be set") {
+					assert.True(t, testScrapedAt.Equal(envelope.Meta.Freshness.ScrapedAt))
+				}
+			},
+		},
+		{
+			name: "regions",
+			path: "/api/v2/providers/dummy/services/compute/regions",
+			checker: func(envelope testEnvelope) {
+				var regions []Region
+				assert.Nil(t, json.Unmarshal(envelope.Data, &regions))
+				assert.Equal(t, []Region{{testRegion, "Region 1"}, {scrapingRegion, "Region 2"}}, regions)
+			},
+		},
+		{
+			name: "products",
+			path: "/api/v2/providers/dummy/services/compute/regions/region-1/products?sort=price&limit=1",
+			checker: func(envelope testEnvelope) {
+				var products []cloudinfo.ProductDetails
+				assert.Nil(t, json.Unmarshal(envelope.Data, &products))
+				if assert.Len(t, products, 1) {
+					assert.Equal(t, "small", products[0].Type)
+				}
+				if assert.NotNil(t, envelope.Meta.Pagination, "the pagination should be set") {
+					assert.Equal(t, 1, envelope.Meta.Pagination.Limit)
+					assert.Equal(t, 2, envelope.Meta.Pagination.Total)
+					assert.NotEmpty(t, envelope.Meta.Pagination.NextCursor)
+				}
+				assert.NotNil(t, envelope.Meta.Units, "the units should be set")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(router, http.MethodGet, test.path, nil)
+			assert.Equal(t, http.StatusOK, w.Code)
+			envelope := decodeEnvelope(t, w.Body.Bytes())
+			assert.Nil(t, envelope.Errors, "the errors should be nil")
+			test.checker(envelope)
+		})
+	}
+}
+
+func TestRouteHandler_V2ErrorEnvelope(t *testing.T) {
+	router := newTestRouter(t)
+	tests := []struct {
+		name       string
+		path       string
+		status     int
+		code       string
+		retryAfter string
+	}{
+		{
+			name:   "invalid limit",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/products?limit=x",
+			status: http.StatusBadRequest,
+			code:   cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name:   "unknown zone",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/products?zone=region-2a",
+			status: http.StatusBadRequest,
+			code:   cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name:   "commitment prices for windows",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/products?os=windows&pricingModel=commitment-1yr",
+			status: http.StatusBadRequest,
+			code:   cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name:   "spot price of an unknown instance type",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/spot/medium",
+			status: http.StatusNotFound,
+			code:   cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:   "spot price in an unknown zone",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/spot/large?zones=region-1a,region-2a",
+			status: http.StatusBadRequest,
+			code:   cloudinfo.ErrCodeInvalidArgument,
+		},
+		{
+			name:       "products not yet cached",
+			path:       "/api/v2/providers/dummy/services/compute/regions/region-2/products",
+			status:     http.StatusServiceUnavailable,
+			code:       cloudinfo.ErrCodeNotYetAvailable,
+			retryAfter: "60",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(router, http.MethodGet, test.path, nil)
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.retryAfter, w.Header().Get("Retry-After"))
+			envelope := decodeEnvelope(t, w.Body.Bytes())
+			assert.Equal(t, "null", string(envelope.Data))
+			if assert.Len(t, envelope.Errors, 1) {
+				assert.Equal(t, test.code, envelope.Errors[0].ErrorCode)
+				assert.NotEmpty(t, envelope.Errors[0].ErrorMessage)
+			}
+		})
+	}
+}
+
+func TestValidatePathResources(t *testing.T) {
+	router := newTestRouter(t)
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		code   string
+	}{
+		{
+			name:   "unknown provider",
+			path:   "/api/v2/providers/unknown/services",
+			status: http.StatusNotFound,
+			code:   cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:   "unknown service",
+			path:   "/api/v2/providers/dummy/services/unknown/regions",
+			status: http.StatusNotFound,
+			code:   cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:   "unknown region",
+			path:   "/api/v2/providers/dummy/services/compute/regions/unknown/products",
+			status: http.StatusNotFound,
+			code:   cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:   "unknown attribute",
+			path:   "/api/v2/providers/dummy/services/compute/regions/region-1/products/unknown",
+			status: http.StatusNotFound,
+			code:   cloudinfo.ErrCodeNotFound,
+		},
+		{
+			name:   "services of the provider unavailable",
+			path:   "/api/v2/providers/broken/services/compute",
+			status: http.StatusBadGateway,
+			code:   cloudinfo.ErrCodeProviderUnavailable,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(router, http.MethodGet, test.path, nil)
+			assert.Equal(t, test.status, w.Code)
+			envelope := decodeEnvelope(t, w.Body.Bytes())
+			if assert.Len(t, envelope.Errors, 1) {
+				assert.Equal(t, test.code, envelope.Errors[0].ErrorCode)
+			}
+		})
+	}
+}
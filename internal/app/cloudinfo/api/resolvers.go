@@ -0,0 +1,737 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// queryResolver resolves the root query of the GraphQL schema
+type queryResolver struct {
+	r *RouteHandler
+}
+
+// Providers resolves the supported providers ordered by name
+func (q *queryResolver) Providers(ctx context.Context, args struct{ Names *[]string }) []*providerResolver {
+	providers := q.r.prod.GetProviders(ctx)
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Provider < providers[j].Provider
+	})
+
+	var resolvers []*providerResolver
+	for _, p := range providers {
+		if args.Names != nil && !cloudinfo.Contains(*args.Names, p.Provider) {
+			continue
+		}
+		resolvers = append(resolvers, &providerResolver{r: q.r, provider: p})
+	}
+	return resolvers
+}
+
+// Provider resolves the provider with the given name
+func (q *queryResolver) Provider(ctx context.Context, args struct{ Name string }) (*providerResolver, error) {
+	for _, p := range q.r.prod.GetProviders(ctx) {
+		if p.Provider == args.Name {
+			return &providerResolver{r: q.r, provider: p}, nil
+		}
+	}
+	return nil, resolverError(cloudinfo.NewNotFoundError("unsupported provider: [%s]", args.Name))
+}
+
+// providerResolver resolves a provider
+type providerResolver struct {
+	r        *RouteHandler
+	provider cloudinfo.Provider
+}
+
+// Name resolves the name of the provider
+func (p *providerResolver) Name() string {
+	return p.provider.Provider
+}
+
+// Services resolves the services of the provider
+func (p *providerResolver) Services(args struct{ Names *[]string }) []*serviceResolver {
+	var resolvers []*serviceResolver
+	for _, s := range p.provider.Services {
+		if args.Names != nil && !cloudinfo.Contains(*args.Names, s.Service) {
+			continue
+		}
+		resolvers = append(resolvers, &serviceResolver{r: p.r, provider: p.provider.Provider, service: s})
+	}
+	return resolvers
+}
+
+// Service resolves the service of the provider with the given name
+func (p *providerResolver) Service(args struct{ Name string }) (*serviceResolver, error) {
+	for _, s := range p.provider.Services {
+		if s.Service == args.Name {
+			return &serviceResolver{r: p.r, provider: p.provider.Provider, service: s}, nil
+		}
+	}
+	return nil, resolverError(cloudinfo.NewNotFoundError("unsupported service: [%s]", args.Name))
+}
+
+// serviceResolver resolves a service of a provider
+type serviceResolver struct {
+	r        *RouteHandler
+	provider string
+	service  cloudinfo.Service
+}
+
+// Name resolves the name of the service
+func (s *serviceResolver) Name() string {
+	return s.service.Service
+}
+
+// ControlPlane resolves the control plane fee of the service in the given currency
+func (s *serviceResolver) ControlPlane(ctx context.Context, args struct{ Currency *string }) (*controlPlaneResolver, error) {
+	fee, err := cloudinfo.ConvertControlPlaneCurrency(ctx, s.service.ControlPlaneFee, optString(args.Currency), s.r.exchangeRates)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	if fee == nil {
+		return nil, nil
+	}
+	return &controlPlaneResolver{*fee}, nil
+}
+
+// Regions resolves the regions of the service ordered by id, optionally narrowed to the given ids and geography
+func (s *serviceResolver) Regions(ctx context.Context, args struct {
+	Ids       *[]string
+	Geography *string
+}) ([]*regionResolver, error) {
+	regions, err := s.r.prod.GetRegions(s.logContext(ctx), s.provider, s.service.Service)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	geography := optString(args.Geography)
+	if geography != "" && !cloudinfo.Contains(cloudinfo.Geographies(), geography) {
+		return nil, resolverError(cloudinfo.NewInvalidArgumentError("unsupported geography: [%s]", geography))
+	}
+
+	var resolvers []*regionResolver
+	for id, name := range regions {
+		if args.Ids != nil && !cloudinfo.Contains(*args.Ids, id) {
+			continue
+		}
+		if geography != "" && cloudinfo.Geography(id) != geography {
+			continue
+		}
+		resolvers = append(resolvers, s.region(id, name))
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].id < resolvers[j].id
+	})
+	return resolvers, nil
+}
+
+// Region resolves the region of the service with the given id
+func (s *serviceResolver) Region(ctx context.Context, args struct{ Id string }) (*regionResolver, error) {
+	regions, err := s.r.prod.GetRegions(s.logContext(ctx), s.provider, s.service.Service)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	name, ok := regions[args.Id]
+	if !ok {
+		return nil, resolverError(cloudinfo.NewNotFoundError("unsupported region: [%s]", args.Id))
+	}
+	return s.region(args.Id, name), nil
+}
+
+func (s *serviceResolver) region(id, name string) *regionResolver {
+	return &regionResolver{r: s.r, provider: s.provider, service: s.service.Service, id: id, name: name}
+}
+
+func (s *serviceResolver) logContext(ctx context.Context) context.Context {
+	return logger.ToContext(ctx, logger.NewLogCtxBuilder().
+		WithProvider(s.provider).
+		WithService(s.service.Service).
+		Build())
+}
+
+// controlPlaneResolver resolves the fee of a managed control plane
+type controlPlaneResolver struct {
+	fee cloudinfo.ControlPlaneFee
+}
+
+// Model resolves the billing model of the control plane
+func (f *controlPlaneResolver) Model() string {
+	return f.fee.Model
+}
+
+// PricePerHour resolves the hourly price of the control plane
+func (f *controlPlaneResolver) PricePerHour() float64 {
+	return f.fee.PricePerHour
+}
+
+// FreeClusters resolves the number of the clusters with a free control plane
+func (f *controlPlaneResolver) FreeClusters() int32 {
+	return int32(f.fee.FreeClusters)
+}
+
+// Currency resolves the currency of the price
+func (f *controlPlaneResolver) Currency() string {
+	return f.fee.Currency
+}
+
+// regionResolver resolves a region of a service
+type regionResolver struct {
+	r        *RouteHandler
+	provider string
+	service  string
+	id       string
+	name     string
+}
+
+// productsArgs are the arguments of the products of a region
+type productsArgs struct {
+	Filter   *productFilter
+	Currency *string
+	Sort     *string
+	Order    *string
+	Limit    *int32
+	Cursor   *string
+}
+
+// productFilter is the ProductFilter input of the products of a region
+type productFilter struct {
+	Types           *[]string
+	Category        *string
+	Family          *string
+	Generation      *int32
+	Size            *string
+	Burst           *bool
+	MinBandwidth    *float64
+	MinCpu          *string
+	MaxCpu          *string
+	MinMem          *string
+	MaxMem          *string
+	MinGpu          *string
+	MaxGpu          *string
+	NetworkCategory *string
+	CurrentGen      *bool
+	Zone            *string
+	Os              *string
+	PricingModel    *string
+	PaymentOption   *string
+	MaxPrice        *float64
+	MaxSpotPrice    *float64
+}
+
+// Id resolves the id of the region
+func (rr *regionResolver) Id() string {
+	return rr.id
+}
+
+// Name resolves the name of the region
+func (rr *regionResolver) Name() string {
+	return rr.name
+}
+
+// Geography resolves the geography of the region, empty if unknown
+func (rr *regionResolver) Geography() string {
+	return cloudinfo.Geography(rr.id)
+}
+
+// Zones resolves the availability zones of the region
+func (rr *regionResolver) Zones(ctx context.Context) ([]string, error) {
+	zones, err := rr.r.prod.GetZones(rr.logContext(ctx), rr.provider, rr.id)
+	return zones, resolverError(err)
+}
+
+// Products resolves a page of the filtered and sorted products of the region
+func (rr *regionResolver) Products(ctx context.Context, args productsArgs) (*productPageResolver, error) {
+	ctx = rr.logContext(ctx)
+
+	query := cloudinfo.ProductQuery{Currency: optString(args.Currency)}
+	if filter := args.Filter; filter != nil {
+		if filter.Types != nil {
+			query.Types = *filter.Types
+		}
+		query.Category = optString(filter.Category)
+		query.Family = optString(filter.Family)
+		query.Generation = optInt(filter.Generation)
+		query.Size = optString(filter.Size)
+		query.Burst = optBool(filter.Burst)
+		query.MinBandwidth = optFloat(filter.MinBandwidth)
+		query.Resources = cloudinfo.ResourceFilter{
+			MinCpu: optString(filter.MinCpu),
+			MaxCpu: optString(filter.MaxCpu),
+			MinMem: optString(filter.MinMem),
+			MaxMem: optString(filter.MaxMem),
+			MinGpu: optString(filter.MinGpu),
+			MaxGpu: optString(filter.MaxGpu),
+		}
+		query.NetworkCategory = optString(filter.NetworkCategory)
+		query.CurrentGen = optBool(filter.CurrentGen)
+		query.Zone = optString(filter.Zone)
+		query.Os = optString(filter.Os)
+		query.PricingModel = optString(filter.PricingModel)
+		query.PaymentOption = optString(filter.PaymentOption)
+		query.MaxPrice = optFloat(filter.MaxPrice)
+		query.MaxSpotPrice = optFloat(filter.MaxSpotPrice)
+	}
+	details, err := rr.r.prod.QueryProducts(ctx, rr.provider, rr.service, rr.id, query, rr.r.exchangeRates)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	details, next, err := cloudinfo.PageProducts(details, optString(args.Sort), optString(args.Order),
+		optString(args.Cursor), optInt(args.Limit))
+	if err != nil {
+		return nil, resolverError(err)
+	}
+
+	page := &productPageResolver{items: make([]*productResolver, len(details))}
+	for i := range details {
+		page.items[i] = &productResolver{details[i]}
+	}
+	if next != "" {
+		page.nextCursor = &next
+	}
+	return page, nil
+}
+
+// Images resolves the images of the service in the region
+func (rr *regionResolver) Images(ctx context.Context) ([]string, error) {
+	images, err := rr.r.prod.GetServiceImages(rr.logContext(ctx), rr.provider, rr.service, rr.id)
+	if err != nil {
+		return nil, resolverError(err)
+	}
+	names := make([]string, len(images))
+	for i, image := range images {
+		names[i] = image.ImageName()
+	}
+	return names, nil
+}
+
+// Versions resolves the versions of the service in the region
+func (rr *regionResolver) Versions(ctx context.Context) ([]string, error) {
+	versions, err := rr.r.prod.GetVersions(rr.logContext(ctx), rr.provider, rr.service, rr.id)
+	return versions, resolverError(err)
+}
+
+func (rr *regionResolver) logContext(ctx context.Context) context.Context {
+	return logger.ToContext(ctx, logger.NewLogCtxBuilder().
+		WithProvider(rr.provider).
+		WithService(rr.service).
+		WithRegion(rr.id).
+		Build())
+}
+
+// productPageResolver resolves a page of the products of a region
+type productPageResolver struct {
+	items      []*productResolver
+	nextCursor *string
+}
+
+// Items resolves the products of the page
+func (pp *productPageResolver) Items() []*productResolver {
+	return pp.items
+}
+
+// NextCursor resolves the cursor of the next page, nil on the last page
+func (pp *productPageResolver) NextCursor() *string {
+	return pp.nextCursor
+}
+
+// productResolver resolves a product of a region
+type productResolver struct {
+	details cloudinfo.ProductDetails
+}
+
+// Type resolves the instance type of the product
+func (p *productResolver) Type() string {
+	return p.details.Type
+}
+
+// Category resolves the normalized category of the instance type
+func (p *productResolver) Category() string {
+	return p.details.Category
+}
+
+// Family resolves the instance family
+func (p *productResolver) Family() string {
+	return p.details.Family
+}
+
+// Generation resolves the generation of the instance family
+func (p *productResolver) Generation() int32 {
+	return int32(p.details.Generation)
+}
+
+// Size resolves the size of the instance type within its family
+func (p *productResolver) Size() string {
+	return p.details.Size
+}
+
+// Cpus resolves the number of vCPUs
+func (p *productResolver) Cpus() float64 {
+	return p.details.Cpus
+}
+
+// CpuCores resolves the number of physical cores of the instance types sold by cores
+func (p *productResolver) CpuCores() float64 {
+	return p.details.CpuCores
+}
+
+// Mem resolves the memory in GiB
+func (p *productResolver) Mem() float64 {
+	return p.details.Mem
+}
+
+// Gpus resolves the number of gpus
+func (p *productResolver) Gpus() float64 {
+	return p.details.Gpus
+}
+
+// GpuModel resolves the model of the gpus
+func (p *productResolver) GpuModel() string {
+	return p.details.GpuModel
+}
+
+// GpuMem resolves the memory of a gpu in GiB
+func (p *productResolver) GpuMem() float64 {
+	return p.details.GpuMem
+}
+
+// Arch resolves the cpu architecture
+func (p *productResolver) Arch() string {
+	return p.details.Arch
+}
+
+// ProcessorFamily resolves the processor of the instance type
+func (p *productResolver) ProcessorFamily() string {
+	return p.details.ProcessorFamily
+}
+
+// NtwPerf resolves the network performance as published by the provider
+func (p *productResolver) NtwPerf() string {
+	return p.details.NtwPerf
+}
+
+// NtwPerfCategory resolves the network performance category
+func (p *productResolver) NtwPerfCategory() string {
+	return p.details.NtwPerfCat
+}
+
+// Bandwidth resolves the network bandwidth in Gbps
+func (p *productResolver) Bandwidth() float64 {
+	return p.details.Bandwidth
+}
+
+// BandwidthUpTo resolves whether the bandwidth is a burst value
+func (p *productResolver) BandwidthUpTo() bool {
+	return p.details.BandwidthUpTo
+}
+
+// Zones resolves the availability zones of the instance type
+func (p *productResolver) Zones() []string {
+	return p.details.Zones
+}
+
+// CurrentGen resolves whether the instance type is of the current generation
+func (p *productResolver) CurrentGen() bool {
+	return p.details.CurrentGen
+}
+
+// Burst resolves whether the instance type is burstable
+func (p *productResolver) Burst() bool {
+	return p.details.Burst
+}
+
+// BurstInfo resolves the cpu model of the burstable instance types
+func (p *productResolver) BurstInfo() *burstInfoResolver {
+	if p.details.BurstInfo == nil {
+		return nil
+	}
+	return &burstInfoResolver{*p.details.BurstInfo}
+}
+
+// LocalDisks resolves the number of the local disks
+func (p *productResolver) LocalDisks() int32 {
+	return int32(p.details.LocalDisks)
+}
+
+// LocalDiskSize resolves the size of a local disk in GiB
+func (p *productResolver) LocalDiskSize() float64 {
+	return p.details.LocalDiskSize
+}
+
+// LocalDiskType resolves the type of the local disks
+func (p *productResolver) LocalDiskType() string {
+	return p.details.LocalDiskType
+}
+
+// PremiumStorage resolves whether the instance type supports optimized block storage
+func (p *productResolver) PremiumStorage() bool {
+	return p.details.PremiumStorage
+}
+
+// MaxNics resolves the maximum number of network interfaces
+func (p *productResolver) MaxNics() int32 {
+	return int32(p.details.MaxNics)
+}
+
+// Hypervisor resolves the virtualization technology of the instance type
+func (p *productResolver) Hypervisor() string {
+	return p.details.Hypervisor
+}
+
+// Attributes resolves the attributes of the instance type ordered by key
+func (p *productResolver) Attributes() []*attributeResolver {
+	var resolvers []*attributeResolver
+	for key, value := range p.details.Attributes {
+		resolvers = append(resolvers, &attributeResolver{key: key, value: value})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].key < resolvers[j].key
+	})
+	return resolvers
+}
+
+// Currency resolves the currency of the prices
+func (p *productResolver) Currency() string {
+	return p.details.Currency
+}
+
+// OnDemandPrice resolves the on demand (linux) price
+func (p *productResolver) OnDemandPrice() float64 {
+	return p.details.OnDemandPrice
+}
+
+// SpotPrices resolves the spot prices per zone ordered by zone
+func (p *productResolver) SpotPrices() []*zonePriceResolver {
+	resolvers := make([]*zonePriceResolver, len(p.details.SpotInfo))
+	for i, zp := range p.details.SpotInfo {
+		resolvers[i] = &zonePriceResolver{zp}
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].price.Zone < resolvers[j].price.Zone
+	})
+	return resolvers
+}
+
+// SpotStats resolves the spot price statistics per zone ordered by zone
+func (p *productResolver) SpotStats() []*spotStatsResolver {
+	var resolvers []*spotStatsResolver
+	for zone, stats := range p.details.SpotStats {
+		resolvers = append(resolvers, &spotStatsResolver{zone: zone, stats: stats})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].zone < resolvers[j].zone
+	})
+	return resolvers
+}
+
+// OsPrices resolves the on demand prices per operating system ordered by operating system
+func (p *productResolver) OsPrices() []*osPriceResolver {
+	var resolvers []*osPriceResolver
+	for os, price := range p.details.OsPrices {
+		resolvers = append(resolvers, &osPriceResolver{os: os, price: price})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].os < resolvers[j].os
+	})
+	return resolvers
+}
+
+// Commitments resolves the prices of the capacity commitments
+func (p *productResolver) Commitments() []*commitmentResolver {
+	resolvers := make([]*commitmentResolver, len(p.details.Commitments))
+	for i, c := range p.details.Commitments {
+		resolvers[i] = &commitmentResolver{c}
+	}
+	return resolvers
+}
+
+// burstInfoResolver resolves the cpu model of a burstable instance type
+type burstInfoResolver struct {
+	info cloudinfo.BurstInfo
+}
+
+// BaselineCpuPercent resolves the sustained cpu performance
+func (b *burstInfoResolver) BaselineCpuPercent() float64 {
+	return b.info.BaselineCpu
+}
+
+// CreditsPerHour resolves the cpu credits earned per hour
+func (b *burstInfoResolver) CreditsPerHour() float64 {
+	return b.info.CreditsPerHour
+}
+
+// Unlimited resolves whether the instance type can run in unlimited mode
+func (b *burstInfoResolver) Unlimited() bool {
+	return b.info.Unlimited
+}
+
+// attributeResolver resolves an attribute of an instance type
+type attributeResolver struct {
+	key   string
+	value string
+}
+
+// Key resolves the name of the attribute
+func (a *attributeResolver) Key() string {
+	return a.key
+}
+
+// Value resolves the value of the attribute
+func (a *attributeResolver) Value() string {
+	return a.value
+}
+
+// zonePriceResolver resolves the spot price of a zone
+type zonePriceResolver struct {
+	price cloudinfo.ZonePrice
+}
+
+// Zone resolves the availability zone
+func (z *zonePriceResolver) Zone() string {
+	return z.price.Zone
+}
+
+// Price resolves the spot price in the zone
+func (z *zonePriceResolver) Price() float64 {
+	return z.price.Price
+}
+
+// spotStatsResolver resolves the spot price statistics of a zone
+type spotStatsResolver struct {
+	zone  string
+	stats cloudinfo.SpotStats
+}
+
+// Zone resolves the availability zone
+func (s *spotStatsResolver) Zone() string {
+	return s.zone
+}
+
+// Min resolves the minimum spot price
+func (s *spotStatsResolver) Min() float64 {
+	return s.stats.Min
+}
+
+// Max resolves the maximum spot price
+func (s *spotStatsResolver) Max() float64 {
+	return s.stats.Max
+}
+
+// Mean resolves the mean spot price
+func (s *spotStatsResolver) Mean() float64 {
+	return s.stats.Mean
+}
+
+// P50 resolves the median spot price
+func (s *spotStatsResolver) P50() float64 {
+	return s.stats.P50
+}
+
+// P90 resolves the 90th percentile of the spot prices
+func (s *spotStatsResolver) P90() float64 {
+	return s.stats.P90
+}
+
+// Volatility resolves the coefficient of variation of the spot prices
+func (s *spotStatsResolver) Volatility() float64 {
+	return s.stats.Volatility
+}
+
+// Samples resolves the number of the price points
+func (s *spotStatsResolver) Samples() int32 {
+	return int32(s.stats.Samples)
+}
+
+// osPriceResolver resolves the on demand price of an operating system
+type osPriceResolver struct {
+	os    string
+	price float64
+}
+
+// Os resolves the operating system / license
+func (o *osPriceResolver) Os() string {
+	return o.os
+}
+
+// Price resolves the on demand price with the operating system
+func (o *osPriceResolver) Price() float64 {
+	return o.price
+}
+
+// commitmentResolver resolves the price of a capacity commitment
+type commitmentResolver struct {
+	commitment cloudinfo.CommitmentPrice
+}
+
+// Term resolves the length of the commitment
+func (c *commitmentResolver) Term() string {
+	return c.commitment.Term
+}
+
+// PaymentOption resolves how the commitment is paid
+func (c *commitmentResolver) PaymentOption() string {
+	return c.commitment.PaymentOption
+}
+
+// Upfront resolves the upfront price
+func (c *commitmentResolver) Upfront() float64 {
+	return c.commitment.Upfront
+}
+
+// Hourly resolves the recurring hourly price
+func (c *commitmentResolver) Hourly() float64 {
+	return c.commitment.Hourly
+}
+
+// EffectiveHourly resolves the hourly price with the upfront price spread over the term
+func (c *commitmentResolver) EffectiveHourly() float64 {
+	return c.commitment.EffectiveHourly
+}
+
+// optString returns the value of an optional argument, empty if not given
+func optString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// optBool formats an optional argument as a query parameter value, empty if not given
+func optBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+// optInt formats an optional argument as a query parameter value, empty if not given
+func optInt(i *int32) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*i))
+}
+
+// optFloat formats an optional argument as a query parameter value, empty if not given
+func optFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
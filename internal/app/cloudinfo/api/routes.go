@@ -32,15 +32,17 @@ import (
 
 // RouteHandler configures the REST API routes in the gin router
 type RouteHandler struct {
-	prod      *cloudinfo.CachingCloudInfo
-	buildInfo buildinfo.BuildInfo
+	prod          *cloudinfo.CachingCloudInfo
+	exchangeRates cloudinfo.ExchangeRater
+	buildInfo     buildinfo.BuildInfo
 }
 
 // NewRouteHandler creates a new RouteHandler and returns a reference to it
-func NewRouteHandler(p *cloudinfo.CachingCloudInfo, bi buildinfo.BuildInfo) *RouteHandler {
+func NewRouteHandler(p *cloudinfo.CachingCloudInfo, er cloudinfo.ExchangeRater, bi buildinfo.BuildInfo) *RouteHandler {
 	return &RouteHandler{
-		prod:      p,
-		buildInfo: bi,
+		prod:          p,
+		exchangeRates: er,
+		buildInfo:     bi,
 	}
 }
 
@@ -51,8 +53,8 @@ func getCorsConfig() cors.Config {
 		config.AllowOrigins = []string{"http://", "https://"}
 	}
 	config.AllowMethods = []string{http.MethodPut, http.MethodDelete, http.MethodGet, http.MethodPost, http.MethodOptions}
-	config.AllowHeaders = []string{"Origin", "Authorization", "Content-Type"}
-	config.ExposeHeaders = []string{"Content-Length"}
+	config.AllowHeaders = []string{"Origin", "Authorization", "Content-Type", "If-None-Match", "If-Modified-Since"}
+	config.ExposeHeaders = []string{"Content-Length", "ETag", "Last-Modified"}
 	config.AllowCredentials = true
 	config.MaxAge = 12
 	return config
@@ -72,6 +74,7 @@ func (r *RouteHandler) ConfigureRoutes(ctx context.Context, router *gin.Engine)
 	router.Use(logger.MiddlewareCorrelationId())
 	router.Use(logger.Middleware())
 	router.Use(cors.New(getCorsConfig()))
+	router.Use(ErrorHandler(ctx))
 	router.Use(static.Serve(basePath, static.LocalFile("./web/dist/ui", true)))
 
 	base := router.Group(basePath)
@@ -81,8 +84,15 @@ func (r *RouteHandler) ConfigureRoutes(ctx context.Context, router *gin.Engine)
 	}
 
 	v1 := base.Group("/api/v1")
+	v1.GET("/compare", r.compare(ctx))
+	v1.GET("/cheapest-regions", r.getCheapestRegions(ctx))
+
+	graphqlHandler := r.queryGraphQL(ctx)
+	v1.GET("/graphql", graphqlHandler)
+	v1.POST("/graphql", graphqlHandler)
 
 	providerGroup := v1.Group("/providers")
+	providerGroup.Use(CacheHandler(ctx, r.prod))
 	{
 
 		providerGroup.GET("/", r.getProviders(ctx)).Use(ValidatePathParam(ctx, providerParam, v, "provider"))
@@ -94,10 +104,39 @@ func (r *RouteHandler) ConfigureRoutes(ctx context.Context, router *gin.Engine)
 		providerGroup.GET("/:provider/services/:service/regions/:region/images", r.getImages(ctx))
 		providerGroup.GET("/:provider/services/:service/regions/:region/versions", r.getVersions(ctx))
 		providerGroup.GET("/:provider/services/:service/regions/:region/products", r.getProducts(ctx))
+		providerGroup.GET("/:provider/services/:service/regions/:region/storage", r.getStorage(ctx))
+		providerGroup.GET("/:provider/services/:service/regions/:region/network", r.getNetworkPrices(ctx))
+		providerGroup.GET("/:provider/services/:service/regions/:region/spot/:instanceType", r.getSpotPrice(ctx))
+		providerGroup.POST("/:provider/services/:service/regions/:region/recommendations", r.getRecommendations(ctx))
+		providerGroup.POST("/:provider/services/:service/regions/:region/estimate", r.estimateCost(ctx))
 		providerGroup.GET("/:provider/services/:service/regions/:region/products/:attribute", r.getAttrValues(ctx)).
 			Use(ValidatePathParam(ctx, attributeParam, v, "attribute"))
 	}
 
+	v2 := base.Group("/api/v2")
+	v2.Use(EnvelopeErrorHandler(ctx))
+	v2.Use(ValidatePathResources(ctx, r.prod))
+	v2.Use(CacheHandler(ctx, r.prod))
+	{
+		v2.GET("/compare", r.compareV2(ctx))
+		v2.GET("/cheapest-regions", r.getCheapestRegionsV2(ctx))
+		v2.GET("/providers", r.getProvidersV2(ctx))
+		v2.GET("/providers/:provider", r.getProviderV2(ctx))
+		v2.GET("/providers/:provider/services", r.getServicesV2(ctx))
+		v2.GET("/providers/:provider/services/:service", r.getServiceV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions", r.getRegionsV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region", r.getRegionV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/images", r.getImagesV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/versions", r.getVersionsV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/products", r.getProductsV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/products/:attribute", r.getAttrValuesV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/storage", r.getStorageV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/network", r.getNetworkPricesV2(ctx))
+		v2.GET("/providers/:provider/services/:service/regions/:region/spot/:instanceType", r.getSpotPriceV2(ctx))
+		v2.POST("/providers/:provider/services/:service/regions/:region/recommendations", r.getRecommendationsV2(ctx))
+		v2.POST("/providers/:provider/services/:service/regions/:region/estimate", r.estimateCostV2(ctx))
+	}
+
 }
 
 func (r *RouteHandler) signalStatus(c *gin.Context) {
@@ -0,0 +1,75 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/internal/platform/buildinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+)
+
+const (
+	testProvider   = cloudinfotest.Provider
+	brokenProvider = cloudinfotest.BrokenProvider
+	testRegion     = cloudinfotest.Region
+	scrapingRegion = cloudinfotest.ScrapingRegion
+)
+
+// testScrapedAt is the completion time of the scrape of testProvider
+var testScrapedAt = time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+
+// newTestCloudInfo creates a CachingCloudInfo with two products of testProvider cached in testRegion
+func newTestCloudInfo() *cloudinfo.CachingCloudInfo {
+	cpi, store := cloudinfotest.NewCachingCloudInfo()
+	store.Set(fmt.Sprintf(cloudinfo.StatusKeyTemplate, testProvider), strconv.FormatInt(testScrapedAt.UnixNano()/int64(time.Millisecond), 10), 0)
+	return cpi
+}
+
+// newTestRouter creates a gin engine with the routes of the API served from newTestCloudInfo
+func newTestRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	cpi := newTestCloudInfo()
+	if err := ConfigureValidator(ctx, []string{testProvider, brokenProvider}, cpi); err != nil {
+		t.Fatal(err)
+	}
+	rates := &cloudinfo.StaticExchangeRates{Base: cloudinfo.CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+
+	router := gin.New()
+	NewRouteHandler(cpi, rates, buildinfo.BuildInfo{}).ConfigureRoutes(ctx, router)
+	return router
+}
+
+// serve serves a request with the router and returns the recorded response
+func serve(router http.Handler, method, path string, body io.Reader) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, body)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	router.ServeHTTP(w, req)
+	return w
+}
@@ -15,23 +15,70 @@
 package api
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/graph-gophers/graphql-go/errors"
 )
 
 const (
 	providerParam  = "provider"
 	attributeParam = "attribute"
+	osQueryParam   = "os"
+
+	pricingModelQueryParam  = "pricingModel"
+	paymentOptionQueryParam = "paymentOption"
+	currencyQueryParam      = "currency"
+
+	categoryQueryParam     = "category"
+	familyQueryParam       = "family"
+	generationQueryParam   = "generation"
+	sizeQueryParam         = "size"
+	burstQueryParam        = "burst"
+	minBandwidthQueryParam = "minBandwidth"
+
+	minCpuQueryParam          = "minCpu"
+	maxCpuQueryParam          = "maxCpu"
+	minMemQueryParam          = "minMem"
+	maxMemQueryParam          = "maxMem"
+	minGpuQueryParam          = "minGpu"
+	maxGpuQueryParam          = "maxGpu"
+	networkCategoryQueryParam = "networkCategory"
+	currentGenQueryParam      = "currentGen"
+	maxPriceQueryParam        = "maxPrice"
+	maxSpotPriceQueryParam    = "maxSpotPrice"
+	zoneQueryParam            = "zone"
+	sortQueryParam            = "sort"
+	orderQueryParam           = "order"
+	cursorQueryParam          = "cursor"
+	limitQueryParam           = "limit"
+
+	cpuQueryParam          = "cpu"
+	memQueryParam          = "mem"
+	gpuQueryParam          = "gpu"
+	providerQueryParam     = "provider"
+	regionQueryParam       = "region"
+	instanceTypeQueryParam = "instanceType"
+	providersQueryParam    = "providers"
+	priceTypeQueryParam    = "priceType"
+	geographyQueryParam    = "geography"
+	maxAgeQueryParam       = "maxAge"
+
+	zonesQueryParam    = "zones"
+	strategyQueryParam = "strategy"
+	countQueryParam    = "count"
 )
 
 // GetProviderPathParams is a placeholder for the providers related route path parameters
-// swagger:parameters getServices getProvider
+// swagger:parameters getServices getProvider getServicesV2 getProviderV2
 type GetProviderPathParams struct {
 	// in:path
 	Provider string `json:"provider"`
 }
 
 // GetServicesPathParams is a placeholder for the services related route path parameters
-// swagger:parameters getRegions getService
+// swagger:parameters getRegions getService getRegionsV2 getServiceV2
 type GetServicesPathParams struct {
 	GetProviderPathParams `mapstructure:",squash"`
 	// in:path
@@ -39,7 +86,7 @@ type GetServicesPathParams struct {
 }
 
 // GetRegionPathParams is a placeholder for the regions related route path parameters
-// swagger:parameters getRegion getImages getProducts getVersions
+// swagger:parameters getRegion getImages getProducts getVersions getStorage getNetworkPrices getRecommendations estimateCost getRegionV2 getImagesV2 getProductsV2 getVersionsV2 getStorageV2 getNetworkPricesV2 getRecommendationsV2 estimateCostV2
 type GetRegionPathParams struct {
 	GetServicesPathParams `mapstructure:",squash"`
 	// in:path
@@ -47,13 +94,225 @@ type GetRegionPathParams struct {
 }
 
 // GetAttributeValuesPathParams is a placeholder for the get attribute values route's path parameters
-// swagger:parameters getAttrValues
+// swagger:parameters getAttrValues getAttrValuesV2
 type GetAttributeValuesPathParams struct {
 	GetRegionPathParams `mapstructure:",squash"`
 	// in:path
 	Attribute string `json:"attribute"`
 }
 
+// GetSpotPricePathParams is a placeholder for the get spot price route's path parameters
+// swagger:parameters getSpotPrice getSpotPriceV2
+type GetSpotPricePathParams struct {
+	GetRegionPathParams `mapstructure:",squash"`
+	// in:path
+	InstanceType string `json:"instanceType"`
+}
+
+// GetSpotPriceQueryParams is a placeholder for the get spot price route's query parameters
+// swagger:parameters getSpotPrice getSpotPriceV2
+type GetSpotPriceQueryParams struct {
+	// Zones is the comma separated list of the zones the spot prices are aggregated over (every zone with a spot price by default)
+	// in:query
+	Zones string `json:"zones"`
+	// Strategy is the aggregation strategy: mean (default), min, max, median or cheapest
+	// in:query
+	Strategy string `json:"strategy"`
+	// Count is the number of the zones aggregated by the cheapest strategy
+	// in:query
+	Count int `json:"count"`
+	// Currency is the ISO 4217 code of the currency the price is converted to (the currency of the provider by default)
+	// in:query
+	Currency string `json:"currency"`
+}
+
+// GetProductsQueryParams is a placeholder for the get products route's query parameters
+// swagger:parameters getProducts getProductsV2
+type GetProductsQueryParams struct {
+	// Os selects the operating system / license the on demand prices are given for (linux by default)
+	// in:query
+	Os string `json:"os"`
+	// PricingModel selects the pricing model of the products: on-demand (default), commitment-1yr or commitment-3yr
+	// in:query
+	PricingModel string `json:"pricingModel"`
+	// PaymentOption selects the payment option of the commitments: no-upfront (default), partial-upfront or all-upfront
+	// in:query
+	PaymentOption string `json:"paymentOption"`
+	// Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+	// in:query
+	Currency string `json:"currency"`
+	// Category filters the products by their category: general, compute, memory, storage, accelerated or burstable
+	// in:query
+	Category string `json:"category"`
+	// Family filters the products by their instance family (eg.: m5, n1, Dv3)
+	// in:query
+	Family string `json:"family"`
+	// Generation filters the products by the generation of their instance family
+	// in:query
+	Generation int `json:"generation"`
+	// Size filters the products by their size within the instance family (eg.: large)
+	// in:query
+	Size string `json:"size"`
+	// Burst selects the burstable (true) or the non burstable (false) products
+	// in:query
+	Burst bool `json:"burst"`
+	// MinBandwidth filters out the products with a network bandwidth (Gbps) below the given value
+	// in:query
+	MinBandwidth float64 `json:"minBandwidth"`
+	// MinCPU filters out the products with less vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+	// in:query
+	MinCPU string `json:"minCpu"`
+	// MaxCPU filters out the products with more vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+	// in:query
+	MaxCPU string `json:"maxCpu"`
+	// MinMem filters out the products with less memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+	// in:query
+	MinMem string `json:"minMem"`
+	// MaxMem filters out the products with more memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+	// in:query
+	MaxMem string `json:"maxMem"`
+	// MinGpu filters out the products with less gpus
+	// in:query
+	MinGpu string `json:"minGpu"`
+	// MaxGpu filters out the products with more gpus
+	// in:query
+	MaxGpu string `json:"maxGpu"`
+	// NetworkCategory filters the products by their network performance category: low, medium, high or extra
+	// in:query
+	NetworkCategory string `json:"networkCategory"`
+	// CurrentGen selects the current generation (true) or the previous generation (false) products
+	// in:query
+	CurrentGen bool `json:"currentGen"`
+	// MaxPrice filters out the products with a higher on demand price, given in the requested currency and pricing model
+	// in:query
+	MaxPrice float64 `json:"maxPrice"`
+	// MaxSpotPrice filters out the products without a spot price up to the given value in any of their zones
+	// in:query
+	MaxSpotPrice float64 `json:"maxSpotPrice"`
+	// Zone selects the products available in a zone of the region, their spot prices are narrowed to the zone
+	// in:query
+	Zone string `json:"zone"`
+	// Sort orders the products by price or pricePerCpu, the products are ordered by their type when only paged
+	// in:query
+	Sort string `json:"sort"`
+	// Order is the sort order: asc (default) or desc
+	// in:query
+	Order string `json:"order"`
+	// Cursor is the nextCursor of the previous page, the first page is returned without cursor
+	// in:query
+	Cursor string `json:"cursor"`
+	// Limit is the maximum number of the products returned in a page (every product by default)
+	// in:query
+	Limit int `json:"limit"`
+}
+
+// GetStorageQueryParams is a placeholder for the get storage, network price, recommendation and estimate routes' query parameters
+// swagger:parameters getStorage getNetworkPrices getRecommendations estimateCost getStorageV2 getNetworkPricesV2 getRecommendationsV2 estimateCostV2
+type GetStorageQueryParams struct {
+	// Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+	// in:query
+	Currency string `json:"currency"`
+}
+
+// GetRecommendationsBodyParams is a placeholder for the recommendation route's request body
+// swagger:parameters getRecommendations getRecommendationsV2
+type GetRecommendationsBodyParams struct {
+	// in:body
+	Body cloudinfo.RecommendationRequest
+}
+
+// CompareQueryParams is a placeholder for the compare route's query parameters
+// swagger:parameters compare compareV2
+type CompareQueryParams struct {
+	// CPU is the number of vCPUs of the reference shape (eg.: 4, 500m)
+	// in:query
+	CPU string `json:"cpu"`
+	// Mem is the memory of the reference shape in GiB or as a Kubernetes style quantity (eg.: 16, 16Gi)
+	// in:query
+	Mem string `json:"mem"`
+	// Gpu is the number of gpus of the reference shape
+	// in:query
+	Gpu string `json:"gpu"`
+	// Provider is the provider of the reference instance type, used instead of the reference shape
+	// in:query
+	Provider string `json:"provider"`
+	// Region is the region of the reference instance type
+	// in:query
+	Region string `json:"region"`
+	// InstanceType is the reference instance type, its shape and price are compared with
+	// in:query
+	InstanceType string `json:"instanceType"`
+	// Providers is the comma separated list of the providers the matches are looked for (every provider by default)
+	// in:query
+	Providers string `json:"providers"`
+	// Count is the number of the closest matches listed per region (1 by default)
+	// in:query
+	Count int `json:"count"`
+	// Currency is the ISO 4217 code of the currency the prices are compared in (USD by default)
+	// in:query
+	Currency string `json:"currency"`
+}
+
+// CheapestRegionsQueryParams is a placeholder for the cheapest regions route's query parameters
+// swagger:parameters getCheapestRegions getCheapestRegionsV2
+type CheapestRegionsQueryParams struct {
+	// InstanceType is the instance type priced in the regions, the minimum resources are used if empty
+	// in:query
+	InstanceType string `json:"instanceType"`
+	// MinCPU is the minimum number of vCPUs of the instance types priced in the regions (eg.: 4, 500m)
+	// in:query
+	MinCPU string `json:"minCpu"`
+	// MinMem is the minimum memory of the instance types priced in the regions in GiB or as a Kubernetes style quantity
+	// in:query
+	MinMem string `json:"minMem"`
+	// MinGpu is the minimum number of gpus of the instance types priced in the regions
+	// in:query
+	MinGpu string `json:"minGpu"`
+	// PriceType is the price the regions are ranked by: on-demand (default) or spot
+	// in:query
+	PriceType string `json:"priceType"`
+	// Providers is the comma separated list of the providers whose regions are ranked (every provider by default)
+	// in:query
+	Providers string `json:"providers"`
+	// Geography is the comma separated list of the geographies of the regions: europe, north-america, south-america,
+	// asia-pacific, middle-east or africa
+	// in:query
+	Geography string `json:"geography"`
+	// MaxAge is the maximum age of the prices of a region in go syntax (eg.: 30m, 2h)
+	// in:query
+	MaxAge string `json:"maxAge"`
+	// Limit is the maximum number of the regions returned (every region by default)
+	// in:query
+	Limit int `json:"limit"`
+	// Currency is the ISO 4217 code of the currency the prices are compared in (USD by default)
+	// in:query
+	Currency string `json:"currency"`
+}
+
+// EstimateCostBodyParams is a placeholder for the cost estimate route's request body
+// swagger:parameters estimateCost estimateCostV2
+type EstimateCostBodyParams struct {
+	// in:body
+	Body cloudinfo.ClusterDescription
+}
+
+// GraphQLRequest is the body of a GraphQL request
+type GraphQLRequest struct {
+	// Query is the GraphQL query document
+	Query string `json:"query" binding:"required"`
+	// OperationName selects the operation to execute if the document holds more than one
+	OperationName string `json:"operationName"`
+	// Variables holds the values of the variables of the operation
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// GraphQLBodyParams is a placeholder for the graphql route's request body
+// swagger:parameters queryGraphQL
+type GraphQLBodyParams struct {
+	// in:body
+	Body GraphQLRequest
+}
+
 // ProductDetailsResponse Api object to be mapped to product info response
 // swagger:model ProductDetailsResponse
 type ProductDetailsResponse struct {
@@ -61,6 +320,10 @@ type ProductDetailsResponse struct {
 	Products []cloudinfo.ProductDetails `json:"products"`
 	// ScrapingTime represents scraping time for a given provider in milliseconds
 	ScrapingTime string `json:"scrapingTime"`
+	// Units describes the units the resources and the prices of the products are given in
+	Units cloudinfo.ResourceUnits `json:"units"`
+	// NextCursor is the cursor of the next page of the products, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 // RegionsResponse holds the list of available regions of a cloud provider
@@ -86,6 +349,8 @@ type GetRegionResp struct {
 type AttributeResponse struct {
 	AttributeName   string    `json:"attributeName"`
 	AttributeValues []float64 `json:"attributeValues"`
+	// Unit is the unit of the attribute values (vCPU or GiB)
+	Unit string `json:"unit"`
 }
 
 // ProviderResponse is the response used for the requested provider
@@ -118,6 +383,66 @@ type ImagesResponse struct {
 	Images []cloudinfo.Image `json:"images"`
 }
 
+// StorageResponse holds the list of the block storage volume types and their prices
+// swagger:model StorageResponse
+type StorageResponse struct {
+	Storage []cloudinfo.StorageInfo `json:"storage"`
+}
+
+// NetworkPricesResponse holds the load balancer and data transfer prices of a region
+// swagger:model NetworkPricesResponse
+type NetworkPricesResponse struct {
+	Network cloudinfo.NetworkPrices `json:"network"`
+}
+
+// SpotPriceResponse holds the spot price of an instance type aggregated over the zones of a region
+// swagger:model SpotPriceResponse
+type SpotPriceResponse struct {
+	Spot cloudinfo.SpotAggregate `json:"spot"`
+}
+
+// RecommendationsResponse holds the recommended node pools ranked by their cost, and the rejected instance types
+// swagger:model RecommendationsResponse
+type RecommendationsResponse struct {
+	Recommendations []cloudinfo.Recommendation `json:"recommendations"`
+	Rejected        []cloudinfo.RejectedType   `json:"rejected"`
+	// Currency is the ISO 4217 code of the currency the costs are given in
+	Currency string `json:"currency"`
+}
+
+// CompareResponse holds the closest matches of a reference in every provider region
+// swagger:model CompareResponse
+type CompareResponse struct {
+	// Reference is the shape the instance types are compared with
+	Reference cloudinfo.Shape `json:"reference"`
+	// ReferencePrice is the hourly on demand price of the reference instance type, 0 for a reference shape
+	ReferencePrice float64 `json:"referencePrice"`
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string                    `json:"currency"`
+	Matches  []cloudinfo.InstanceMatch `json:"matches"`
+}
+
+// CheapestRegionsResponse holds the regions ranked by the lowest price of an instance type or resources
+// swagger:model CheapestRegionsResponse
+type CheapestRegionsResponse struct {
+	Regions []cloudinfo.RegionPrice `json:"regions"`
+}
+
+// CostEstimateResponse holds the estimated cost of a cluster
+// swagger:model CostEstimateResponse
+type CostEstimateResponse struct {
+	Estimate cloudinfo.CostEstimate `json:"estimate"`
+}
+
+// GraphQLResponse holds the result of a GraphQL query
+// swagger:model GraphQLResponse
+type GraphQLResponse struct {
+	// Data holds the selected fields, missing if the query could not be executed
+	Data json.RawMessage `json:"data,omitempty"`
+	// Errors holds the errors of the query and of the fields that could not be resolved
+	Errors []*errors.QueryError `json:"errors,omitempty"`
+}
+
 // VersionsResponse holds the list of available versions
 // swagger:model VersionsResponse
 type VersionsResponse struct {
@@ -127,10 +452,176 @@ type VersionsResponse struct {
 // ErrorResponse struct for error responses
 // swagger:model ErrorResponse
 type ErrorResponse struct {
-	ErrorCode    string `json:"code,omitempty"`
+	// ErrorCode is a stable, machine readable code (eg.: not_found, not_yet_available)
+	ErrorCode string `json:"code,omitempty"`
+	// ErrorMessage is the human readable description of the error
 	ErrorMessage string `json:"message,omitempty"`
 }
 
+// Meta holds the metadata of the v2 responses
+// swagger:model Meta
+type Meta struct {
+	// Generation identifies the scrape of the provider the data comes from (its completion time in unix milliseconds)
+	Generation int64 `json:"generation,omitempty"`
+	// Freshness tells how old the data is
+	Freshness *Freshness `json:"freshness,omitempty"`
+	// Pagination describes the page of the listed items
+	Pagination *Pagination `json:"pagination,omitempty"`
+	// Units describes the units the resources and the prices are given in
+	Units *cloudinfo.ResourceUnits `json:"units,omitempty"`
+}
+
+// Freshness holds the age of the data of a response
+// swagger:model Freshness
+type Freshness struct {
+	// ScrapedAt is the completion time of the last scrape of the provider
+	ScrapedAt time.Time `json:"scrapedAt"`
+	// PricesUpdatedAt is the retrieval time of the oldest prices of the data, missing if not priced
+	PricesUpdatedAt *time.Time `json:"pricesUpdatedAt,omitempty"`
+	// AgeSeconds is the number of seconds elapsed since the last scrape of the provider
+	AgeSeconds int64 `json:"ageSeconds"`
+}
+
+// Pagination describes a page of the listed items
+// swagger:model Pagination
+type Pagination struct {
+	// Limit is the maximum number of the items on a page, missing if not paged
+	Limit int `json:"limit,omitempty"`
+	// Total is the number of the items matching the request on every page
+	Total int `json:"total"`
+	// NextCursor is the cursor of the next page, missing on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// ErrorEnvelope is the v2 response of a failed request
+// swagger:model ErrorEnvelope
+type ErrorEnvelope struct {
+	// Data is always null
+	Data interface{} `json:"data"`
+	Meta Meta        `json:"meta"`
+	// Errors holds the errors the request failed with
+	Errors []ErrorResponse `json:"errors"`
+}
+
+// ProvidersEnvelope is the v2 response listing the supported providers
+// swagger:model ProvidersEnvelope
+type ProvidersEnvelope struct {
+	Data []cloudinfo.Provider `json:"data"`
+	Meta Meta                 `json:"meta"`
+}
+
+// ProviderEnvelope is the v2 response describing a provider
+// swagger:model ProviderEnvelope
+type ProviderEnvelope struct {
+	Data cloudinfo.Provider `json:"data"`
+	Meta Meta               `json:"meta"`
+}
+
+// ServicesEnvelope is the v2 response listing the services of a provider
+// swagger:model ServicesEnvelope
+type ServicesEnvelope struct {
+	Data []cloudinfo.Service `json:"data"`
+	Meta Meta                `json:"meta"`
+}
+
+// ServiceEnvelope is the v2 response describing a service of a provider
+// swagger:model ServiceEnvelope
+type ServiceEnvelope struct {
+	Data cloudinfo.Service `json:"data"`
+	Meta Meta              `json:"meta"`
+}
+
+// RegionsEnvelope is the v2 response listing the regions of a service, ordered by their id
+// swagger:model RegionsEnvelope
+type RegionsEnvelope struct {
+	Data []Region `json:"data"`
+	Meta Meta     `json:"meta"`
+}
+
+// RegionEnvelope is the v2 response describing a region
+// swagger:model RegionEnvelope
+type RegionEnvelope struct {
+	Data GetRegionResp `json:"data"`
+	Meta Meta          `json:"meta"`
+}
+
+// ImagesEnvelope is the v2 response listing the images of a region
+// swagger:model ImagesEnvelope
+type ImagesEnvelope struct {
+	Data []cloudinfo.Image `json:"data"`
+	Meta Meta              `json:"meta"`
+}
+
+// VersionsEnvelope is the v2 response listing the versions available in a region
+// swagger:model VersionsEnvelope
+type VersionsEnvelope struct {
+	Data []string `json:"data"`
+	Meta Meta     `json:"meta"`
+}
+
+// ProductsEnvelope is the v2 response listing a page of the products of a region
+// swagger:model ProductsEnvelope
+type ProductsEnvelope struct {
+	Data []cloudinfo.ProductDetails `json:"data"`
+	Meta Meta                       `json:"meta"`
+}
+
+// AttributeEnvelope is the v2 response listing the values of an attribute
+// swagger:model AttributeEnvelope
+type AttributeEnvelope struct {
+	Data AttributeResponse `json:"data"`
+	Meta Meta              `json:"meta"`
+}
+
+// StorageEnvelope is the v2 response listing the block storage volume types of a region
+// swagger:model StorageEnvelope
+type StorageEnvelope struct {
+	Data []cloudinfo.StorageInfo `json:"data"`
+	Meta Meta                    `json:"meta"`
+}
+
+// NetworkPricesEnvelope is the v2 response holding the network prices of a region
+// swagger:model NetworkPricesEnvelope
+type NetworkPricesEnvelope struct {
+	Data cloudinfo.NetworkPrices `json:"data"`
+	Meta Meta                    `json:"meta"`
+}
+
+// SpotPriceEnvelope is the v2 response holding the aggregated spot price of an instance type
+// swagger:model SpotPriceEnvelope
+type SpotPriceEnvelope struct {
+	Data cloudinfo.SpotAggregate `json:"data"`
+	Meta Meta                    `json:"meta"`
+}
+
+// RecommendationsEnvelope is the v2 response holding the recommended node pools
+// swagger:model RecommendationsEnvelope
+type RecommendationsEnvelope struct {
+	Data RecommendationsResponse `json:"data"`
+	Meta Meta                    `json:"meta"`
+}
+
+// CostEstimateEnvelope is the v2 response holding the estimated cost of a cluster
+// swagger:model CostEstimateEnvelope
+type CostEstimateEnvelope struct {
+	Data cloudinfo.CostEstimate `json:"data"`
+	Meta Meta                   `json:"meta"`
+}
+
+// CompareEnvelope is the v2 response holding the closest matches of a reference
+// swagger:model CompareEnvelope
+type CompareEnvelope struct {
+	Data CompareResponse `json:"data"`
+	Meta Meta            `json:"meta"`
+}
+
+// CheapestRegionsEnvelope is the v2 response holding the regions ranked by price
+// swagger:model CheapestRegionsEnvelope
+type CheapestRegionsEnvelope struct {
+	Data []cloudinfo.RegionPrice `json:"data"`
+	Meta Meta                    `json:"meta"`
+}
+
 // NewErrorResponse creates a new ErrorResponse struct
 func NewErrorResponse(code, message string) ErrorResponse {
 	return ErrorResponse{
@@ -142,7 +633,7 @@ func NewErrorResponse(code, message string) ErrorResponse {
 // NewServiceResponse assembles a service response
 func NewServiceResponse(sd cloudinfo.ServiceDescriber) ServiceResponse {
 	return ServiceResponse{
-		Service: cloudinfo.NewService(sd.ServiceName()),
+		Service: cloudinfo.ServiceOf(sd),
 	}
 }
 
@@ -150,7 +641,7 @@ func NewServiceResponse(sd cloudinfo.ServiceDescriber) ServiceResponse {
 func NewServicesResponse(sds []cloudinfo.ServiceDescriber) ServicesResponse {
 	var services []cloudinfo.Service
 	for _, sd := range sds {
-		services = append(services, cloudinfo.NewService(sd.ServiceName()))
+		services = append(services, cloudinfo.ServiceOf(sd))
 	}
 	return ServicesResponse{
 		Services: services,
@@ -17,7 +17,6 @@ package api
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"reflect"
 
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
@@ -75,12 +74,8 @@ func ValidatePathParam(ctx context.Context, name string, validate *validator.Val
 			err := validate.Field(p, tag)
 			if err != nil {
 				logger.Extract(ctx).WithError(err).Error("validation failed.")
+				c.Error(cloudinfo.NewInvalidArgumentError("invalid %s parameter: %s", name, p))
 				c.Abort()
-				c.JSON(http.StatusBadRequest, gin.H{
-					"code":    "bad_params",
-					"message": fmt.Sprintf("invalid %s parameter", name),
-					"params":  map[string]string{name: p},
-				})
 				return
 			}
 		}
@@ -106,7 +101,8 @@ func ValidatePathData(ctx context.Context, validate *validator.Validate) gin.Han
 		}
 
 		if err := mapstructure.Decode(getPathParamMap(c), pathData); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"status": http.StatusBadRequest, "message": fmt.Sprintf("%s", err)})
+			c.Error(cloudinfo.NewInvalidArgumentError("%s", err))
+			c.Abort()
 			return
 		}
 
@@ -114,18 +110,72 @@ func ValidatePathData(ctx context.Context, validate *validator.Validate) gin.Han
 		err := validate.Struct(pathData)
 		if err != nil {
 			log.WithError(err).Error("validation failed.")
+			c.Error(cloudinfo.NewInvalidArgumentError("invalid path parameter value: %s", pathData))
 			c.Abort()
-			c.JSON(http.StatusBadRequest, gin.H{
-				"code":    "bad_params",
-				"message": fmt.Sprintf("invalid path parameter value: %s", pathData),
-				"params":  pathData,
-			})
 			return
 		}
 	}
 
 }
 
+// ValidatePathResources is a gin middleware that checks that the provider, service, region and attribute in the request
+// path exist, registering a not found error otherwise
+func ValidatePathResources(ctx context.Context, cpi *cloudinfo.CachingCloudInfo) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := validatePathResources(ctx, cpi, c); err != nil {
+			c.Error(err)
+			c.Abort()
+		}
+	}
+}
+
+func validatePathResources(ctx context.Context, cpi *cloudinfo.CachingCloudInfo, c *gin.Context) error {
+	provider, ok := c.Params.Get(providerParam)
+	if !ok {
+		return nil
+	}
+	infoer, err := cpi.GetInfoer(provider)
+	if err != nil {
+		return err
+	}
+
+	service, ok := c.Params.Get("service")
+	if !ok {
+		return nil
+	}
+	services, err := infoer.GetServices()
+	if err != nil {
+		return cloudinfo.NewProviderUnavailableError(provider, err)
+	}
+	found := false
+	for _, svc := range services {
+		if svc.ServiceName() == service {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return cloudinfo.NewNotFoundError("unknown service: [%s]", service)
+	}
+
+	region, ok := c.Params.Get("region")
+	if !ok {
+		return nil
+	}
+	regions, err := cpi.GetRegions(ctx, provider, service)
+	if err != nil {
+		return err
+	}
+	if _, ok := regions[region]; !ok {
+		return cloudinfo.NewNotFoundError("unknown region: [%s]", region)
+	}
+
+	if attribute, ok := c.Params.Get(attributeParam); ok && !cloudinfo.Contains(cpi.GetAttributes(), attribute) {
+		return cloudinfo.NewNotFoundError("unknown attribute: [%s]", attribute)
+	}
+	return nil
+}
+
 // validationFn validation logic for the region data to be registered with the validator
 func regionValidator(ctx context.Context, cpi *cloudinfo.CachingCloudInfo) validator.Func {
 
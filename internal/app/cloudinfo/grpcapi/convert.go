@@ -0,0 +1,127 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"sort"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	pb "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-grpc"
+)
+
+// newService converts a service to its protobuf message
+func newService(sd cloudinfo.ServiceDescriber) *pb.Service {
+	service := &pb.Service{Name: sd.ServiceName()}
+	if fee := sd.ControlPlane(); fee != nil {
+		service.ControlPlane = &pb.ControlPlaneFee{
+			Model:        fee.Model,
+			PricePerHour: fee.PricePerHour,
+			FreeClusters: int32(fee.FreeClusters),
+			Currency:     fee.Currency,
+		}
+	}
+	return service
+}
+
+// newProvider converts a provider with its services to its protobuf message
+func newProvider(p cloudinfo.Provider) *pb.Provider {
+	provider := &pb.Provider{Name: p.Provider}
+	for _, s := range p.Services {
+		provider.Services = append(provider.Services, newService(s))
+	}
+	return provider
+}
+
+// newRegions converts the regions (id - name pairs) to protobuf messages ordered by id
+func newRegions(regions map[string]string) []*pb.Region {
+	var msgs []*pb.Region
+	for id, name := range regions {
+		msgs = append(msgs, &pb.Region{Id: id, Name: name})
+	}
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].Id < msgs[j].Id
+	})
+	return msgs
+}
+
+// newProduct converts the product details to its protobuf message
+func newProduct(d cloudinfo.ProductDetails) *pb.Product {
+	product := &pb.Product{
+		Type:            d.Type,
+		Category:        d.Category,
+		Family:          d.Family,
+		Generation:      int32(d.Generation),
+		Size:            d.Size,
+		Cpus:            d.Cpus,
+		CpuCores:        d.CpuCores,
+		Mem:             d.Mem,
+		Gpus:            d.Gpus,
+		GpuModel:        d.GpuModel,
+		GpuMem:          d.GpuMem,
+		Arch:            d.Arch,
+		ProcessorFamily: d.ProcessorFamily,
+		NtwPerf:         d.NtwPerf,
+		NtwPerfCategory: d.NtwPerfCat,
+		Bandwidth:       d.Bandwidth,
+		BandwidthUpTo:   d.BandwidthUpTo,
+		Zones:           d.Zones,
+		CurrentGen:      d.CurrentGen,
+		Burst:           d.Burst,
+		LocalDisks:      int32(d.LocalDisks),
+		LocalDiskSize:   d.LocalDiskSize,
+		LocalDiskType:   d.LocalDiskType,
+		PremiumStorage:  d.PremiumStorage,
+		MaxNics:         int32(d.MaxNics),
+		Hypervisor:      d.Hypervisor,
+		Attributes:      d.Attributes,
+		Currency:        d.Currency,
+		OnDemandPrice:   d.OnDemandPrice,
+		OsPrices:        d.OsPrices,
+	}
+	if d.BurstInfo != nil {
+		product.BurstInfo = &pb.BurstInfo{
+			BaselineCpuPercent: d.BurstInfo.BaselineCpu,
+			CreditsPerHour:     d.BurstInfo.CreditsPerHour,
+			Unlimited:          d.BurstInfo.Unlimited,
+		}
+	}
+	for _, zp := range d.SpotInfo {
+		product.SpotPrices = append(product.SpotPrices, &pb.ZonePrice{Zone: zp.Zone, Price: zp.Price})
+	}
+	if len(d.SpotStats) > 0 {
+		product.SpotStats = make(map[string]*pb.SpotStats, len(d.SpotStats))
+		for zone, stats := range d.SpotStats {
+			product.SpotStats[zone] = &pb.SpotStats{
+				Min:        stats.Min,
+				Max:        stats.Max,
+				Mean:       stats.Mean,
+				P50:        stats.P50,
+				P90:        stats.P90,
+				Volatility: stats.Volatility,
+				Samples:    int32(stats.Samples),
+			}
+		}
+	}
+	for _, c := range d.Commitments {
+		product.Commitments = append(product.Commitments, &pb.CommitmentPrice{
+			Term:            c.Term,
+			PaymentOption:   c.PaymentOption,
+			Upfront:         c.Upfront,
+			Hourly:          c.Hourly,
+			EffectiveHourly: c.EffectiveHourly,
+		})
+	}
+	return product
+}
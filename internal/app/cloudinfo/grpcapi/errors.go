@@ -0,0 +1,43 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps the typed errors of the cloudinfo package to gRPC status errors
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Error(errorCode(err), err.Error())
+}
+
+// errorCode maps the error to the gRPC status code, the counterpart of the HTTP status codes of the REST API
+func errorCode(err error) codes.Code {
+	switch err.(type) {
+	case cloudinfo.NotFoundError:
+		return codes.NotFound
+	case cloudinfo.NotYetAvailableError, cloudinfo.ProviderUnavailableError:
+		return codes.Unavailable
+	case cloudinfo.InvalidArgumentError:
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
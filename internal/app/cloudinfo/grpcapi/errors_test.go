@@ -0,0 +1,70 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{
+			name: "not found",
+			err:  cloudinfo.NewNotFoundError("unsupported region: [%s]", "region"),
+			code: codes.NotFound,
+		},
+		{
+			name: "not yet available",
+			err:  cloudinfo.NewNotYetAvailableError("vms not yet cached"),
+			code: codes.Unavailable,
+		},
+		{
+			name: "provider unavailable",
+			err:  cloudinfo.NewProviderUnavailableError("dummy", errors.New("timeout")),
+			code: codes.Unavailable,
+		},
+		{
+			name: "invalid argument",
+			err:  cloudinfo.NewInvalidArgumentError("invalid limit: [%s]", "x"),
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "untyped error",
+			err:  errors.New("unexpected"),
+			code: codes.Internal,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			st, ok := status.FromError(toStatus(test.err))
+			assert.True(t, ok, "the error should be a status error")
+			assert.Equal(t, test.code, st.Code())
+			assert.Equal(t, test.err.Error(), st.Message(), "the message should be kept")
+		})
+	}
+
+	assert.Nil(t, toStatus(nil), "the status of a nil error should be nil")
+}
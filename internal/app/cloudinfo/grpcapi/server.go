@@ -0,0 +1,342 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	pb "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-grpc"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	// serviceName is the fully qualified name of the CloudInfo gRPC service, as known by the health checks
+	serviceName = "cloudinfo.v1.CloudInfo"
+
+	// healthCheckInterval is the period the serving status of the health service is updated with
+	healthCheckInterval = 10 * time.Second
+)
+
+// Server implements the CloudInfo gRPC service from the cached cloud information
+type Server struct {
+	prod          *cloudinfo.CachingCloudInfo
+	exchangeRates cloudinfo.ExchangeRater
+	health        *health.Server
+}
+
+// NewServer creates a new Server and returns a reference to it
+func NewServer(p *cloudinfo.CachingCloudInfo, er cloudinfo.ExchangeRater) *Server {
+	return &Server{
+		prod:          p,
+		exchangeRates: er,
+		health:        health.NewServer(),
+	}
+}
+
+// Register registers the CloudInfo service, the standard health service and the server reflection on the gRPC server
+func (s *Server) Register(gs *grpc.Server) {
+	pb.RegisterCloudInfoServer(gs, s)
+
+	s.updateHealth()
+	healthpb.RegisterHealthServer(gs, s.health)
+
+	reflection.Register(gs)
+}
+
+// updateHealth sets the serving status of the health service from the scrapes of the providers: the CloudInfo service
+// is serving while the scraped information of any provider is available, every provider is reported as well under
+// the name of the service suffixed with the provider (eg.: cloudinfo.v1.CloudInfo/amazon)
+func (s *Server) updateHealth() {
+	serving := healthpb.HealthCheckResponse_NOT_SERVING
+	for _, provider := range s.prod.ProviderNames() {
+		providerServing := healthpb.HealthCheckResponse_NOT_SERVING
+		if _, err := s.prod.GetStatus(provider); err == nil {
+			providerServing = healthpb.HealthCheckResponse_SERVING
+			serving = healthpb.HealthCheckResponse_SERVING
+		}
+		s.health.SetServingStatus(serviceName+"/"+provider, providerServing)
+	}
+	s.health.SetServingStatus("", serving)
+	s.health.SetServingStatus(serviceName, serving)
+}
+
+// watchHealth keeps the serving status of the health service up to date until the context is done
+func (s *Server) watchHealth(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.updateHealth()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ListenAndServe serves the gRPC API on the given address until the context is done
+func (s *Server) ListenAndServe(ctx context.Context, address string) error {
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	gs := grpc.NewServer()
+	s.Register(gs)
+
+	go s.watchHealth(ctx)
+	go func() {
+		<-ctx.Done()
+		s.health.Shutdown()
+		gs.GracefulStop()
+	}()
+
+	logger.Extract(ctx).WithField("address", address).Info("serving the grpc api")
+	return gs.Serve(lis)
+}
+
+// GetProviders returns the supported providers with their services
+func (s *Server) GetProviders(ctx context.Context, req *pb.GetProvidersRequest) (*pb.GetProvidersResponse, error) {
+	providers := s.prod.GetProviders(ctx)
+	if len(providers) < 1 {
+		return nil, toStatus(cloudinfo.ErrNoProviders)
+	}
+
+	response := &pb.GetProvidersResponse{}
+	for _, p := range providers {
+		response.Providers = append(response.Providers, newProvider(p))
+	}
+	return response, nil
+}
+
+// GetProvider returns a supported provider
+func (s *Server) GetProvider(ctx context.Context, req *pb.GetProviderRequest) (*pb.Provider, error) {
+	provider, err := s.prod.GetProvider(ctx, req.Provider)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return newProvider(provider), nil
+}
+
+// GetServices returns the services of a provider
+func (s *Server) GetServices(ctx context.Context, req *pb.GetServicesRequest) (*pb.GetServicesResponse, error) {
+	infoer, err := s.prod.GetInfoer(req.Provider)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	services, err := infoer.GetServices()
+	if err != nil {
+		return nil, toStatus(cloudinfo.NewProviderUnavailableError(req.Provider, err))
+	}
+
+	response := &pb.GetServicesResponse{}
+	for _, sd := range services {
+		response.Services = append(response.Services, newService(sd))
+	}
+	return response, nil
+}
+
+// GetService returns a service of a provider
+func (s *Server) GetService(ctx context.Context, req *pb.GetServiceRequest) (*pb.Service, error) {
+	sd, err := s.service(ctx, req.Provider, req.Service)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return newService(sd), nil
+}
+
+// GetRegions returns the regions of a service
+func (s *Server) GetRegions(ctx context.Context, req *pb.GetRegionsRequest) (*pb.GetRegionsResponse, error) {
+	if _, err := s.service(ctx, req.Provider, req.Service); err != nil {
+		return nil, toStatus(err)
+	}
+	regions, err := s.prod.GetRegions(ctx, req.Provider, req.Service)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetRegionsResponse{Regions: newRegions(regions)}, nil
+}
+
+// GetRegion returns a region of a service with its availability zones
+func (s *Server) GetRegion(ctx context.Context, req *pb.GetRegionRequest) (*pb.Region, error) {
+	ctx = logContext(ctx, req.Provider, req.Service, req.Region)
+
+	name, err := s.region(ctx, req.Provider, req.Service, req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	zones, err := s.prod.GetZones(ctx, req.Provider, req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.Region{Id: req.Region, Name: name, Zones: zones}, nil
+}
+
+// ListProducts streams the filtered and sorted products of a region
+func (s *Server) ListProducts(req *pb.ListProductsRequest, stream pb.CloudInfo_ListProductsServer) error {
+	ctx := logContext(stream.Context(), req.Provider, req.Service, req.Region)
+
+	log := logger.Extract(ctx)
+	log.Info("streaming product details")
+
+	details, err := s.products(ctx, req)
+	if err != nil {
+		return toStatus(err)
+	}
+	for _, d := range details {
+		if err := stream.Send(newProduct(d)); err != nil {
+			return err
+		}
+	}
+
+	log.Debugf("successfully streamed %d product details", len(details))
+	return nil
+}
+
+// products retrieves the products of the region, filtered and sorted as by the products endpoint of the REST API
+func (s *Server) products(ctx context.Context, req *pb.ListProductsRequest) ([]cloudinfo.ProductDetails, error) {
+	if _, err := s.region(ctx, req.Provider, req.Service, req.Region); err != nil {
+		return nil, err
+	}
+	details, err := s.prod.QueryProducts(ctx, req.Provider, req.Service, req.Region, cloudinfo.ProductQuery{
+		Category:     req.Category,
+		Family:       req.Family,
+		Generation:   req.Generation,
+		Size:         req.Size,
+		Burst:        req.Burst,
+		MinBandwidth: req.MinBandwidth,
+		Resources: cloudinfo.ResourceFilter{
+			MinCpu: req.MinCpu,
+			MaxCpu: req.MaxCpu,
+			MinMem: req.MinMem,
+			MaxMem: req.MaxMem,
+			MinGpu: req.MinGpu,
+			MaxGpu: req.MaxGpu,
+		},
+		NetworkCategory: req.NetworkCategory,
+		CurrentGen:      req.CurrentGen,
+		Zone:            req.Zone,
+		Os:              req.Os,
+		PricingModel:    req.PricingModel,
+		PaymentOption:   req.PaymentOption,
+		Currency:        req.Currency,
+		MaxPrice:        req.MaxPrice,
+		MaxSpotPrice:    req.MaxSpotPrice,
+	}, s.exchangeRates)
+	if err != nil {
+		return nil, err
+	}
+
+	var limit string
+	if req.Limit > 0 {
+		limit = strconv.Itoa(int(req.Limit))
+	}
+	details, _, err = cloudinfo.PageProducts(details, req.Sort, req.Order, "", limit)
+	return details, err
+}
+
+// GetAttributeValues returns the values of a product attribute of a service
+func (s *Server) GetAttributeValues(ctx context.Context, req *pb.GetAttributeValuesRequest) (*pb.GetAttributeValuesResponse, error) {
+	if !cloudinfo.Contains(s.prod.GetAttributes(), req.Attribute) {
+		return nil, toStatus(cloudinfo.NewInvalidArgumentError("invalid attribute: [%s]", req.Attribute))
+	}
+	if _, err := s.service(ctx, req.Provider, req.Service); err != nil {
+		return nil, toStatus(err)
+	}
+	values, err := s.prod.GetAttrValues(ctx, req.Provider, req.Service, req.Attribute)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetAttributeValuesResponse{
+		Attribute: req.Attribute,
+		Values:    values,
+		Unit:      cloudinfo.AttrUnit(req.Attribute),
+	}, nil
+}
+
+// GetImages returns the images of a service in a region
+func (s *Server) GetImages(ctx context.Context, req *pb.GetImagesRequest) (*pb.GetImagesResponse, error) {
+	ctx = logContext(ctx, req.Provider, req.Service, req.Region)
+
+	if _, err := s.region(ctx, req.Provider, req.Service, req.Region); err != nil {
+		return nil, toStatus(err)
+	}
+	images, err := s.prod.GetServiceImages(ctx, req.Provider, req.Service, req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &pb.GetImagesResponse{}
+	for _, image := range images {
+		response.Images = append(response.Images, image.ImageName())
+	}
+	return response, nil
+}
+
+// GetVersions returns the versions of a service in a region
+func (s *Server) GetVersions(ctx context.Context, req *pb.GetVersionsRequest) (*pb.GetVersionsResponse, error) {
+	ctx = logContext(ctx, req.Provider, req.Service, req.Region)
+
+	if _, err := s.region(ctx, req.Provider, req.Service, req.Region); err != nil {
+		return nil, toStatus(err)
+	}
+	versions, err := s.prod.GetVersions(ctx, req.Provider, req.Service, req.Region)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetVersionsResponse{Versions: versions}, nil
+}
+
+// service returns the service of the provider, a not found error if either of them is not supported
+func (s *Server) service(ctx context.Context, provider, service string) (cloudinfo.ServiceDescriber, error) {
+	infoer, err := s.prod.GetInfoer(provider)
+	if err != nil {
+		return nil, err
+	}
+	return infoer.GetService(ctx, service)
+}
+
+// region returns the name of the region of the service, a not found error if it is not supported
+func (s *Server) region(ctx context.Context, provider, service, region string) (string, error) {
+	if _, err := s.service(ctx, provider, service); err != nil {
+		return "", err
+	}
+	regions, err := s.prod.GetRegions(ctx, provider, service)
+	if err != nil {
+		return "", err
+	}
+	name, ok := regions[region]
+	if !ok {
+		return "", cloudinfo.NewNotFoundError("unsupported region: [%s]", region)
+	}
+	return name, nil
+}
+
+// logContext adds the provider, service and region of the request to the logger of the context
+func logContext(ctx context.Context, provider, service, region string) context.Context {
+	return logger.ToContext(ctx, logger.NewLogCtxBuilder().
+		WithProvider(provider).
+		WithService(service).
+		WithRegion(region).
+		Build())
+}
@@ -0,0 +1,219 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	pb "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-grpc"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+)
+
+// newTestServer creates a Server with two products of the dummy provider cached in region-1
+func newTestServer() (*Server, *cache.Cache) {
+	cpi, store := cloudinfotest.NewCachingCloudInfo()
+	rates := &cloudinfo.StaticExchangeRates{Base: cloudinfo.CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	return NewServer(cpi, rates), store
+}
+
+// productStream collects the products streamed by ListProducts
+type productStream struct {
+	grpc.ServerStream
+	products []*pb.Product
+}
+
+func (ps *productStream) Context() context.Context {
+	return context.Background()
+}
+
+func (ps *productStream) Send(product *pb.Product) error {
+	ps.products = append(ps.products, product)
+	return nil
+}
+
+// assertCode checks the status code of the error
+func assertCode(t *testing.T, code codes.Code, err error) {
+	st, ok := status.FromError(err)
+	if assert.True(t, ok, "the error should be a status error") {
+		assert.Equal(t, code, st.Code(), "unexpected status code: %s", err)
+	}
+}
+
+func TestServer_GetProviders(t *testing.T) {
+	server, _ := newTestServer()
+
+	providers, err := server.GetProviders(context.Background(), &pb.GetProvidersRequest{})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Len(t, providers.Providers, 2)
+
+	provider, err := server.GetProvider(context.Background(), &pb.GetProviderRequest{Provider: "dummy"})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, "dummy", provider.Name)
+
+	_, err = server.GetProvider(context.Background(), &pb.GetProviderRequest{Provider: "unknown"})
+	assertCode(t, codes.NotFound, err)
+
+	cpi, _ := cloudinfo.NewCachingCloudInfo(time.Hour, cache.New(cache.NoExpiration, time.Hour),
+		map[string]cloudinfo.CloudInfoer{}, cloudinfo.DefaultNetworkCategories())
+	_, err = NewServer(cpi, nil).GetProviders(context.Background(), &pb.GetProvidersRequest{})
+	assertCode(t, codes.Internal, err)
+}
+
+func TestServer_GetServices(t *testing.T) {
+	server, _ := newTestServer()
+
+	services, err := server.GetServices(context.Background(), &pb.GetServicesRequest{Provider: "dummy"})
+	assert.Nil(t, err, "the error should be nil")
+	if assert.Len(t, services.Services, 1) {
+		assert.Equal(t, "compute", services.Services[0].Name)
+	}
+
+	_, err = server.GetServices(context.Background(), &pb.GetServicesRequest{Provider: "broken"})
+	assertCode(t, codes.Unavailable, err)
+
+	_, err = server.GetService(context.Background(), &pb.GetServiceRequest{Provider: "dummy", Service: "unknown"})
+	assertCode(t, codes.NotFound, err)
+}
+
+func TestServer_GetRegion(t *testing.T) {
+	server, _ := newTestServer()
+
+	regions, err := server.GetRegions(context.Background(), &pb.GetRegionsRequest{Provider: "dummy", Service: "compute"})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Len(t, regions.Regions, 2)
+
+	region, err := server.GetRegion(context.Background(), &pb.GetRegionRequest{Provider: "dummy", Service: "compute", Region: "region-1"})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, "Region 1", region.Name)
+	assert.Equal(t, []string{"region-1a", "region-1b"}, region.Zones)
+
+	_, err = server.GetRegion(context.Background(), &pb.GetRegionRequest{Provider: "dummy", Service: "compute", Region: "unknown"})
+	assertCode(t, codes.NotFound, err)
+}
+
+func TestServer_ListProducts(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     pb.ListProductsRequest
+		checker func(products []*pb.Product, err error)
+	}{
+		{
+			name: "sorted and limited",
+			req:  pb.ListProductsRequest{Sort: "price", Order: "desc", Limit: 1},
+			checker: func(products []*pb.Product, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Len(t, products, 1) {
+					assert.Equal(t, "large", products[0].Type)
+					assert.Equal(t, []*pb.ZonePrice{{Zone: "region-1a", Price: 0.12}}, products[0].SpotPrices)
+				}
+			},
+		},
+		{
+			name: "filtered and converted",
+			req:  pb.ListProductsRequest{MaxCpu: "4", Currency: "EUR"},
+			checker: func(products []*pb.Product, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Len(t, products, 1) {
+					assert.Equal(t, "small", products[0].Type)
+					assert.Equal(t, "EUR", products[0].Currency)
+					assert.InDelta(t, 0.05, products[0].OnDemandPrice, 1e-9)
+				}
+			},
+		},
+		{
+			name: "unknown zone",
+			req:  pb.ListProductsRequest{Zone: "region-2a"},
+			checker: func(products []*pb.Product, err error) {
+				assertCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "invalid filter",
+			req:  pb.ListProductsRequest{MinMem: "x"},
+			checker: func(products []*pb.Product, err error) {
+				assertCode(t, codes.InvalidArgument, err)
+			},
+		},
+		{
+			name: "unknown region",
+			req:  pb.ListProductsRequest{Region: "unknown"},
+			checker: func(products []*pb.Product, err error) {
+				assertCode(t, codes.NotFound, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newTestServer()
+			req := test.req
+			req.Provider = "dummy"
+			req.Service = "compute"
+			if req.Region == "" {
+				req.Region = "region-1"
+			}
+			stream := &productStream{}
+			err := server.ListProducts(&req, stream)
+			test.checker(stream.products, err)
+		})
+	}
+}
+
+func TestServer_GetAttributeValues(t *testing.T) {
+	server, _ := newTestServer()
+
+	values, err := server.GetAttributeValues(context.Background(),
+		&pb.GetAttributeValuesRequest{Provider: "dummy", Service: "compute", Attribute: cloudinfo.Cpu})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, []float64{2, 8}, values.Values)
+
+	_, err = server.GetAttributeValues(context.Background(),
+		&pb.GetAttributeValuesRequest{Provider: "dummy", Service: "compute", Attribute: "unknown"})
+	assertCode(t, codes.InvalidArgument, err)
+}
+
+func TestServer_updateHealth(t *testing.T) {
+	server, store := newTestServer()
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		response, err := server.health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if !assert.Nil(t, err, "the error should be nil") {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return response.Status
+	}
+
+	server.updateHealth()
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(""), "nothing should be served before a scrape")
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(serviceName))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(serviceName+"/dummy"))
+
+	store.Set(fmt.Sprintf(cloudinfo.StatusKeyTemplate, "dummy"), strconv.FormatInt(time.Now().UnixNano()/1e6, 10), 0)
+	server.updateHealth()
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(serviceName))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, check(serviceName+"/dummy"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, check(serviceName+"/broken"))
+}
@@ -32,6 +32,20 @@ func (o *GetAttrValuesReader) ReadResponse(response runtime.ClientResponse, cons
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetAttrValuesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetAttrValuesBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetAttrValuesOK) readResponse(response runtime.ClientResponse, consumer
 
 	return nil
 }
+
+// NewGetAttrValuesBadRequest creates a GetAttrValuesBadRequest with default headers values
+func NewGetAttrValuesBadRequest() *GetAttrValuesBadRequest {
+	return &GetAttrValuesBadRequest{}
+}
+
+/*GetAttrValuesBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetAttrValuesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetAttrValuesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute}][%d] getAttrValuesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetAttrValuesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAttrValuesBadGateway creates a GetAttrValuesBadGateway with default headers values
+func NewGetAttrValuesBadGateway() *GetAttrValuesBadGateway {
+	return &GetAttrValuesBadGateway{}
+}
+
+/*GetAttrValuesBadGateway handles this case with default header values.
+
+ErrorResponse
+*/
+type GetAttrValuesBadGateway struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetAttrValuesBadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute}][%d] getAttrValuesBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetAttrValuesBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -12,13 +12,20 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/attributes"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/compare"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/estimate"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/graphql"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/images"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/network"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/products"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/provider"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/providers"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/recommendations"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/regions"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/service"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/services"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/spot"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/storage"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/client/versions"
 )
 
@@ -67,20 +74,34 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Cloudinfo
 
 	cli.Attributes = attributes.New(transport, formats)
 
+	cli.Compare = compare.New(transport, formats)
+
+	cli.Estimate = estimate.New(transport, formats)
+
+	cli.Graphql = graphql.New(transport, formats)
+
 	cli.Images = images.New(transport, formats)
 
+	cli.Network = network.New(transport, formats)
+
 	cli.Products = products.New(transport, formats)
 
 	cli.Provider = provider.New(transport, formats)
 
 	cli.Providers = providers.New(transport, formats)
 
+	cli.Recommendations = recommendations.New(transport, formats)
+
 	cli.Regions = regions.New(transport, formats)
 
 	cli.Service = service.New(transport, formats)
 
 	cli.Services = services.New(transport, formats)
 
+	cli.Spot = spot.New(transport, formats)
+
+	cli.Storage = storage.New(transport, formats)
+
 	cli.Versions = versions.New(transport, formats)
 
 	return cli
@@ -129,20 +150,34 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 type Cloudinfo struct {
 	Attributes *attributes.Client
 
+	Compare *compare.Client
+
+	Estimate *estimate.Client
+
+	Graphql *graphql.Client
+
 	Images *images.Client
 
+	Network *network.Client
+
 	Products *products.Client
 
 	Provider *provider.Client
 
 	Providers *providers.Client
 
+	Recommendations *recommendations.Client
+
 	Regions *regions.Client
 
 	Service *service.Client
 
 	Services *services.Client
 
+	Spot *spot.Client
+
+	Storage *storage.Client
+
 	Versions *versions.Client
 
 	Transport runtime.ClientTransport
@@ -154,20 +189,34 @@ func (c *Cloudinfo) SetTransport(transport runtime.ClientTransport) {
 
 	c.Attributes.SetTransport(transport)
 
+	c.Compare.SetTransport(transport)
+
+	c.Estimate.SetTransport(transport)
+
+	c.Graphql.SetTransport(transport)
+
 	c.Images.SetTransport(transport)
 
+	c.Network.SetTransport(transport)
+
 	c.Products.SetTransport(transport)
 
 	c.Provider.SetTransport(transport)
 
 	c.Providers.SetTransport(transport)
 
+	c.Recommendations.SetTransport(transport)
+
 	c.Regions.SetTransport(transport)
 
 	c.Service.SetTransport(transport)
 
 	c.Services.SetTransport(transport)
 
+	c.Spot.SetTransport(transport)
+
+	c.Storage.SetTransport(transport)
+
 	c.Versions.SetTransport(transport)
 
 }
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package compare
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// CompareReader is a Reader for the Compare structure.
+type CompareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CompareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCompareOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewCompareBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewCompareNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewCompareServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewCompareOK creates a CompareOK with default headers values
+func NewCompareOK() *CompareOK {
+	return &CompareOK{}
+}
+
+/*CompareOK handles this case with default header values.
+
+CompareResponse
+*/
+type CompareOK struct {
+	Payload *models.CompareResponse
+}
+
+func (o *CompareOK) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareOK  %+v", 200, o.Payload)
+}
+
+func (o *CompareOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CompareResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareBadRequest creates a CompareBadRequest with default headers values
+func NewCompareBadRequest() *CompareBadRequest {
+	return &CompareBadRequest{}
+}
+
+/*CompareBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type CompareBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *CompareBadRequest) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CompareBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareNotFound creates a CompareNotFound with default headers values
+func NewCompareNotFound() *CompareNotFound {
+	return &CompareNotFound{}
+}
+
+/*CompareNotFound handles this case with default header values.
+
+ErrorResponse
+*/
+type CompareNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *CompareNotFound) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *CompareNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareServiceUnavailable creates a CompareServiceUnavailable with default headers values
+func NewCompareServiceUnavailable() *CompareServiceUnavailable {
+	return &CompareServiceUnavailable{}
+}
+
+/*CompareServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type CompareServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *CompareServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CompareServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package estimate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// NewEstimateCostParams creates a new EstimateCostParams object
+// with the default values initialized.
+func NewEstimateCostParams() *EstimateCostParams {
+	var ()
+	return &EstimateCostParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewEstimateCostParamsWithTimeout creates a new EstimateCostParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewEstimateCostParamsWithTimeout(timeout time.Duration) *EstimateCostParams {
+	var ()
+	return &EstimateCostParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewEstimateCostParamsWithContext creates a new EstimateCostParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewEstimateCostParamsWithContext(ctx context.Context) *EstimateCostParams {
+	var ()
+	return &EstimateCostParams{
+
+		Context: ctx,
+	}
+}
+
+// NewEstimateCostParamsWithHTTPClient creates a new EstimateCostParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewEstimateCostParamsWithHTTPClient(client *http.Client) *EstimateCostParams {
+	var ()
+	return &EstimateCostParams{
+		HTTPClient: client,
+	}
+}
+
+/*EstimateCostParams contains all the parameters to send to the API endpoint
+for the estimate cost operation typically these are written to a http.Request
+*/
+type EstimateCostParams struct {
+
+	/*Body*/
+	Body *models.ClusterDescription
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the estimate cost params
+func (o *EstimateCostParams) WithTimeout(timeout time.Duration) *EstimateCostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the estimate cost params
+func (o *EstimateCostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the estimate cost params
+func (o *EstimateCostParams) WithContext(ctx context.Context) *EstimateCostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the estimate cost params
+func (o *EstimateCostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the estimate cost params
+func (o *EstimateCostParams) WithHTTPClient(client *http.Client) *EstimateCostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the estimate cost params
+func (o *EstimateCostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the estimate cost params
+func (o *EstimateCostParams) WithBody(body *models.ClusterDescription) *EstimateCostParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the estimate cost params
+func (o *EstimateCostParams) SetBody(body *models.ClusterDescription) {
+	o.Body = body
+}
+
+// WithCurrency adds the currency to the estimate cost params
+func (o *EstimateCostParams) WithCurrency(currency *string) *EstimateCostParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the estimate cost params
+func (o *EstimateCostParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the estimate cost params
+func (o *EstimateCostParams) WithProvider(provider string) *EstimateCostParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the estimate cost params
+func (o *EstimateCostParams) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the estimate cost params
+func (o *EstimateCostParams) WithRegion(region string) *EstimateCostParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the estimate cost params
+func (o *EstimateCostParams) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the estimate cost params
+func (o *EstimateCostParams) WithService(service string) *EstimateCostParams {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the estimate cost params
+func (o *EstimateCostParams) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *EstimateCostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
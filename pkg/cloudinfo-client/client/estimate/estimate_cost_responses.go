@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package estimate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// EstimateCostReader is a Reader for the EstimateCost structure.
+type EstimateCostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EstimateCostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewEstimateCostOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewEstimateCostBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewEstimateCostNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewEstimateCostServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewEstimateCostOK creates a EstimateCostOK with default headers values
+func NewEstimateCostOK() *EstimateCostOK {
+	return &EstimateCostOK{}
+}
+
+/*EstimateCostOK handles this case with default header values.
+
+CostEstimateResponse
+*/
+type EstimateCostOK struct {
+	Payload *models.CostEstimateResponse
+}
+
+func (o *EstimateCostOK) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostOK  %+v", 200, o.Payload)
+}
+
+func (o *EstimateCostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CostEstimateResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostBadRequest creates a EstimateCostBadRequest with default headers values
+func NewEstimateCostBadRequest() *EstimateCostBadRequest {
+	return &EstimateCostBadRequest{}
+}
+
+/*EstimateCostBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type EstimateCostBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *EstimateCostBadRequest) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *EstimateCostBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostNotFound creates a EstimateCostNotFound with default headers values
+func NewEstimateCostNotFound() *EstimateCostNotFound {
+	return &EstimateCostNotFound{}
+}
+
+/*EstimateCostNotFound handles this case with default header values.
+
+ErrorResponse
+*/
+type EstimateCostNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *EstimateCostNotFound) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostNotFound  %+v", 404, o.Payload)
+}
+
+func (o *EstimateCostNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostServiceUnavailable creates a EstimateCostServiceUnavailable with default headers values
+func NewEstimateCostServiceUnavailable() *EstimateCostServiceUnavailable {
+	return &EstimateCostServiceUnavailable{}
+}
+
+/*EstimateCostServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type EstimateCostServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *EstimateCostServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *EstimateCostServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graphql
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// NewQueryGraphQLParams creates a new QueryGraphQLParams object
+// with the default values initialized.
+func NewQueryGraphQLParams() *QueryGraphQLParams {
+	var ()
+	return &QueryGraphQLParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewQueryGraphQLParamsWithTimeout creates a new QueryGraphQLParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewQueryGraphQLParamsWithTimeout(timeout time.Duration) *QueryGraphQLParams {
+	var ()
+	return &QueryGraphQLParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewQueryGraphQLParamsWithContext creates a new QueryGraphQLParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewQueryGraphQLParamsWithContext(ctx context.Context) *QueryGraphQLParams {
+	var ()
+	return &QueryGraphQLParams{
+
+		Context: ctx,
+	}
+}
+
+// NewQueryGraphQLParamsWithHTTPClient creates a new QueryGraphQLParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewQueryGraphQLParamsWithHTTPClient(client *http.Client) *QueryGraphQLParams {
+	var ()
+	return &QueryGraphQLParams{
+		HTTPClient: client,
+	}
+}
+
+/*QueryGraphQLParams contains all the parameters to send to the API endpoint
+for the query graph q l operation typically these are written to a http.Request
+*/
+type QueryGraphQLParams struct {
+
+	/*Body*/
+	Body *models.GraphQLRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the query graph q l params
+func (o *QueryGraphQLParams) WithTimeout(timeout time.Duration) *QueryGraphQLParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the query graph q l params
+func (o *QueryGraphQLParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the query graph q l params
+func (o *QueryGraphQLParams) WithContext(ctx context.Context) *QueryGraphQLParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the query graph q l params
+func (o *QueryGraphQLParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the query graph q l params
+func (o *QueryGraphQLParams) WithHTTPClient(client *http.Client) *QueryGraphQLParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the query graph q l params
+func (o *QueryGraphQLParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the query graph q l params
+func (o *QueryGraphQLParams) WithBody(body *models.GraphQLRequest) *QueryGraphQLParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the query graph q l params
+func (o *QueryGraphQLParams) SetBody(body *models.GraphQLRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *QueryGraphQLParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
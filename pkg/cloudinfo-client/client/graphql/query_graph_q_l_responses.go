@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graphql
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// QueryGraphQLReader is a Reader for the QueryGraphQL structure.
+type QueryGraphQLReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *QueryGraphQLReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewQueryGraphQLOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewQueryGraphQLBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewQueryGraphQLOK creates a QueryGraphQLOK with default headers values
+func NewQueryGraphQLOK() *QueryGraphQLOK {
+	return &QueryGraphQLOK{}
+}
+
+/*QueryGraphQLOK handles this case with default header values.
+
+GraphQLResponse
+*/
+type QueryGraphQLOK struct {
+	Payload *models.GraphQLResponse
+}
+
+func (o *QueryGraphQLOK) Error() string {
+	return fmt.Sprintf("[POST /graphql][%d] queryGraphQLOK  %+v", 200, o.Payload)
+}
+
+func (o *QueryGraphQLOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GraphQLResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewQueryGraphQLBadRequest creates a QueryGraphQLBadRequest with default headers values
+func NewQueryGraphQLBadRequest() *QueryGraphQLBadRequest {
+	return &QueryGraphQLBadRequest{}
+}
+
+/*QueryGraphQLBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type QueryGraphQLBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *QueryGraphQLBadRequest) Error() string {
+	return fmt.Sprintf("[POST /graphql][%d] queryGraphQLBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *QueryGraphQLBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -32,6 +32,20 @@ func (o *GetImagesReader) ReadResponse(response runtime.ClientResponse, consumer
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetImagesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetImagesServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetImagesOK) readResponse(response runtime.ClientResponse, consumer run
 
 	return nil
 }
+
+// NewGetImagesBadRequest creates a GetImagesBadRequest with default headers values
+func NewGetImagesBadRequest() *GetImagesBadRequest {
+	return &GetImagesBadRequest{}
+}
+
+/*GetImagesBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetImagesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetImagesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/images][%d] getImagesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetImagesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetImagesServiceUnavailable creates a GetImagesServiceUnavailable with default headers values
+func NewGetImagesServiceUnavailable() *GetImagesServiceUnavailable {
+	return &GetImagesServiceUnavailable{}
+}
+
+/*GetImagesServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetImagesServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetImagesServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/images][%d] getImagesServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetImagesServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
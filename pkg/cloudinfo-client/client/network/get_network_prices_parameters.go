@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetNetworkPricesParams creates a new GetNetworkPricesParams object
+// with the default values initialized.
+func NewGetNetworkPricesParams() *GetNetworkPricesParams {
+	var ()
+	return &GetNetworkPricesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetNetworkPricesParamsWithTimeout creates a new GetNetworkPricesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetNetworkPricesParamsWithTimeout(timeout time.Duration) *GetNetworkPricesParams {
+	var ()
+	return &GetNetworkPricesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetNetworkPricesParamsWithContext creates a new GetNetworkPricesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetNetworkPricesParamsWithContext(ctx context.Context) *GetNetworkPricesParams {
+	var ()
+	return &GetNetworkPricesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetNetworkPricesParamsWithHTTPClient creates a new GetNetworkPricesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetNetworkPricesParamsWithHTTPClient(client *http.Client) *GetNetworkPricesParams {
+	var ()
+	return &GetNetworkPricesParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetNetworkPricesParams contains all the parameters to send to the API endpoint
+for the get network prices operation typically these are written to a http.Request
+*/
+type GetNetworkPricesParams struct {
+
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get network prices params
+func (o *GetNetworkPricesParams) WithTimeout(timeout time.Duration) *GetNetworkPricesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get network prices params
+func (o *GetNetworkPricesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get network prices params
+func (o *GetNetworkPricesParams) WithContext(ctx context.Context) *GetNetworkPricesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get network prices params
+func (o *GetNetworkPricesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get network prices params
+func (o *GetNetworkPricesParams) WithHTTPClient(client *http.Client) *GetNetworkPricesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get network prices params
+func (o *GetNetworkPricesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the get network prices params
+func (o *GetNetworkPricesParams) WithCurrency(currency *string) *GetNetworkPricesParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get network prices params
+func (o *GetNetworkPricesParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the get network prices params
+func (o *GetNetworkPricesParams) WithProvider(provider string) *GetNetworkPricesParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get network prices params
+func (o *GetNetworkPricesParams) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get network prices params
+func (o *GetNetworkPricesParams) WithRegion(region string) *GetNetworkPricesParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get network prices params
+func (o *GetNetworkPricesParams) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get network prices params
+func (o *GetNetworkPricesParams) WithService(service string) *GetNetworkPricesParams {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get network prices params
+func (o *GetNetworkPricesParams) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetNetworkPricesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
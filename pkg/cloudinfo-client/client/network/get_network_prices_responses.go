@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// GetNetworkPricesReader is a Reader for the GetNetworkPrices structure.
+type GetNetworkPricesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetNetworkPricesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetNetworkPricesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetNetworkPricesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetNetworkPricesServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetNetworkPricesOK creates a GetNetworkPricesOK with default headers values
+func NewGetNetworkPricesOK() *GetNetworkPricesOK {
+	return &GetNetworkPricesOK{}
+}
+
+/*GetNetworkPricesOK handles this case with default header values.
+
+NetworkPricesResponse
+*/
+type GetNetworkPricesOK struct {
+	Payload *models.NetworkPricesResponse
+}
+
+func (o *GetNetworkPricesOK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesOK  %+v", 200, o.Payload)
+}
+
+func (o *GetNetworkPricesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkPricesResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNetworkPricesBadRequest creates a GetNetworkPricesBadRequest with default headers values
+func NewGetNetworkPricesBadRequest() *GetNetworkPricesBadRequest {
+	return &GetNetworkPricesBadRequest{}
+}
+
+/*GetNetworkPricesBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetNetworkPricesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetNetworkPricesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetNetworkPricesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNetworkPricesServiceUnavailable creates a GetNetworkPricesServiceUnavailable with default headers values
+func NewGetNetworkPricesServiceUnavailable() *GetNetworkPricesServiceUnavailable {
+	return &GetNetworkPricesServiceUnavailable{}
+}
+
+/*GetNetworkPricesServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetNetworkPricesServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetNetworkPricesServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetNetworkPricesServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -62,12 +63,137 @@ for the get products operation typically these are written to a http.Request
 */
 type GetProductsParams struct {
 
+	/*Burst
+	  Burst selects the burstable (true) or the non burstable (false) products
+
+	*/
+	Burst *bool
+	/*Category
+	  Category filters the products by their category: general, compute, memory, storage, accelerated or burstable
+
+	*/
+	Category *string
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*CurrentGen
+	  CurrentGen selects the current generation (true) or the previous generation (false) products
+
+	*/
+	CurrentGen *bool
+	/*Cursor
+	  Cursor is the nextCursor of the previous page, the first page is returned without cursor
+
+	*/
+	Cursor *string
+	/*Family
+	  Family filters the products by their instance family (eg.: m5, n1, Dv3)
+
+	*/
+	Family *string
+	/*Generation
+	  Generation filters the products by the generation of their instance family
+
+	*/
+	Generation *int64
+	/*Limit
+	  Limit is the maximum number of the products returned in a page (every product by default)
+
+	*/
+	Limit *int64
+	/*MaxCPU
+	  MaxCPU filters out the products with more vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+
+	*/
+	MaxCPU *string
+	/*MaxGpu
+	  MaxGpu filters out the products with more gpus
+
+	*/
+	MaxGpu *string
+	/*MaxMem
+	  MaxMem filters out the products with more memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+
+	*/
+	MaxMem *string
+	/*MaxPrice
+	  MaxPrice filters out the products with a higher on demand price, given in the requested currency and pricing model
+
+	*/
+	MaxPrice *float64
+	/*MaxSpotPrice
+	  MaxSpotPrice filters out the products without a spot price up to the given value in any of their zones
+
+	*/
+	MaxSpotPrice *float64
+	/*MinBandwidth
+	  MinBandwidth filters out the products with a network bandwidth (Gbps) below the given value
+
+	*/
+	MinBandwidth *float64
+	/*MinCPU
+	  MinCPU filters out the products with less vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+
+	*/
+	MinCPU *string
+	/*MinGpu
+	  MinGpu filters out the products with less gpus
+
+	*/
+	MinGpu *string
+	/*MinMem
+	  MinMem filters out the products with less memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+
+	*/
+	MinMem *string
+	/*NetworkCategory
+	  NetworkCategory filters the products by their network performance category: low, medium, high or extra
+
+	*/
+	NetworkCategory *string
+	/*Order
+	  Order is the sort order: asc (default) or desc
+
+	*/
+	Order *string
+	/*Os
+	  Os selects the operating system / license the on demand prices are given for (linux by default)
+
+	*/
+	Os *string
+	/*PaymentOption
+	  PaymentOption selects the payment option of the commitments: no-upfront (default), partial-upfront or all-upfront
+
+	*/
+	PaymentOption *string
+	/*PricingModel
+	  PricingModel selects the pricing model of the products: on-demand (default), commitment-1yr or commitment-3yr
+
+	*/
+	PricingModel *string
 	/*Provider*/
 	Provider string
 	/*Region*/
 	Region string
 	/*Service*/
 	Service string
+	/*Size
+	  Size filters the products by their size within the instance family (eg.: large)
+
+	*/
+	Size *string
+	/*Sort
+	  Sort orders the products by price or pricePerCpu, the products are ordered by their type when only paged
+
+	*/
+	Sort *string
+	/*Zone
+	  Zone selects the products available in a zone of the region, their spot prices are narrowed to the zone
+
+	*/
+	Zone *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -107,6 +233,248 @@ func (o *GetProductsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithBurst adds the burst to the get products params
+func (o *GetProductsParams) WithBurst(burst *bool) *GetProductsParams {
+	o.SetBurst(burst)
+	return o
+}
+
+// SetBurst adds the burst to the get products params
+func (o *GetProductsParams) SetBurst(burst *bool) {
+	o.Burst = burst
+}
+
+// WithCategory adds the category to the get products params
+func (o *GetProductsParams) WithCategory(category *string) *GetProductsParams {
+	o.SetCategory(category)
+	return o
+}
+
+// SetCategory adds the category to the get products params
+func (o *GetProductsParams) SetCategory(category *string) {
+	o.Category = category
+}
+
+// WithCurrency adds the currency to the get products params
+func (o *GetProductsParams) WithCurrency(currency *string) *GetProductsParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get products params
+func (o *GetProductsParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithCurrentGen adds the currentGen to the get products params
+func (o *GetProductsParams) WithCurrentGen(currentGen *bool) *GetProductsParams {
+	o.SetCurrentGen(currentGen)
+	return o
+}
+
+// SetCurrentGen adds the currentGen to the get products params
+func (o *GetProductsParams) SetCurrentGen(currentGen *bool) {
+	o.CurrentGen = currentGen
+}
+
+// WithCursor adds the cursor to the get products params
+func (o *GetProductsParams) WithCursor(cursor *string) *GetProductsParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the get products params
+func (o *GetProductsParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFamily adds the family to the get products params
+func (o *GetProductsParams) WithFamily(family *string) *GetProductsParams {
+	o.SetFamily(family)
+	return o
+}
+
+// SetFamily adds the family to the get products params
+func (o *GetProductsParams) SetFamily(family *string) {
+	o.Family = family
+}
+
+// WithGeneration adds the generation to the get products params
+func (o *GetProductsParams) WithGeneration(generation *int64) *GetProductsParams {
+	o.SetGeneration(generation)
+	return o
+}
+
+// SetGeneration adds the generation to the get products params
+func (o *GetProductsParams) SetGeneration(generation *int64) {
+	o.Generation = generation
+}
+
+// WithLimit adds the limit to the get products params
+func (o *GetProductsParams) WithLimit(limit *int64) *GetProductsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get products params
+func (o *GetProductsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxCPU adds the maxCPU to the get products params
+func (o *GetProductsParams) WithMaxCPU(maxCPU *string) *GetProductsParams {
+	o.SetMaxCPU(maxCPU)
+	return o
+}
+
+// SetMaxCPU adds the maxCpu to the get products params
+func (o *GetProductsParams) SetMaxCPU(maxCPU *string) {
+	o.MaxCPU = maxCPU
+}
+
+// WithMaxGpu adds the maxGpu to the get products params
+func (o *GetProductsParams) WithMaxGpu(maxGpu *string) *GetProductsParams {
+	o.SetMaxGpu(maxGpu)
+	return o
+}
+
+// SetMaxGpu adds the maxGpu to the get products params
+func (o *GetProductsParams) SetMaxGpu(maxGpu *string) {
+	o.MaxGpu = maxGpu
+}
+
+// WithMaxMem adds the maxMem to the get products params
+func (o *GetProductsParams) WithMaxMem(maxMem *string) *GetProductsParams {
+	o.SetMaxMem(maxMem)
+	return o
+}
+
+// SetMaxMem adds the maxMem to the get products params
+func (o *GetProductsParams) SetMaxMem(maxMem *string) {
+	o.MaxMem = maxMem
+}
+
+// WithMaxPrice adds the maxPrice to the get products params
+func (o *GetProductsParams) WithMaxPrice(maxPrice *float64) *GetProductsParams {
+	o.SetMaxPrice(maxPrice)
+	return o
+}
+
+// SetMaxPrice adds the maxPrice to the get products params
+func (o *GetProductsParams) SetMaxPrice(maxPrice *float64) {
+	o.MaxPrice = maxPrice
+}
+
+// WithMaxSpotPrice adds the maxSpotPrice to the get products params
+func (o *GetProductsParams) WithMaxSpotPrice(maxSpotPrice *float64) *GetProductsParams {
+	o.SetMaxSpotPrice(maxSpotPrice)
+	return o
+}
+
+// SetMaxSpotPrice adds the maxSpotPrice to the get products params
+func (o *GetProductsParams) SetMaxSpotPrice(maxSpotPrice *float64) {
+	o.MaxSpotPrice = maxSpotPrice
+}
+
+// WithMinBandwidth adds the minBandwidth to the get products params
+func (o *GetProductsParams) WithMinBandwidth(minBandwidth *float64) *GetProductsParams {
+	o.SetMinBandwidth(minBandwidth)
+	return o
+}
+
+// SetMinBandwidth adds the minBandwidth to the get products params
+func (o *GetProductsParams) SetMinBandwidth(minBandwidth *float64) {
+	o.MinBandwidth = minBandwidth
+}
+
+// WithMinCPU adds the minCPU to the get products params
+func (o *GetProductsParams) WithMinCPU(minCPU *string) *GetProductsParams {
+	o.SetMinCPU(minCPU)
+	return o
+}
+
+// SetMinCPU adds the minCpu to the get products params
+func (o *GetProductsParams) SetMinCPU(minCPU *string) {
+	o.MinCPU = minCPU
+}
+
+// WithMinGpu adds the minGpu to the get products params
+func (o *GetProductsParams) WithMinGpu(minGpu *string) *GetProductsParams {
+	o.SetMinGpu(minGpu)
+	return o
+}
+
+// SetMinGpu adds the minGpu to the get products params
+func (o *GetProductsParams) SetMinGpu(minGpu *string) {
+	o.MinGpu = minGpu
+}
+
+// WithMinMem adds the minMem to the get products params
+func (o *GetProductsParams) WithMinMem(minMem *string) *GetProductsParams {
+	o.SetMinMem(minMem)
+	return o
+}
+
+// SetMinMem adds the minMem to the get products params
+func (o *GetProductsParams) SetMinMem(minMem *string) {
+	o.MinMem = minMem
+}
+
+// WithNetworkCategory adds the networkCategory to the get products params
+func (o *GetProductsParams) WithNetworkCategory(networkCategory *string) *GetProductsParams {
+	o.SetNetworkCategory(networkCategory)
+	return o
+}
+
+// SetNetworkCategory adds the networkCategory to the get products params
+func (o *GetProductsParams) SetNetworkCategory(networkCategory *string) {
+	o.NetworkCategory = networkCategory
+}
+
+// WithOrder adds the order to the get products params
+func (o *GetProductsParams) WithOrder(order *string) *GetProductsParams {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the get products params
+func (o *GetProductsParams) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithOs adds the os to the get products params
+func (o *GetProductsParams) WithOs(os *string) *GetProductsParams {
+	o.SetOs(os)
+	return o
+}
+
+// SetOs adds the os to the get products params
+func (o *GetProductsParams) SetOs(os *string) {
+	o.Os = os
+}
+
+// WithPaymentOption adds the paymentOption to the get products params
+func (o *GetProductsParams) WithPaymentOption(paymentOption *string) *GetProductsParams {
+	o.SetPaymentOption(paymentOption)
+	return o
+}
+
+// SetPaymentOption adds the paymentOption to the get products params
+func (o *GetProductsParams) SetPaymentOption(paymentOption *string) {
+	o.PaymentOption = paymentOption
+}
+
+// WithPricingModel adds the pricingModel to the get products params
+func (o *GetProductsParams) WithPricingModel(pricingModel *string) *GetProductsParams {
+	o.SetPricingModel(pricingModel)
+	return o
+}
+
+// SetPricingModel adds the pricingModel to the get products params
+func (o *GetProductsParams) SetPricingModel(pricingModel *string) {
+	o.PricingModel = pricingModel
+}
+
 // WithProvider adds the provider to the get products params
 func (o *GetProductsParams) WithProvider(provider string) *GetProductsParams {
 	o.SetProvider(provider)
@@ -140,6 +508,39 @@ func (o *GetProductsParams) SetService(service string) {
 	o.Service = service
 }
 
+// WithSize adds the size to the get products params
+func (o *GetProductsParams) WithSize(size *string) *GetProductsParams {
+	o.SetSize(size)
+	return o
+}
+
+// SetSize adds the size to the get products params
+func (o *GetProductsParams) SetSize(size *string) {
+	o.Size = size
+}
+
+// WithSort adds the sort to the get products params
+func (o *GetProductsParams) WithSort(sort *string) *GetProductsParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the get products params
+func (o *GetProductsParams) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithZone adds the zone to the get products params
+func (o *GetProductsParams) WithZone(zone *string) *GetProductsParams {
+	o.SetZone(zone)
+	return o
+}
+
+// SetZone adds the zone to the get products params
+func (o *GetProductsParams) SetZone(zone *string) {
+	o.Zone = zone
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -148,6 +549,358 @@ func (o *GetProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Burst != nil {
+
+		// query param burst
+		var qrBurst bool
+		if o.Burst != nil {
+			qrBurst = *o.Burst
+		}
+		qBurst := swag.FormatBool(qrBurst)
+		if qBurst != "" {
+			if err := r.SetQueryParam("burst", qBurst); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Category != nil {
+
+		// query param category
+		var qrCategory string
+		if o.Category != nil {
+			qrCategory = *o.Category
+		}
+		qCategory := qrCategory
+		if qCategory != "" {
+			if err := r.SetQueryParam("category", qCategory); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.CurrentGen != nil {
+
+		// query param currentGen
+		var qrCurrentGen bool
+		if o.CurrentGen != nil {
+			qrCurrentGen = *o.CurrentGen
+		}
+		qCurrentGen := swag.FormatBool(qrCurrentGen)
+		if qCurrentGen != "" {
+			if err := r.SetQueryParam("currentGen", qCurrentGen); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Family != nil {
+
+		// query param family
+		var qrFamily string
+		if o.Family != nil {
+			qrFamily = *o.Family
+		}
+		qFamily := qrFamily
+		if qFamily != "" {
+			if err := r.SetQueryParam("family", qFamily); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Generation != nil {
+
+		// query param generation
+		var qrGeneration int64
+		if o.Generation != nil {
+			qrGeneration = *o.Generation
+		}
+		qGeneration := swag.FormatInt64(qrGeneration)
+		if qGeneration != "" {
+			if err := r.SetQueryParam("generation", qGeneration); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxCPU != nil {
+
+		// query param maxCpu
+		var qrMaxCPU string
+		if o.MaxCPU != nil {
+			qrMaxCPU = *o.MaxCPU
+		}
+		qMaxCPU := qrMaxCPU
+		if qMaxCPU != "" {
+			if err := r.SetQueryParam("maxCpu", qMaxCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxGpu != nil {
+
+		// query param maxGpu
+		var qrMaxGpu string
+		if o.MaxGpu != nil {
+			qrMaxGpu = *o.MaxGpu
+		}
+		qMaxGpu := qrMaxGpu
+		if qMaxGpu != "" {
+			if err := r.SetQueryParam("maxGpu", qMaxGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxMem != nil {
+
+		// query param maxMem
+		var qrMaxMem string
+		if o.MaxMem != nil {
+			qrMaxMem = *o.MaxMem
+		}
+		qMaxMem := qrMaxMem
+		if qMaxMem != "" {
+			if err := r.SetQueryParam("maxMem", qMaxMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxPrice != nil {
+
+		// query param maxPrice
+		var qrMaxPrice float64
+		if o.MaxPrice != nil {
+			qrMaxPrice = *o.MaxPrice
+		}
+		qMaxPrice := swag.FormatFloat64(qrMaxPrice)
+		if qMaxPrice != "" {
+			if err := r.SetQueryParam("maxPrice", qMaxPrice); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxSpotPrice != nil {
+
+		// query param maxSpotPrice
+		var qrMaxSpotPrice float64
+		if o.MaxSpotPrice != nil {
+			qrMaxSpotPrice = *o.MaxSpotPrice
+		}
+		qMaxSpotPrice := swag.FormatFloat64(qrMaxSpotPrice)
+		if qMaxSpotPrice != "" {
+			if err := r.SetQueryParam("maxSpotPrice", qMaxSpotPrice); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinBandwidth != nil {
+
+		// query param minBandwidth
+		var qrMinBandwidth float64
+		if o.MinBandwidth != nil {
+			qrMinBandwidth = *o.MinBandwidth
+		}
+		qMinBandwidth := swag.FormatFloat64(qrMinBandwidth)
+		if qMinBandwidth != "" {
+			if err := r.SetQueryParam("minBandwidth", qMinBandwidth); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinCPU != nil {
+
+		// query param minCpu
+		var qrMinCPU string
+		if o.MinCPU != nil {
+			qrMinCPU = *o.MinCPU
+		}
+		qMinCPU := qrMinCPU
+		if qMinCPU != "" {
+			if err := r.SetQueryParam("minCpu", qMinCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinGpu != nil {
+
+		// query param minGpu
+		var qrMinGpu string
+		if o.MinGpu != nil {
+			qrMinGpu = *o.MinGpu
+		}
+		qMinGpu := qrMinGpu
+		if qMinGpu != "" {
+			if err := r.SetQueryParam("minGpu", qMinGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinMem != nil {
+
+		// query param minMem
+		var qrMinMem string
+		if o.MinMem != nil {
+			qrMinMem = *o.MinMem
+		}
+		qMinMem := qrMinMem
+		if qMinMem != "" {
+			if err := r.SetQueryParam("minMem", qMinMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.NetworkCategory != nil {
+
+		// query param networkCategory
+		var qrNetworkCategory string
+		if o.NetworkCategory != nil {
+			qrNetworkCategory = *o.NetworkCategory
+		}
+		qNetworkCategory := qrNetworkCategory
+		if qNetworkCategory != "" {
+			if err := r.SetQueryParam("networkCategory", qNetworkCategory); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Os != nil {
+
+		// query param os
+		var qrOs string
+		if o.Os != nil {
+			qrOs = *o.Os
+		}
+		qOs := qrOs
+		if qOs != "" {
+			if err := r.SetQueryParam("os", qOs); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PaymentOption != nil {
+
+		// query param paymentOption
+		var qrPaymentOption string
+		if o.PaymentOption != nil {
+			qrPaymentOption = *o.PaymentOption
+		}
+		qPaymentOption := qrPaymentOption
+		if qPaymentOption != "" {
+			if err := r.SetQueryParam("paymentOption", qPaymentOption); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PricingModel != nil {
+
+		// query param pricingModel
+		var qrPricingModel string
+		if o.PricingModel != nil {
+			qrPricingModel = *o.PricingModel
+		}
+		qPricingModel := qrPricingModel
+		if qPricingModel != "" {
+			if err := r.SetQueryParam("pricingModel", qPricingModel); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param provider
 	if err := r.SetPathParam("provider", o.Provider); err != nil {
 		return err
@@ -163,6 +916,54 @@ func (o *GetProductsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		return err
 	}
 
+	if o.Size != nil {
+
+		// query param size
+		var qrSize string
+		if o.Size != nil {
+			qrSize = *o.Size
+		}
+		qSize := qrSize
+		if qSize != "" {
+			if err := r.SetQueryParam("size", qSize); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Zone != nil {
+
+		// query param zone
+		var qrZone string
+		if o.Zone != nil {
+			qrZone = *o.Zone
+		}
+		qZone := qrZone
+		if qZone != "" {
+			if err := r.SetQueryParam("zone", qZone); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -32,6 +32,20 @@ func (o *GetProductsReader) ReadResponse(response runtime.ClientResponse, consum
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetProductsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetProductsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetProductsOK) readResponse(response runtime.ClientResponse, consumer r
 
 	return nil
 }
+
+// NewGetProductsBadRequest creates a GetProductsBadRequest with default headers values
+func NewGetProductsBadRequest() *GetProductsBadRequest {
+	return &GetProductsBadRequest{}
+}
+
+/*GetProductsBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetProductsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetProductsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetProductsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProductsServiceUnavailable creates a GetProductsServiceUnavailable with default headers values
+func NewGetProductsServiceUnavailable() *GetProductsServiceUnavailable {
+	return &GetProductsServiceUnavailable{}
+}
+
+/*GetProductsServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetProductsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetProductsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetProductsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -24,8 +24,11 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
-/*
-GetProducts provides a list of available machine types on a given provider in a specific region
+/*GetProducts provides a list of available machine types on a given provider in a specific region
+
+The on demand prices are given for linux unless another operating system / license is selected with the os query parameter.
+The pricingModel and paymentOption query parameters price the products with the effective hourly price of a commitment instead.
+The products can be filtered by their resources, network, generation, zone and price, ordered by price or price per vCPU and paged with a cursor.
 */
 func (a *Client) GetProducts(params *GetProductsParams) (*GetProductsOK, error) {
 	// TODO: Validate the params before sending
@@ -32,6 +32,20 @@ func (o *GetProviderReader) ReadResponse(response runtime.ClientResponse, consum
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetProviderBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetProviderNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetProviderOK) readResponse(response runtime.ClientResponse, consumer r
 
 	return nil
 }
+
+// NewGetProviderBadRequest creates a GetProviderBadRequest with default headers values
+func NewGetProviderBadRequest() *GetProviderBadRequest {
+	return &GetProviderBadRequest{}
+}
+
+/*GetProviderBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetProviderBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetProviderBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}][%d] getProviderBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetProviderBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProviderNotFound creates a GetProviderNotFound with default headers values
+func NewGetProviderNotFound() *GetProviderNotFound {
+	return &GetProviderNotFound{}
+}
+
+/*GetProviderNotFound handles this case with default header values.
+
+ErrorResponse
+*/
+type GetProviderNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetProviderNotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}][%d] getProviderNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetProviderNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
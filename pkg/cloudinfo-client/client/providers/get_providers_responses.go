@@ -32,6 +32,13 @@ func (o *GetProvidersReader) ReadResponse(response runtime.ClientResponse, consu
 		}
 		return result, nil
 
+	case 500:
+		result := NewGetProvidersInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +72,32 @@ func (o *GetProvidersOK) readResponse(response runtime.ClientResponse, consumer
 
 	return nil
 }
+
+// NewGetProvidersInternalServerError creates a GetProvidersInternalServerError with default headers values
+func NewGetProvidersInternalServerError() *GetProvidersInternalServerError {
+	return &GetProvidersInternalServerError{}
+}
+
+/*GetProvidersInternalServerError handles this case with default header values.
+
+ErrorResponse
+*/
+type GetProvidersInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetProvidersInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /providers][%d] getProvidersInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetProvidersInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package recommendations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// NewGetRecommendationsParams creates a new GetRecommendationsParams object
+// with the default values initialized.
+func NewGetRecommendationsParams() *GetRecommendationsParams {
+	var ()
+	return &GetRecommendationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRecommendationsParamsWithTimeout creates a new GetRecommendationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRecommendationsParamsWithTimeout(timeout time.Duration) *GetRecommendationsParams {
+	var ()
+	return &GetRecommendationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRecommendationsParamsWithContext creates a new GetRecommendationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRecommendationsParamsWithContext(ctx context.Context) *GetRecommendationsParams {
+	var ()
+	return &GetRecommendationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRecommendationsParamsWithHTTPClient creates a new GetRecommendationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRecommendationsParamsWithHTTPClient(client *http.Client) *GetRecommendationsParams {
+	var ()
+	return &GetRecommendationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetRecommendationsParams contains all the parameters to send to the API endpoint
+for the get recommendations operation typically these are written to a http.Request
+*/
+type GetRecommendationsParams struct {
+
+	/*Body*/
+	Body *models.RecommendationRequest
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get recommendations params
+func (o *GetRecommendationsParams) WithTimeout(timeout time.Duration) *GetRecommendationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get recommendations params
+func (o *GetRecommendationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get recommendations params
+func (o *GetRecommendationsParams) WithContext(ctx context.Context) *GetRecommendationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get recommendations params
+func (o *GetRecommendationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get recommendations params
+func (o *GetRecommendationsParams) WithHTTPClient(client *http.Client) *GetRecommendationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get recommendations params
+func (o *GetRecommendationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the get recommendations params
+func (o *GetRecommendationsParams) WithBody(body *models.RecommendationRequest) *GetRecommendationsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the get recommendations params
+func (o *GetRecommendationsParams) SetBody(body *models.RecommendationRequest) {
+	o.Body = body
+}
+
+// WithCurrency adds the currency to the get recommendations params
+func (o *GetRecommendationsParams) WithCurrency(currency *string) *GetRecommendationsParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get recommendations params
+func (o *GetRecommendationsParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the get recommendations params
+func (o *GetRecommendationsParams) WithProvider(provider string) *GetRecommendationsParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get recommendations params
+func (o *GetRecommendationsParams) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get recommendations params
+func (o *GetRecommendationsParams) WithRegion(region string) *GetRecommendationsParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get recommendations params
+func (o *GetRecommendationsParams) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get recommendations params
+func (o *GetRecommendationsParams) WithService(service string) *GetRecommendationsParams {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get recommendations params
+func (o *GetRecommendationsParams) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRecommendationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package recommendations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// GetRecommendationsReader is a Reader for the GetRecommendations structure.
+type GetRecommendationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRecommendationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRecommendationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetRecommendationsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetRecommendationsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetRecommendationsOK creates a GetRecommendationsOK with default headers values
+func NewGetRecommendationsOK() *GetRecommendationsOK {
+	return &GetRecommendationsOK{}
+}
+
+/*GetRecommendationsOK handles this case with default header values.
+
+RecommendationsResponse
+*/
+type GetRecommendationsOK struct {
+	Payload *models.RecommendationsResponse
+}
+
+func (o *GetRecommendationsOK) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetRecommendationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RecommendationsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRecommendationsBadRequest creates a GetRecommendationsBadRequest with default headers values
+func NewGetRecommendationsBadRequest() *GetRecommendationsBadRequest {
+	return &GetRecommendationsBadRequest{}
+}
+
+/*GetRecommendationsBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRecommendationsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRecommendationsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetRecommendationsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRecommendationsServiceUnavailable creates a GetRecommendationsServiceUnavailable with default headers values
+func NewGetRecommendationsServiceUnavailable() *GetRecommendationsServiceUnavailable {
+	return &GetRecommendationsServiceUnavailable{}
+}
+
+/*GetRecommendationsServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRecommendationsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRecommendationsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetRecommendationsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// GetCheapestRegionsReader is a Reader for the GetCheapestRegions structure.
+type GetCheapestRegionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetCheapestRegionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetCheapestRegionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetCheapestRegionsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetCheapestRegionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetCheapestRegionsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetCheapestRegionsOK creates a GetCheapestRegionsOK with default headers values
+func NewGetCheapestRegionsOK() *GetCheapestRegionsOK {
+	return &GetCheapestRegionsOK{}
+}
+
+/*GetCheapestRegionsOK handles this case with default header values.
+
+CheapestRegionsResponse
+*/
+type GetCheapestRegionsOK struct {
+	Payload *models.CheapestRegionsResponse
+}
+
+func (o *GetCheapestRegionsOK) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetCheapestRegionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CheapestRegionsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsBadRequest creates a GetCheapestRegionsBadRequest with default headers values
+func NewGetCheapestRegionsBadRequest() *GetCheapestRegionsBadRequest {
+	return &GetCheapestRegionsBadRequest{}
+}
+
+/*GetCheapestRegionsBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetCheapestRegionsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetCheapestRegionsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetCheapestRegionsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsNotFound creates a GetCheapestRegionsNotFound with default headers values
+func NewGetCheapestRegionsNotFound() *GetCheapestRegionsNotFound {
+	return &GetCheapestRegionsNotFound{}
+}
+
+/*GetCheapestRegionsNotFound handles this case with default header values.
+
+ErrorResponse
+*/
+type GetCheapestRegionsNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetCheapestRegionsNotFound) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetCheapestRegionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsServiceUnavailable creates a GetCheapestRegionsServiceUnavailable with default headers values
+func NewGetCheapestRegionsServiceUnavailable() *GetCheapestRegionsServiceUnavailable {
+	return &GetCheapestRegionsServiceUnavailable{}
+}
+
+/*GetCheapestRegionsServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetCheapestRegionsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetCheapestRegionsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetCheapestRegionsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -32,6 +32,20 @@ func (o *GetRegionReader) ReadResponse(response runtime.ClientResponse, consumer
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetRegionBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetRegionBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetRegionOK) readResponse(response runtime.ClientResponse, consumer run
 
 	return nil
 }
+
+// NewGetRegionBadRequest creates a GetRegionBadRequest with default headers values
+func NewGetRegionBadRequest() *GetRegionBadRequest {
+	return &GetRegionBadRequest{}
+}
+
+/*GetRegionBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRegionBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRegionBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}][%d] getRegionBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetRegionBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionBadGateway creates a GetRegionBadGateway with default headers values
+func NewGetRegionBadGateway() *GetRegionBadGateway {
+	return &GetRegionBadGateway{}
+}
+
+/*GetRegionBadGateway handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRegionBadGateway struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRegionBadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}][%d] getRegionBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetRegionBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -32,6 +32,20 @@ func (o *GetRegionsReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetRegionsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetRegionsBadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -63,3 +77,61 @@ func (o *GetRegionsOK) readResponse(response runtime.ClientResponse, consumer ru
 
 	return nil
 }
+
+// NewGetRegionsBadRequest creates a GetRegionsBadRequest with default headers values
+func NewGetRegionsBadRequest() *GetRegionsBadRequest {
+	return &GetRegionsBadRequest{}
+}
+
+/*GetRegionsBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRegionsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRegionsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions][%d] getRegionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetRegionsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionsBadGateway creates a GetRegionsBadGateway with default headers values
+func NewGetRegionsBadGateway() *GetRegionsBadGateway {
+	return &GetRegionsBadGateway{}
+}
+
+/*GetRegionsBadGateway handles this case with default header values.
+
+ErrorResponse
+*/
+type GetRegionsBadGateway struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetRegionsBadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions][%d] getRegionsBadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetRegionsBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -24,6 +24,34 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*GetCheapestRegions Ranks the regions of the providers by the lowest on demand or spot price of an instance type, or of the instance
+types providing the minimum resources. The regions can be filtered by geography and by the age of their prices.
+*/
+func (a *Client) GetCheapestRegions(params *GetCheapestRegionsParams) (*GetCheapestRegionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetCheapestRegionsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getCheapestRegions",
+		Method:             "GET",
+		PathPattern:        "/cheapest-regions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetCheapestRegionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetCheapestRegionsOK), nil
+
+}
+
 /*
 GetRegion Provides the detailed info of a specific region of a cloud provider
 */
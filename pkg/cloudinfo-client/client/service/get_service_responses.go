@@ -32,8 +32,15 @@ func (o *GetServiceReader) ReadResponse(response runtime.ClientResponse, consume
 		}
 		return result, nil
 
-	case 503:
-		result := NewGetServiceServiceUnavailable()
+	case 400:
+		result := NewGetServiceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetServiceNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -73,24 +80,53 @@ func (o *GetServiceOK) readResponse(response runtime.ClientResponse, consumer ru
 	return nil
 }
 
-// NewGetServiceServiceUnavailable creates a GetServiceServiceUnavailable with default headers values
-func NewGetServiceServiceUnavailable() *GetServiceServiceUnavailable {
-	return &GetServiceServiceUnavailable{}
+// NewGetServiceBadRequest creates a GetServiceBadRequest with default headers values
+func NewGetServiceBadRequest() *GetServiceBadRequest {
+	return &GetServiceBadRequest{}
+}
+
+/*GetServiceBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetServiceBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetServiceBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}][%d] getServiceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetServiceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServiceNotFound creates a GetServiceNotFound with default headers values
+func NewGetServiceNotFound() *GetServiceNotFound {
+	return &GetServiceNotFound{}
 }
 
-/*GetServiceServiceUnavailable handles this case with default header values.
+/*GetServiceNotFound handles this case with default header values.
 
 ErrorResponse
 */
-type GetServiceServiceUnavailable struct {
+type GetServiceNotFound struct {
 	Payload *models.ErrorResponse
 }
 
-func (o *GetServiceServiceUnavailable) Error() string {
-	return fmt.Sprintf("[GET /providers/{provider}/services/{service}][%d] getServiceServiceUnavailable  %+v", 503, o.Payload)
+func (o *GetServiceNotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}][%d] getServiceNotFound  %+v", 404, o.Payload)
 }
 
-func (o *GetServiceServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *GetServiceNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.ErrorResponse)
 
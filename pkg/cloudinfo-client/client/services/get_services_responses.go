@@ -32,8 +32,22 @@ func (o *GetServicesReader) ReadResponse(response runtime.ClientResponse, consum
 		}
 		return result, nil
 
-	case 503:
-		result := NewGetServicesServiceUnavailable()
+	case 400:
+		result := NewGetServicesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetServicesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetServicesBadGateway()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -73,24 +87,82 @@ func (o *GetServicesOK) readResponse(response runtime.ClientResponse, consumer r
 	return nil
 }
 
-// NewGetServicesServiceUnavailable creates a GetServicesServiceUnavailable with default headers values
-func NewGetServicesServiceUnavailable() *GetServicesServiceUnavailable {
-	return &GetServicesServiceUnavailable{}
+// NewGetServicesBadRequest creates a GetServicesBadRequest with default headers values
+func NewGetServicesBadRequest() *GetServicesBadRequest {
+	return &GetServicesBadRequest{}
+}
+
+/*GetServicesBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetServicesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetServicesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetServicesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServicesNotFound creates a GetServicesNotFound with default headers values
+func NewGetServicesNotFound() *GetServicesNotFound {
+	return &GetServicesNotFound{}
+}
+
+/*GetServicesNotFound handles this case with default header values.
+
+ErrorResponse
+*/
+type GetServicesNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetServicesNotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesNotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetServicesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServicesBadGateway creates a GetServicesBadGateway with default headers values
+func NewGetServicesBadGateway() *GetServicesBadGateway {
+	return &GetServicesBadGateway{}
 }
 
-/*GetServicesServiceUnavailable handles this case with default header values.
+/*GetServicesBadGateway handles this case with default header values.
 
 ErrorResponse
 */
-type GetServicesServiceUnavailable struct {
+type GetServicesBadGateway struct {
 	Payload *models.ErrorResponse
 }
 
-func (o *GetServicesServiceUnavailable) Error() string {
-	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesServiceUnavailable  %+v", 503, o.Payload)
+func (o *GetServicesBadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesBadGateway  %+v", 502, o.Payload)
 }
 
-func (o *GetServicesServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *GetServicesBadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.ErrorResponse)
 
@@ -0,0 +1,317 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spot
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetSpotPriceParams creates a new GetSpotPriceParams object
+// with the default values initialized.
+func NewGetSpotPriceParams() *GetSpotPriceParams {
+	var ()
+	return &GetSpotPriceParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSpotPriceParamsWithTimeout creates a new GetSpotPriceParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetSpotPriceParamsWithTimeout(timeout time.Duration) *GetSpotPriceParams {
+	var ()
+	return &GetSpotPriceParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetSpotPriceParamsWithContext creates a new GetSpotPriceParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetSpotPriceParamsWithContext(ctx context.Context) *GetSpotPriceParams {
+	var ()
+	return &GetSpotPriceParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetSpotPriceParamsWithHTTPClient creates a new GetSpotPriceParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetSpotPriceParamsWithHTTPClient(client *http.Client) *GetSpotPriceParams {
+	var ()
+	return &GetSpotPriceParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetSpotPriceParams contains all the parameters to send to the API endpoint
+for the get spot price operation typically these are written to a http.Request
+*/
+type GetSpotPriceParams struct {
+
+	/*Count
+	  Count is the number of the zones aggregated by the cheapest strategy
+
+	*/
+	Count *int64
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the price is converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*InstanceType*/
+	InstanceType string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+	/*Strategy
+	  Strategy is the aggregation strategy: mean (default), min, max, median or cheapest
+
+	*/
+	Strategy *string
+	/*Zones
+	  Zones is the comma separated list of the zones the spot prices are aggregated over (every zone with a spot price by default)
+
+	*/
+	Zones *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get spot price params
+func (o *GetSpotPriceParams) WithTimeout(timeout time.Duration) *GetSpotPriceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get spot price params
+func (o *GetSpotPriceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get spot price params
+func (o *GetSpotPriceParams) WithContext(ctx context.Context) *GetSpotPriceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get spot price params
+func (o *GetSpotPriceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get spot price params
+func (o *GetSpotPriceParams) WithHTTPClient(client *http.Client) *GetSpotPriceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get spot price params
+func (o *GetSpotPriceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCount adds the count to the get spot price params
+func (o *GetSpotPriceParams) WithCount(count *int64) *GetSpotPriceParams {
+	o.SetCount(count)
+	return o
+}
+
+// SetCount adds the count to the get spot price params
+func (o *GetSpotPriceParams) SetCount(count *int64) {
+	o.Count = count
+}
+
+// WithCurrency adds the currency to the get spot price params
+func (o *GetSpotPriceParams) WithCurrency(currency *string) *GetSpotPriceParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get spot price params
+func (o *GetSpotPriceParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithInstanceType adds the instanceType to the get spot price params
+func (o *GetSpotPriceParams) WithInstanceType(instanceType string) *GetSpotPriceParams {
+	o.SetInstanceType(instanceType)
+	return o
+}
+
+// SetInstanceType adds the instanceType to the get spot price params
+func (o *GetSpotPriceParams) SetInstanceType(instanceType string) {
+	o.InstanceType = instanceType
+}
+
+// WithProvider adds the provider to the get spot price params
+func (o *GetSpotPriceParams) WithProvider(provider string) *GetSpotPriceParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get spot price params
+func (o *GetSpotPriceParams) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get spot price params
+func (o *GetSpotPriceParams) WithRegion(region string) *GetSpotPriceParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get spot price params
+func (o *GetSpotPriceParams) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get spot price params
+func (o *GetSpotPriceParams) WithService(service string) *GetSpotPriceParams {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get spot price params
+func (o *GetSpotPriceParams) SetService(service string) {
+	o.Service = service
+}
+
+// WithStrategy adds the strategy to the get spot price params
+func (o *GetSpotPriceParams) WithStrategy(strategy *string) *GetSpotPriceParams {
+	o.SetStrategy(strategy)
+	return o
+}
+
+// SetStrategy adds the strategy to the get spot price params
+func (o *GetSpotPriceParams) SetStrategy(strategy *string) {
+	o.Strategy = strategy
+}
+
+// WithZones adds the zones to the get spot price params
+func (o *GetSpotPriceParams) WithZones(zones *string) *GetSpotPriceParams {
+	o.SetZones(zones)
+	return o
+}
+
+// SetZones adds the zones to the get spot price params
+func (o *GetSpotPriceParams) SetZones(zones *string) {
+	o.Zones = zones
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSpotPriceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Count != nil {
+
+		// query param count
+		var qrCount int64
+		if o.Count != nil {
+			qrCount = *o.Count
+		}
+		qCount := swag.FormatInt64(qrCount)
+		if qCount != "" {
+			if err := r.SetQueryParam("count", qCount); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param instanceType
+	if err := r.SetPathParam("instanceType", o.InstanceType); err != nil {
+		return err
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if o.Strategy != nil {
+
+		// query param strategy
+		var qrStrategy string
+		if o.Strategy != nil {
+			qrStrategy = *o.Strategy
+		}
+		qStrategy := qrStrategy
+		if qStrategy != "" {
+			if err := r.SetQueryParam("strategy", qStrategy); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Zones != nil {
+
+		// query param zones
+		var qrZones string
+		if o.Zones != nil {
+			qrZones = *o.Zones
+		}
+		qZones := qrZones
+		if qZones != "" {
+			if err := r.SetQueryParam("zones", qZones); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spot
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// GetSpotPriceReader is a Reader for the GetSpotPrice structure.
+type GetSpotPriceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSpotPriceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetSpotPriceOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetSpotPriceBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetSpotPriceServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetSpotPriceOK creates a GetSpotPriceOK with default headers values
+func NewGetSpotPriceOK() *GetSpotPriceOK {
+	return &GetSpotPriceOK{}
+}
+
+/*GetSpotPriceOK handles this case with default header values.
+
+SpotPriceResponse
+*/
+type GetSpotPriceOK struct {
+	Payload *models.SpotPriceResponse
+}
+
+func (o *GetSpotPriceOK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSpotPriceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SpotPriceResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSpotPriceBadRequest creates a GetSpotPriceBadRequest with default headers values
+func NewGetSpotPriceBadRequest() *GetSpotPriceBadRequest {
+	return &GetSpotPriceBadRequest{}
+}
+
+/*GetSpotPriceBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetSpotPriceBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetSpotPriceBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetSpotPriceBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSpotPriceServiceUnavailable creates a GetSpotPriceServiceUnavailable with default headers values
+func NewGetSpotPriceServiceUnavailable() *GetSpotPriceServiceUnavailable {
+	return &GetSpotPriceServiceUnavailable{}
+}
+
+/*GetSpotPriceServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetSpotPriceServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetSpotPriceServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetSpotPriceServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
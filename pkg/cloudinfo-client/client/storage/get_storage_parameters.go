@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetStorageParams creates a new GetStorageParams object
+// with the default values initialized.
+func NewGetStorageParams() *GetStorageParams {
+	var ()
+	return &GetStorageParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetStorageParamsWithTimeout creates a new GetStorageParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetStorageParamsWithTimeout(timeout time.Duration) *GetStorageParams {
+	var ()
+	return &GetStorageParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetStorageParamsWithContext creates a new GetStorageParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetStorageParamsWithContext(ctx context.Context) *GetStorageParams {
+	var ()
+	return &GetStorageParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetStorageParamsWithHTTPClient creates a new GetStorageParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetStorageParamsWithHTTPClient(client *http.Client) *GetStorageParams {
+	var ()
+	return &GetStorageParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetStorageParams contains all the parameters to send to the API endpoint
+for the get storage operation typically these are written to a http.Request
+*/
+type GetStorageParams struct {
+
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get storage params
+func (o *GetStorageParams) WithTimeout(timeout time.Duration) *GetStorageParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get storage params
+func (o *GetStorageParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get storage params
+func (o *GetStorageParams) WithContext(ctx context.Context) *GetStorageParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get storage params
+func (o *GetStorageParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get storage params
+func (o *GetStorageParams) WithHTTPClient(client *http.Client) *GetStorageParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get storage params
+func (o *GetStorageParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the get storage params
+func (o *GetStorageParams) WithCurrency(currency *string) *GetStorageParams {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get storage params
+func (o *GetStorageParams) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the get storage params
+func (o *GetStorageParams) WithProvider(provider string) *GetStorageParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get storage params
+func (o *GetStorageParams) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get storage params
+func (o *GetStorageParams) WithRegion(region string) *GetStorageParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get storage params
+func (o *GetStorageParams) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get storage params
+func (o *GetStorageParams) WithService(service string) *GetStorageParams {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get storage params
+func (o *GetStorageParams) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetStorageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/models"
+)
+
+// GetStorageReader is a Reader for the GetStorage structure.
+type GetStorageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetStorageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetStorageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetStorageBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetStorageServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetStorageOK creates a GetStorageOK with default headers values
+func NewGetStorageOK() *GetStorageOK {
+	return &GetStorageOK{}
+}
+
+/*GetStorageOK handles this case with default header values.
+
+StorageResponse
+*/
+type GetStorageOK struct {
+	Payload *models.StorageResponse
+}
+
+func (o *GetStorageOK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageOK  %+v", 200, o.Payload)
+}
+
+func (o *GetStorageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.StorageResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageBadRequest creates a GetStorageBadRequest with default headers values
+func NewGetStorageBadRequest() *GetStorageBadRequest {
+	return &GetStorageBadRequest{}
+}
+
+/*GetStorageBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetStorageBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetStorageBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetStorageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageServiceUnavailable creates a GetStorageServiceUnavailable with default headers values
+func NewGetStorageServiceUnavailable() *GetStorageServiceUnavailable {
+	return &GetStorageServiceUnavailable{}
+}
+
+/*GetStorageServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetStorageServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetStorageServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetStorageServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
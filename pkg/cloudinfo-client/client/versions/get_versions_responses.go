@@ -32,6 +32,20 @@ func (o *GetVersionsReader) ReadResponse(response runtime.ClientResponse, consum
 		}
 		return result, nil
 
+	case 400:
+		result := NewGetVersionsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetVersionsServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
 	}
@@ -65,3 +79,61 @@ func (o *GetVersionsOK) readResponse(response runtime.ClientResponse, consumer r
 
 	return nil
 }
+
+// NewGetVersionsBadRequest creates a GetVersionsBadRequest with default headers values
+func NewGetVersionsBadRequest() *GetVersionsBadRequest {
+	return &GetVersionsBadRequest{}
+}
+
+/*GetVersionsBadRequest handles this case with default header values.
+
+ErrorResponse
+*/
+type GetVersionsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetVersionsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/versions][%d] getVersionsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetVersionsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetVersionsServiceUnavailable creates a GetVersionsServiceUnavailable with default headers values
+func NewGetVersionsServiceUnavailable() *GetVersionsServiceUnavailable {
+	return &GetVersionsServiceUnavailable{}
+}
+
+/*GetVersionsServiceUnavailable handles this case with default header values.
+
+ErrorResponse
+*/
+type GetVersionsServiceUnavailable struct {
+	Payload *models.ErrorResponse
+}
+
+func (o *GetVersionsServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/versions][%d] getVersionsServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetVersionsServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -20,6 +20,9 @@ type AttributeResponse struct {
 
 	// attribute values
 	AttributeValues []float64 `json:"attributeValues"`
+
+	// Unit is the unit of the attribute values (vCPU or GiB)
+	Unit string `json:"unit,omitempty"`
 }
 
 // Validate validates this attribute response
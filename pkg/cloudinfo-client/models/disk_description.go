@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// DiskDescription DiskDescription describes block storage volumes of a cluster
+// swagger:model DiskDescription
+type DiskDescription struct {
+
+	// Count is the number of the volumes (1 if 0)
+	Count int64 `json:"count,omitempty"`
+
+	// Iops is the number of the provisioned IOPS of a volume, only charged if the volume type charges IOPS separately
+	Iops float64 `json:"iops,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// SizeGb is the size of a volume in GiB
+	SizeGb float64 `json:"sizeGb,omitempty"`
+
+	// Type is the volume type (see StorageInfo)
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this disk description
+func (m *DiskDescription) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DiskDescription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DiskDescription) UnmarshalBinary(b []byte) error {
+	var res DiskDescription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// EgressTier EgressTier holds the price of the data transferred to the internet within a range of the monthly volume
+// swagger:model EgressTier
+type EgressTier struct {
+
+	// EndGb is the monthly volume in GB the tier ends at, 0 if the tier is not bounded
+	EndGb float64 `json:"endGb,omitempty"`
+
+	// PricePerGb is the price of a GB transferred within the tier
+	PricePerGb float64 `json:"pricePerGb,omitempty"`
+
+	// StartGb is the monthly volume in GB the tier starts at
+	StartGb float64 `json:"startGb,omitempty"`
+}
+
+// Validate validates this egress tier
+func (m *EgressTier) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EgressTier) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EgressTier) UnmarshalBinary(b []byte) error {
+	var res EgressTier
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
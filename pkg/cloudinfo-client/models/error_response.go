@@ -15,10 +15,10 @@ import (
 // swagger:model ErrorResponse
 type ErrorResponse struct {
 
-	// error code
+	// ErrorCode is a stable, machine readable code (eg.: not_found, not_yet_available)
 	ErrorCode string `json:"code,omitempty"`
 
-	// error message
+	// ErrorMessage is the human readable description of the error
 	ErrorMessage string `json:"message,omitempty"`
 }
 
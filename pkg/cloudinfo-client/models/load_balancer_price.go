@@ -0,0 +1,52 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// LoadBalancerPrice LoadBalancerPrice describes the prices of a load balancer type
+// swagger:model LoadBalancerPrice
+type LoadBalancerPrice struct {
+
+	// PricePerCapacityUnitHour is the price of a capacity unit (eg.: aws LCU) per hour, 0 if not charged
+	PricePerCapacityUnitHour float64 `json:"pricePerCapacityUnitHour,omitempty"`
+
+	// PricePerGb is the price of a GB processed by the load balancer, 0 if not charged
+	PricePerGb float64 `json:"pricePerGb,omitempty"`
+
+	// PricePerHour is the price of a load balancer (or load balancing rule) per hour
+	PricePerHour float64 `json:"pricePerHour,omitempty"`
+
+	// Type is the provider specific name of the load balancer type (eg.: application, network, forwarding-rule, standard)
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this load balancer price
+func (m *LoadBalancerPrice) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LoadBalancerPrice) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LoadBalancerPrice) UnmarshalBinary(b []byte) error {
+	var res LoadBalancerPrice
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
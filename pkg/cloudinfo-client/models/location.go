@@ -11,21 +11,24 @@ import (
 	"github.com/go-openapi/swag"
 )
 
-// GetProviderPathParams GetProviderPathParams is a placeholder for the providers related route path parameters
-// swagger:model GetProviderPathParams
-type GetProviderPathParams struct {
+// Location location
+// swagger:model Location
+type Location struct {
 
-	// in:path
-	Provider string `json:"provider,omitempty"`
+	// column
+	Column int64 `json:"column,omitempty"`
+
+	// line
+	Line int64 `json:"line,omitempty"`
 }
 
-// Validate validates this get provider path params
-func (m *GetProviderPathParams) Validate(formats strfmt.Registry) error {
+// Validate validates this location
+func (m *Location) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (m *GetProviderPathParams) MarshalBinary() ([]byte, error) {
+func (m *Location) MarshalBinary() ([]byte, error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -33,8 +36,8 @@ func (m *GetProviderPathParams) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (m *GetProviderPathParams) UnmarshalBinary(b []byte) error {
-	var res GetProviderPathParams
+func (m *Location) UnmarshalBinary(b []byte) error {
+	var res Location
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// Meta Meta holds the metadata of the v2 responses
+// swagger:model Meta
+type Meta struct {
+
+	// Generation identifies the scrape of the provider the data comes from (its completion time in unix milliseconds)
+	Generation int64 `json:"generation,omitempty"`
+
+	// freshness
+	Freshness *Freshness `json:"freshness,omitempty"`
+
+	// pagination
+	Pagination *Pagination `json:"pagination,omitempty"`
+
+	// units
+	Units *ResourceUnits `json:"units,omitempty"`
+}
+
+// Validate validates this meta
+func (m *Meta) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFreshness(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePagination(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Meta) validateFreshness(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Freshness) { // not required
+		return nil
+	}
+
+	if m.Freshness != nil {
+		if err := m.Freshness.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("freshness")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Meta) validatePagination(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Pagination) { // not required
+		return nil
+	}
+
+	if m.Pagination != nil {
+		if err := m.Pagination.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("pagination")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Meta) validateUnits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Units) { // not required
+		return nil
+	}
+
+	if m.Units != nil {
+		if err := m.Units.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("units")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Meta) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Meta) UnmarshalBinary(b []byte) error {
+	var res Meta
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkPrices NetworkPrices holds the prices of the network products in a region
+// swagger:model NetworkPrices
+type NetworkPrices struct {
+
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency,omitempty"`
+
+	// InterZonePerGb is the price of a GB transferred between the zones of the region
+	InterZonePerGb float64 `json:"interZonePerGb,omitempty"`
+
+	// InternetEgress lists the tiers of the monthly data transfer to the internet, ordered by volume
+	InternetEgress []*EgressTier `json:"internetEgress"`
+
+	// LoadBalancers lists the load balancer types of the region
+	LoadBalancers []*LoadBalancerPrice `json:"loadBalancers"`
+}
+
+// Validate validates this network prices
+func (m *NetworkPrices) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateInternetEgress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLoadBalancers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkPrices) validateInternetEgress(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.InternetEgress) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.InternetEgress); i++ {
+		if swag.IsZero(m.InternetEgress[i]) { // not required
+			continue
+		}
+
+		if m.InternetEgress[i] != nil {
+			if err := m.InternetEgress[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("internetEgress" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworkPrices) validateLoadBalancers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LoadBalancers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LoadBalancers); i++ {
+		if swag.IsZero(m.LoadBalancers[i]) { // not required
+			continue
+		}
+
+		if m.LoadBalancers[i] != nil {
+			if err := m.LoadBalancers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("loadBalancers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkPrices) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkPrices) UnmarshalBinary(b []byte) error {
+	var res NetworkPrices
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// NodePool NodePool is a group of nodes of the same instance type
+// swagger:model NodePool
+type NodePool struct {
+
+	// Cpus is the number of vCPUs of a node
+	Cpus float64 `json:"cpusPerNode,omitempty"`
+
+	// Mem is the memory of a node in GiB
+	Mem float64 `json:"memPerNode,omitempty"`
+
+	// OnDemandNodes is the number of the on demand nodes
+	OnDemandNodes int64 `json:"onDemandNodes,omitempty"`
+
+	// OnDemandPrice is the hourly on demand price of a node
+	OnDemandPrice float64 `json:"onDemandPrice,omitempty"`
+
+	// SpotNodes is the number of the spot nodes
+	SpotNodes int64 `json:"spotNodes,omitempty"`
+
+	// SpotPrice is the hourly spot price of a node, the mean of the zones with a spot price
+	SpotPrice float64 `json:"spotPrice,omitempty"`
+
+	// SpotZones lists the zones the spot price is given for
+	SpotZones []string `json:"spotZones"`
+
+	// Type is the instance type of the nodes
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this node pool
+func (m *NodePool) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodePool) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodePool) UnmarshalBinary(b []byte) error {
+	var res NodePool
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
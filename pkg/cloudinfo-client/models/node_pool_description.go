@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// NodePoolDescription NodePoolDescription describes a node pool of a cluster
+// swagger:model NodePoolDescription
+type NodePoolDescription struct {
+
+	// Count is the number of the nodes
+	Count int64 `json:"count,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// SpotRatio is the share of the nodes running on spot instances between 0 and 1, the spot nodes are rounded down
+	SpotRatio float64 `json:"spotRatio,omitempty"`
+
+	// Type is the instance type of the nodes
+	Type string `json:"type,omitempty"`
+
+	// Zones are the zones the spot nodes run in, the spot price is the mean of the zones (every zone if empty)
+	Zones []string `json:"zones"`
+}
+
+// Validate validates this node pool description
+func (m *NodePoolDescription) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodePoolDescription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodePoolDescription) UnmarshalBinary(b []byte) error {
+	var res NodePoolDescription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
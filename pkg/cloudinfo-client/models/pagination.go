@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// Pagination Pagination describes a page of the listed items
+// swagger:model Pagination
+type Pagination struct {
+
+	// Limit is the maximum number of the items on a page, missing if not paged
+	Limit int64 `json:"limit,omitempty"`
+
+	// NextCursor is the cursor of the next page, missing on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// Total is the number of the items matching the request on every page
+	Total int64 `json:"total,omitempty"`
+}
+
+// Validate validates this pagination
+func (m *Pagination) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Pagination) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Pagination) UnmarshalBinary(b []byte) error {
+	var res Pagination
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -18,11 +18,17 @@ import (
 // swagger:model ProductDetailsResponse
 type ProductDetailsResponse struct {
 
+	// NextCursor is the cursor of the next page of the products, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// Products represents a slice of products for a given provider (VMs with attributes and process)
 	Products []*ProductDetails `json:"products"`
 
 	// ScrapingTime represents scraping time for a given provider in milliseconds
 	ScrapingTime string `json:"scrapingTime,omitempty"`
+
+	// units
+	Units *ResourceUnits `json:"units,omitempty"`
 }
 
 // Validate validates this product details response
@@ -33,6 +39,10 @@ func (m *ProductDetailsResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateUnits(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -64,6 +74,24 @@ func (m *ProductDetailsResponse) validateProducts(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ProductDetailsResponse) validateUnits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Units) { // not required
+		return nil
+	}
+
+	if m.Units != nil {
+		if err := m.Units.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("units")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ProductDetailsResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
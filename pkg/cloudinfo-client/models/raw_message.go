@@ -0,0 +1,22 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// RawMessage RawMessage is a raw encoded JSON value.
+//
+// It implements [Marshaler] and [Unmarshaler] and can
+// be used to delay JSON decoding or precompute a JSON encoding.
+// swagger:model RawMessage
+type RawMessage []uint8
+
+// Validate validates this raw message
+func (m RawMessage) Validate(formats strfmt.Registry) error {
+	return nil
+}
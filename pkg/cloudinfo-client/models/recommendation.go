@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// Recommendation Recommendation is a combination of node pools providing the requested resources
+// swagger:model Recommendation
+type Recommendation struct {
+
+	// Cost is the total hourly cost of the nodes
+	Cost float64 `json:"cost,omitempty"`
+
+	// Cpu is the total number of vCPUs of the nodes
+	CPU float64 `json:"cpu,omitempty"`
+
+	// Mem is the total memory of the nodes in GiB
+	Mem float64 `json:"mem,omitempty"`
+
+	// NodePools are the node pools of the recommendation
+	NodePools []*NodePool `json:"nodePools"`
+
+	// Nodes is the number of the nodes in the node pools
+	Nodes int64 `json:"nodes,omitempty"`
+
+	// OnDemandCost is the hourly cost of the on demand nodes
+	OnDemandCost float64 `json:"onDemandCost,omitempty"`
+
+	// Reasons explain why the instance types were chosen
+	Reasons []string `json:"reasons"`
+
+	// SpotCost is the hourly cost of the spot nodes
+	SpotCost float64 `json:"spotCost,omitempty"`
+}
+
+// Validate validates this recommendation
+func (m *Recommendation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNodePools(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Recommendation) validateNodePools(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NodePools) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NodePools); i++ {
+		if swag.IsZero(m.NodePools[i]) { // not required
+			continue
+		}
+
+		if m.NodePools[i] != nil {
+			if err := m.NodePools[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodePools" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Recommendation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Recommendation) UnmarshalBinary(b []byte) error {
+	var res Recommendation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
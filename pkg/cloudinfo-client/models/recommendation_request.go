@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// RecommendationRequest RecommendationRequest describes the resources of a cluster and the constraints of its node pools
+// swagger:model RecommendationRequest
+type RecommendationRequest struct {
+
+	// Excludes lists the instance types or families left out of the node pools
+	Excludes []string `json:"excludes"`
+
+	// Includes lists the instance types or families the node pools are chosen from, every product if empty
+	Includes []string `json:"includes"`
+
+	// Limit is the maximum number of the recommendations (DefaultRecommendations if 0)
+	Limit int64 `json:"limit,omitempty"`
+
+	// MaxNodes is the maximum number of the nodes, unbounded if 0
+	MaxNodes int64 `json:"maxNodes,omitempty"`
+
+	// MinNodes is the minimum number of the nodes (1 by default)
+	MinNodes int64 `json:"minNodes,omitempty"`
+
+	// SpotRatio is the ratio of the nodes running on spot instances between 0 and 1, the spot nodes are rounded down
+	SpotRatio float64 `json:"spotRatio,omitempty"`
+
+	// SumCpu is the total number of vCPUs the node pools should provide
+	SumCPU float64 `json:"sumCpu,omitempty"`
+
+	// SumMem is the total memory (GiB) the node pools should provide
+	SumMem float64 `json:"sumMem,omitempty"`
+
+	// Zones are the zones of the region the nodes run in, every zone if empty
+	Zones []string `json:"zones"`
+}
+
+// Validate validates this recommendation request
+func (m *RecommendationRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RecommendationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RecommendationRequest) UnmarshalBinary(b []byte) error {
+	var res RecommendationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
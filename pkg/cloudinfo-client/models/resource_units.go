@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// ResourceUnits ResourceUnits describes the units the resources and the prices of the products are given in
+// swagger:model ResourceUnits
+type ResourceUnits struct {
+
+	// bandwidth
+	Bandwidth string `json:"bandwidth,omitempty"`
+
+	// Cpu
+	CPU string `json:"cpu,omitempty"`
+
+	// gpu memory
+	GpuMemory string `json:"gpuMemory,omitempty"`
+
+	// local disk
+	LocalDisk string `json:"localDisk,omitempty"`
+
+	// memory
+	Memory string `json:"memory,omitempty"`
+
+	// price
+	Price string `json:"price,omitempty"`
+}
+
+// Validate validates this resource units
+func (m *ResourceUnits) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ResourceUnits) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ResourceUnits) UnmarshalBinary(b []byte) error {
+	var res ResourceUnits
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -8,6 +8,7 @@ package models
 import (
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
 )
 
@@ -19,10 +20,40 @@ type Service struct {
 
 	// service
 	Service string `json:"service,omitempty"`
+
+	// control plane fee
+	ControlPlaneFee *ControlPlaneFee `json:"controlPlaneFee,omitempty"`
 }
 
 // Validate validates this service
 func (m *Service) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateControlPlaneFee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Service) validateControlPlaneFee(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ControlPlaneFee) { // not required
+		return nil
+	}
+
+	if m.ControlPlaneFee != nil {
+		if err := m.ControlPlaneFee.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("controlPlaneFee")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SpotAggregate SpotAggregate is the spot price of an instance type aggregated over the zones of a region
+// swagger:model SpotAggregate
+type SpotAggregate struct {
+
+	// Age is the age of the spot prices in seconds, 0 if unknown
+	Age float64 `json:"ageSeconds,omitempty"`
+
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency,omitempty"`
+
+	// MissingZones lists the requested zones without a spot price, they are left out of the aggregation
+	MissingZones []string `json:"missingZones"`
+
+	// Price is the aggregated hourly spot price, 0 if none of the zones has a spot price
+	Price float64 `json:"price,omitempty"`
+
+	// Strategy is the strategy the zone prices are aggregated with
+	Strategy string `json:"strategy,omitempty"`
+
+	// UpdatedAt is the time the spot prices were retrieved from the provider, zero if unknown
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
+
+	// Zones lists the zones that contributed to the aggregated price, ordered by price
+	Zones []*ZonePrice `json:"zones"`
+}
+
+// Validate validates this spot aggregate
+func (m *SpotAggregate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateZones(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SpotAggregate) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SpotAggregate) validateZones(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Zones) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Zones); i++ {
+		if swag.IsZero(m.Zones[i]) { // not required
+			continue
+		}
+
+		if m.Zones[i] != nil {
+			if err := m.Zones[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("zones" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SpotAggregate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SpotAggregate) UnmarshalBinary(b []byte) error {
+	var res SpotAggregate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// StorageInfo StorageInfo describes a block storage volume type and its prices in a region
+// swagger:model StorageInfo
+type StorageInfo struct {
+
+	// Category is the media the volumes are backed by (ssd or hdd)
+	Category string `json:"category,omitempty"`
+
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency,omitempty"`
+
+	// MaxSize is the maximum size of a volume in GiB (the size of the tier for size tiers), 0 if unknown
+	MaxSize float64 `json:"maxSize,omitempty"`
+
+	// MinSize is the minimum size of a volume in GiB, 0 if unknown
+	MinSize float64 `json:"minSize,omitempty"`
+
+	// PricePerGbMonth is the monthly price of a GiB of provisioned capacity; for size tiers the price of the tier spread over its size
+	PricePerGbMonth float64 `json:"pricePerGbMonth,omitempty"`
+
+	// PricePerIopsMonth is the monthly price of a provisioned IOPS, 0 if the IOPS are not charged separately
+	PricePerIopsMonth float64 `json:"pricePerIopsMonth,omitempty"`
+
+	// Tier is the size tier of the volume type, only set if the volumes are billed per size tier (azure managed disks)
+	Tier string `json:"tier,omitempty"`
+
+	// Type is the provider specific name of the volume type (eg.: gp2, pd-ssd, Premium_LRS, cloud_ssd)
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this storage info
+func (m *StorageInfo) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StorageInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StorageInfo) UnmarshalBinary(b []byte) error {
+	var res StorageInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
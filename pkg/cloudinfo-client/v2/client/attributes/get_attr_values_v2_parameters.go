@@ -0,0 +1,188 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package attributes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetAttrValuesV2Params creates a new GetAttrValuesV2Params object
+// with the default values initialized.
+func NewGetAttrValuesV2Params() *GetAttrValuesV2Params {
+	var ()
+	return &GetAttrValuesV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAttrValuesV2ParamsWithTimeout creates a new GetAttrValuesV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetAttrValuesV2ParamsWithTimeout(timeout time.Duration) *GetAttrValuesV2Params {
+	var ()
+	return &GetAttrValuesV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetAttrValuesV2ParamsWithContext creates a new GetAttrValuesV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetAttrValuesV2ParamsWithContext(ctx context.Context) *GetAttrValuesV2Params {
+	var ()
+	return &GetAttrValuesV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetAttrValuesV2ParamsWithHTTPClient creates a new GetAttrValuesV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetAttrValuesV2ParamsWithHTTPClient(client *http.Client) *GetAttrValuesV2Params {
+	var ()
+	return &GetAttrValuesV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetAttrValuesV2Params contains all the parameters to send to the API endpoint
+for the get attr values v2 operation typically these are written to a http.Request
+*/
+type GetAttrValuesV2Params struct {
+
+	/*Attribute*/
+	Attribute string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithTimeout(timeout time.Duration) *GetAttrValuesV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithContext(ctx context.Context) *GetAttrValuesV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithHTTPClient(client *http.Client) *GetAttrValuesV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAttribute adds the attribute to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithAttribute(attribute string) *GetAttrValuesV2Params {
+	o.SetAttribute(attribute)
+	return o
+}
+
+// SetAttribute adds the attribute to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetAttribute(attribute string) {
+	o.Attribute = attribute
+}
+
+// WithProvider adds the provider to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithProvider(provider string) *GetAttrValuesV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithRegion(region string) *GetAttrValuesV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get attr values v2 params
+func (o *GetAttrValuesV2Params) WithService(service string) *GetAttrValuesV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get attr values v2 params
+func (o *GetAttrValuesV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAttrValuesV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param attribute
+	if err := r.SetPathParam("attribute", o.Attribute); err != nil {
+		return err
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
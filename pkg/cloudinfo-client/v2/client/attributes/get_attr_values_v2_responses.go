@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package attributes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetAttrValuesV2Reader is a Reader for the GetAttrValuesV2 structure.
+type GetAttrValuesV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAttrValuesV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetAttrValuesV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetAttrValuesV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetAttrValuesV2BadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetAttrValuesV2OK creates a GetAttrValuesV2OK with default headers values
+func NewGetAttrValuesV2OK() *GetAttrValuesV2OK {
+	return &GetAttrValuesV2OK{}
+}
+
+/*GetAttrValuesV2OK handles this case with default header values.
+
+AttributeEnvelope
+*/
+type GetAttrValuesV2OK struct {
+	Payload *models.AttributeEnvelope
+}
+
+func (o *GetAttrValuesV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute}][%d] getAttrValuesV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetAttrValuesV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AttributeEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAttrValuesV2NotFound creates a GetAttrValuesV2NotFound with default headers values
+func NewGetAttrValuesV2NotFound() *GetAttrValuesV2NotFound {
+	return &GetAttrValuesV2NotFound{}
+}
+
+/*GetAttrValuesV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetAttrValuesV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetAttrValuesV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute}][%d] getAttrValuesV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetAttrValuesV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAttrValuesV2BadGateway creates a GetAttrValuesV2BadGateway with default headers values
+func NewGetAttrValuesV2BadGateway() *GetAttrValuesV2BadGateway {
+	return &GetAttrValuesV2BadGateway{}
+}
+
+/*GetAttrValuesV2BadGateway handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetAttrValuesV2BadGateway struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetAttrValuesV2BadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products/{attribute}][%d] getAttrValuesV2BadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetAttrValuesV2BadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package client
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/attributes"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/compare"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/estimate"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/images"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/network"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/products"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/provider"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/providers"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/recommendations"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/regions"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/service"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/services"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/spot"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/storage"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/client/versions"
+)
+
+// Default cloudinfo HTTP client.
+var Default = NewHTTPClient(nil)
+
+const (
+	// DefaultHost is the default Host
+	// found in Meta (info) section of spec file
+	DefaultHost string = "localhost"
+	// DefaultBasePath is the default BasePath
+	// found in Meta (info) section of spec file
+	DefaultBasePath string = "/api/v2"
+)
+
+// DefaultSchemes are the default schemes found in Meta (info) section of spec file
+var DefaultSchemes = []string{"http", "https"}
+
+// NewHTTPClient creates a new cloudinfo HTTP client.
+func NewHTTPClient(formats strfmt.Registry) *Cloudinfo {
+	return NewHTTPClientWithConfig(formats, nil)
+}
+
+// NewHTTPClientWithConfig creates a new cloudinfo HTTP client,
+// using a customizable transport config.
+func NewHTTPClientWithConfig(formats strfmt.Registry, cfg *TransportConfig) *Cloudinfo {
+	// ensure nullable parameters have default
+	if cfg == nil {
+		cfg = DefaultTransportConfig()
+	}
+
+	// create transport and client
+	transport := httptransport.New(cfg.Host, cfg.BasePath, cfg.Schemes)
+	return New(transport, formats)
+}
+
+// New creates a new cloudinfo client
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Cloudinfo {
+	// ensure nullable parameters have default
+	if formats == nil {
+		formats = strfmt.Default
+	}
+
+	cli := new(Cloudinfo)
+	cli.Transport = transport
+
+	cli.Attributes = attributes.New(transport, formats)
+
+	cli.Compare = compare.New(transport, formats)
+
+	cli.Estimate = estimate.New(transport, formats)
+
+	cli.Images = images.New(transport, formats)
+
+	cli.Network = network.New(transport, formats)
+
+	cli.Products = products.New(transport, formats)
+
+	cli.Provider = provider.New(transport, formats)
+
+	cli.Providers = providers.New(transport, formats)
+
+	cli.Recommendations = recommendations.New(transport, formats)
+
+	cli.Regions = regions.New(transport, formats)
+
+	cli.Service = service.New(transport, formats)
+
+	cli.Services = services.New(transport, formats)
+
+	cli.Spot = spot.New(transport, formats)
+
+	cli.Storage = storage.New(transport, formats)
+
+	cli.Versions = versions.New(transport, formats)
+
+	return cli
+}
+
+// DefaultTransportConfig creates a TransportConfig with the
+// default settings taken from the meta section of the spec file.
+func DefaultTransportConfig() *TransportConfig {
+	return &TransportConfig{
+		Host:     DefaultHost,
+		BasePath: DefaultBasePath,
+		Schemes:  DefaultSchemes,
+	}
+}
+
+// TransportConfig contains the transport related info,
+// found in the meta section of the spec file.
+type TransportConfig struct {
+	Host     string
+	BasePath string
+	Schemes  []string
+}
+
+// WithHost overrides the default host,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithHost(host string) *TransportConfig {
+	cfg.Host = host
+	return cfg
+}
+
+// WithBasePath overrides the default basePath,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithBasePath(basePath string) *TransportConfig {
+	cfg.BasePath = basePath
+	return cfg
+}
+
+// WithSchemes overrides the default schemes,
+// provided by the meta section of the spec file.
+func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
+	cfg.Schemes = schemes
+	return cfg
+}
+
+// Cloudinfo is a client for cloudinfo
+type Cloudinfo struct {
+	Attributes *attributes.Client
+
+	Compare *compare.Client
+
+	Estimate *estimate.Client
+
+	Images *images.Client
+
+	Network *network.Client
+
+	Products *products.Client
+
+	Provider *provider.Client
+
+	Providers *providers.Client
+
+	Recommendations *recommendations.Client
+
+	Regions *regions.Client
+
+	Service *service.Client
+
+	Services *services.Client
+
+	Spot *spot.Client
+
+	Storage *storage.Client
+
+	Versions *versions.Client
+
+	Transport runtime.ClientTransport
+}
+
+// SetTransport changes the transport on the client and all its subresources
+func (c *Cloudinfo) SetTransport(transport runtime.ClientTransport) {
+	c.Transport = transport
+
+	c.Attributes.SetTransport(transport)
+
+	c.Compare.SetTransport(transport)
+
+	c.Estimate.SetTransport(transport)
+
+	c.Images.SetTransport(transport)
+
+	c.Network.SetTransport(transport)
+
+	c.Products.SetTransport(transport)
+
+	c.Provider.SetTransport(transport)
+
+	c.Providers.SetTransport(transport)
+
+	c.Recommendations.SetTransport(transport)
+
+	c.Regions.SetTransport(transport)
+
+	c.Service.SetTransport(transport)
+
+	c.Services.SetTransport(transport)
+
+	c.Spot.SetTransport(transport)
+
+	c.Storage.SetTransport(transport)
+
+	c.Versions.SetTransport(transport)
+
+}
@@ -0,0 +1,405 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package compare
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCompareV2Params creates a new CompareV2Params object
+// with the default values initialized.
+func NewCompareV2Params() *CompareV2Params {
+	var ()
+	return &CompareV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCompareV2ParamsWithTimeout creates a new CompareV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCompareV2ParamsWithTimeout(timeout time.Duration) *CompareV2Params {
+	var ()
+	return &CompareV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewCompareV2ParamsWithContext creates a new CompareV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewCompareV2ParamsWithContext(ctx context.Context) *CompareV2Params {
+	var ()
+	return &CompareV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewCompareV2ParamsWithHTTPClient creates a new CompareV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCompareV2ParamsWithHTTPClient(client *http.Client) *CompareV2Params {
+	var ()
+	return &CompareV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*CompareV2Params contains all the parameters to send to the API endpoint
+for the compare v2 operation typically these are written to a http.Request
+*/
+type CompareV2Params struct {
+
+	/*Count
+	  Count is the number of the closest matches listed per region (1 by default)
+
+	*/
+	Count *int64
+	/*CPU
+	  CPU is the number of vCPUs of the reference shape (eg.: 4, 500m)
+
+	*/
+	CPU *string
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are compared in (USD by default)
+
+	*/
+	Currency *string
+	/*Gpu
+	  Gpu is the number of gpus of the reference shape
+
+	*/
+	Gpu *string
+	/*InstanceType
+	  InstanceType is the reference instance type, its shape and price are compared with
+
+	*/
+	InstanceType *string
+	/*Mem
+	  Mem is the memory of the reference shape in GiB or as a Kubernetes style quantity (eg.: 16, 16Gi)
+
+	*/
+	Mem *string
+	/*Provider
+	  Provider is the provider of the reference instance type, used instead of the reference shape
+
+	*/
+	Provider *string
+	/*Providers
+	  Providers is the comma separated list of the providers the matches are looked for (every provider by default)
+
+	*/
+	Providers *string
+	/*Region
+	  Region is the region of the reference instance type
+
+	*/
+	Region *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the compare v2 params
+func (o *CompareV2Params) WithTimeout(timeout time.Duration) *CompareV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the compare v2 params
+func (o *CompareV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the compare v2 params
+func (o *CompareV2Params) WithContext(ctx context.Context) *CompareV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the compare v2 params
+func (o *CompareV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the compare v2 params
+func (o *CompareV2Params) WithHTTPClient(client *http.Client) *CompareV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the compare v2 params
+func (o *CompareV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCount adds the count to the compare v2 params
+func (o *CompareV2Params) WithCount(count *int64) *CompareV2Params {
+	o.SetCount(count)
+	return o
+}
+
+// SetCount adds the count to the compare v2 params
+func (o *CompareV2Params) SetCount(count *int64) {
+	o.Count = count
+}
+
+// WithCPU adds the cpu to the compare v2 params
+func (o *CompareV2Params) WithCPU(cpu *string) *CompareV2Params {
+	o.SetCPU(cpu)
+	return o
+}
+
+// SetCPU adds the cpu to the compare v2 params
+func (o *CompareV2Params) SetCPU(cpu *string) {
+	o.CPU = cpu
+}
+
+// WithCurrency adds the currency to the compare v2 params
+func (o *CompareV2Params) WithCurrency(currency *string) *CompareV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the compare v2 params
+func (o *CompareV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithGpu adds the gpu to the compare v2 params
+func (o *CompareV2Params) WithGpu(gpu *string) *CompareV2Params {
+	o.SetGpu(gpu)
+	return o
+}
+
+// SetGpu adds the gpu to the compare v2 params
+func (o *CompareV2Params) SetGpu(gpu *string) {
+	o.Gpu = gpu
+}
+
+// WithInstanceType adds the instanceType to the compare v2 params
+func (o *CompareV2Params) WithInstanceType(instanceType *string) *CompareV2Params {
+	o.SetInstanceType(instanceType)
+	return o
+}
+
+// SetInstanceType adds the instanceType to the compare v2 params
+func (o *CompareV2Params) SetInstanceType(instanceType *string) {
+	o.InstanceType = instanceType
+}
+
+// WithMem adds the mem to the compare v2 params
+func (o *CompareV2Params) WithMem(mem *string) *CompareV2Params {
+	o.SetMem(mem)
+	return o
+}
+
+// SetMem adds the mem to the compare v2 params
+func (o *CompareV2Params) SetMem(mem *string) {
+	o.Mem = mem
+}
+
+// WithProvider adds the provider to the compare v2 params
+func (o *CompareV2Params) WithProvider(provider *string) *CompareV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the compare v2 params
+func (o *CompareV2Params) SetProvider(provider *string) {
+	o.Provider = provider
+}
+
+// WithProviders adds the providers to the compare v2 params
+func (o *CompareV2Params) WithProviders(providers *string) *CompareV2Params {
+	o.SetProviders(providers)
+	return o
+}
+
+// SetProviders adds the providers to the compare v2 params
+func (o *CompareV2Params) SetProviders(providers *string) {
+	o.Providers = providers
+}
+
+// WithRegion adds the region to the compare v2 params
+func (o *CompareV2Params) WithRegion(region *string) *CompareV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the compare v2 params
+func (o *CompareV2Params) SetRegion(region *string) {
+	o.Region = region
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CompareV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Count != nil {
+
+		// query param count
+		var qrCount int64
+		if o.Count != nil {
+			qrCount = *o.Count
+		}
+		qCount := swag.FormatInt64(qrCount)
+		if qCount != "" {
+			if err := r.SetQueryParam("count", qCount); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.CPU != nil {
+
+		// query param cpu
+		var qrCPU string
+		if o.CPU != nil {
+			qrCPU = *o.CPU
+		}
+		qCPU := qrCPU
+		if qCPU != "" {
+			if err := r.SetQueryParam("cpu", qCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Gpu != nil {
+
+		// query param gpu
+		var qrGpu string
+		if o.Gpu != nil {
+			qrGpu = *o.Gpu
+		}
+		qGpu := qrGpu
+		if qGpu != "" {
+			if err := r.SetQueryParam("gpu", qGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.InstanceType != nil {
+
+		// query param instanceType
+		var qrInstanceType string
+		if o.InstanceType != nil {
+			qrInstanceType = *o.InstanceType
+		}
+		qInstanceType := qrInstanceType
+		if qInstanceType != "" {
+			if err := r.SetQueryParam("instanceType", qInstanceType); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Mem != nil {
+
+		// query param mem
+		var qrMem string
+		if o.Mem != nil {
+			qrMem = *o.Mem
+		}
+		qMem := qrMem
+		if qMem != "" {
+			if err := r.SetQueryParam("mem", qMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Provider != nil {
+
+		// query param provider
+		var qrProvider string
+		if o.Provider != nil {
+			qrProvider = *o.Provider
+		}
+		qProvider := qrProvider
+		if qProvider != "" {
+			if err := r.SetQueryParam("provider", qProvider); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Providers != nil {
+
+		// query param providers
+		var qrProviders string
+		if o.Providers != nil {
+			qrProviders = *o.Providers
+		}
+		qProviders := qrProviders
+		if qProviders != "" {
+			if err := r.SetQueryParam("providers", qProviders); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Region != nil {
+
+		// query param region
+		var qrRegion string
+		if o.Region != nil {
+			qrRegion = *o.Region
+		}
+		qRegion := qrRegion
+		if qRegion != "" {
+			if err := r.SetQueryParam("region", qRegion); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
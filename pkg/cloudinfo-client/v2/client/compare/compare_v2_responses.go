@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package compare
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// CompareV2Reader is a Reader for the CompareV2 structure.
+type CompareV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CompareV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCompareV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewCompareV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewCompareV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewCompareV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewCompareV2OK creates a CompareV2OK with default headers values
+func NewCompareV2OK() *CompareV2OK {
+	return &CompareV2OK{}
+}
+
+/*CompareV2OK handles this case with default header values.
+
+CompareEnvelope
+*/
+type CompareV2OK struct {
+	Payload *models.CompareEnvelope
+}
+
+func (o *CompareV2OK) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareV2OK  %+v", 200, o.Payload)
+}
+
+func (o *CompareV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CompareEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareV2BadRequest creates a CompareV2BadRequest with default headers values
+func NewCompareV2BadRequest() *CompareV2BadRequest {
+	return &CompareV2BadRequest{}
+}
+
+/*CompareV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type CompareV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *CompareV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CompareV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareV2NotFound creates a CompareV2NotFound with default headers values
+func NewCompareV2NotFound() *CompareV2NotFound {
+	return &CompareV2NotFound{}
+}
+
+/*CompareV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type CompareV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *CompareV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *CompareV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCompareV2ServiceUnavailable creates a CompareV2ServiceUnavailable with default headers values
+func NewCompareV2ServiceUnavailable() *CompareV2ServiceUnavailable {
+	return &CompareV2ServiceUnavailable{}
+}
+
+/*CompareV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type CompareV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *CompareV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /compare][%d] compareV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *CompareV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package estimate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// EstimateCostV2Reader is a Reader for the EstimateCostV2 structure.
+type EstimateCostV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EstimateCostV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewEstimateCostV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewEstimateCostV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewEstimateCostV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewEstimateCostV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewEstimateCostV2OK creates a EstimateCostV2OK with default headers values
+func NewEstimateCostV2OK() *EstimateCostV2OK {
+	return &EstimateCostV2OK{}
+}
+
+/*EstimateCostV2OK handles this case with default header values.
+
+CostEstimateEnvelope
+*/
+type EstimateCostV2OK struct {
+	Payload *models.CostEstimateEnvelope
+}
+
+func (o *EstimateCostV2OK) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostV2OK  %+v", 200, o.Payload)
+}
+
+func (o *EstimateCostV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CostEstimateEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostV2BadRequest creates a EstimateCostV2BadRequest with default headers values
+func NewEstimateCostV2BadRequest() *EstimateCostV2BadRequest {
+	return &EstimateCostV2BadRequest{}
+}
+
+/*EstimateCostV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type EstimateCostV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *EstimateCostV2BadRequest) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *EstimateCostV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostV2NotFound creates a EstimateCostV2NotFound with default headers values
+func NewEstimateCostV2NotFound() *EstimateCostV2NotFound {
+	return &EstimateCostV2NotFound{}
+}
+
+/*EstimateCostV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type EstimateCostV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *EstimateCostV2NotFound) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *EstimateCostV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateCostV2ServiceUnavailable creates a EstimateCostV2ServiceUnavailable with default headers values
+func NewEstimateCostV2ServiceUnavailable() *EstimateCostV2ServiceUnavailable {
+	return &EstimateCostV2ServiceUnavailable{}
+}
+
+/*EstimateCostV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type EstimateCostV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *EstimateCostV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/estimate][%d] estimateCostV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *EstimateCostV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package images
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetImagesV2Params creates a new GetImagesV2Params object
+// with the default values initialized.
+func NewGetImagesV2Params() *GetImagesV2Params {
+	var ()
+	return &GetImagesV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetImagesV2ParamsWithTimeout creates a new GetImagesV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetImagesV2ParamsWithTimeout(timeout time.Duration) *GetImagesV2Params {
+	var ()
+	return &GetImagesV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetImagesV2ParamsWithContext creates a new GetImagesV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetImagesV2ParamsWithContext(ctx context.Context) *GetImagesV2Params {
+	var ()
+	return &GetImagesV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetImagesV2ParamsWithHTTPClient creates a new GetImagesV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetImagesV2ParamsWithHTTPClient(client *http.Client) *GetImagesV2Params {
+	var ()
+	return &GetImagesV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetImagesV2Params contains all the parameters to send to the API endpoint
+for the get images v2 operation typically these are written to a http.Request
+*/
+type GetImagesV2Params struct {
+
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get images v2 params
+func (o *GetImagesV2Params) WithTimeout(timeout time.Duration) *GetImagesV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get images v2 params
+func (o *GetImagesV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get images v2 params
+func (o *GetImagesV2Params) WithContext(ctx context.Context) *GetImagesV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get images v2 params
+func (o *GetImagesV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get images v2 params
+func (o *GetImagesV2Params) WithHTTPClient(client *http.Client) *GetImagesV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get images v2 params
+func (o *GetImagesV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get images v2 params
+func (o *GetImagesV2Params) WithProvider(provider string) *GetImagesV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get images v2 params
+func (o *GetImagesV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get images v2 params
+func (o *GetImagesV2Params) WithRegion(region string) *GetImagesV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get images v2 params
+func (o *GetImagesV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get images v2 params
+func (o *GetImagesV2Params) WithService(service string) *GetImagesV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get images v2 params
+func (o *GetImagesV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetImagesV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
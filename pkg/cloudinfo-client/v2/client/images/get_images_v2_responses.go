@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package images
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetImagesV2Reader is a Reader for the GetImagesV2 structure.
+type GetImagesV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetImagesV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetImagesV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetImagesV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetImagesV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetImagesV2OK creates a GetImagesV2OK with default headers values
+func NewGetImagesV2OK() *GetImagesV2OK {
+	return &GetImagesV2OK{}
+}
+
+/*GetImagesV2OK handles this case with default header values.
+
+ImagesEnvelope
+*/
+type GetImagesV2OK struct {
+	Payload *models.ImagesEnvelope
+}
+
+func (o *GetImagesV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/images][%d] getImagesV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetImagesV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ImagesEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetImagesV2NotFound creates a GetImagesV2NotFound with default headers values
+func NewGetImagesV2NotFound() *GetImagesV2NotFound {
+	return &GetImagesV2NotFound{}
+}
+
+/*GetImagesV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetImagesV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetImagesV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/images][%d] getImagesV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetImagesV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetImagesV2ServiceUnavailable creates a GetImagesV2ServiceUnavailable with default headers values
+func NewGetImagesV2ServiceUnavailable() *GetImagesV2ServiceUnavailable {
+	return &GetImagesV2ServiceUnavailable{}
+}
+
+/*GetImagesV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetImagesV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetImagesV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/images][%d] getImagesV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetImagesV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
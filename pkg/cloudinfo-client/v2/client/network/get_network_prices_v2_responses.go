@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package network
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetNetworkPricesV2Reader is a Reader for the GetNetworkPricesV2 structure.
+type GetNetworkPricesV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetNetworkPricesV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetNetworkPricesV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetNetworkPricesV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetNetworkPricesV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetNetworkPricesV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetNetworkPricesV2OK creates a GetNetworkPricesV2OK with default headers values
+func NewGetNetworkPricesV2OK() *GetNetworkPricesV2OK {
+	return &GetNetworkPricesV2OK{}
+}
+
+/*GetNetworkPricesV2OK handles this case with default header values.
+
+NetworkPricesEnvelope
+*/
+type GetNetworkPricesV2OK struct {
+	Payload *models.NetworkPricesEnvelope
+}
+
+func (o *GetNetworkPricesV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetNetworkPricesV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkPricesEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNetworkPricesV2BadRequest creates a GetNetworkPricesV2BadRequest with default headers values
+func NewGetNetworkPricesV2BadRequest() *GetNetworkPricesV2BadRequest {
+	return &GetNetworkPricesV2BadRequest{}
+}
+
+/*GetNetworkPricesV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetNetworkPricesV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetNetworkPricesV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetNetworkPricesV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNetworkPricesV2NotFound creates a GetNetworkPricesV2NotFound with default headers values
+func NewGetNetworkPricesV2NotFound() *GetNetworkPricesV2NotFound {
+	return &GetNetworkPricesV2NotFound{}
+}
+
+/*GetNetworkPricesV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetNetworkPricesV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetNetworkPricesV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetNetworkPricesV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetNetworkPricesV2ServiceUnavailable creates a GetNetworkPricesV2ServiceUnavailable with default headers values
+func NewGetNetworkPricesV2ServiceUnavailable() *GetNetworkPricesV2ServiceUnavailable {
+	return &GetNetworkPricesV2ServiceUnavailable{}
+}
+
+/*GetNetworkPricesV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetNetworkPricesV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetNetworkPricesV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/network][%d] getNetworkPricesV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetNetworkPricesV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,971 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetProductsV2Params creates a new GetProductsV2Params object
+// with the default values initialized.
+func NewGetProductsV2Params() *GetProductsV2Params {
+	var ()
+	return &GetProductsV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProductsV2ParamsWithTimeout creates a new GetProductsV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetProductsV2ParamsWithTimeout(timeout time.Duration) *GetProductsV2Params {
+	var ()
+	return &GetProductsV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetProductsV2ParamsWithContext creates a new GetProductsV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetProductsV2ParamsWithContext(ctx context.Context) *GetProductsV2Params {
+	var ()
+	return &GetProductsV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetProductsV2ParamsWithHTTPClient creates a new GetProductsV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetProductsV2ParamsWithHTTPClient(client *http.Client) *GetProductsV2Params {
+	var ()
+	return &GetProductsV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetProductsV2Params contains all the parameters to send to the API endpoint
+for the get products v2 operation typically these are written to a http.Request
+*/
+type GetProductsV2Params struct {
+
+	/*Burst
+	  Burst selects the burstable (true) or the non burstable (false) products
+
+	*/
+	Burst *bool
+	/*Category
+	  Category filters the products by their category: general, compute, memory, storage, accelerated or burstable
+
+	*/
+	Category *string
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*CurrentGen
+	  CurrentGen selects the current generation (true) or the previous generation (false) products
+
+	*/
+	CurrentGen *bool
+	/*Cursor
+	  Cursor is the nextCursor of the previous page, the first page is returned without cursor
+
+	*/
+	Cursor *string
+	/*Family
+	  Family filters the products by their instance family (eg.: m5, n1, Dv3)
+
+	*/
+	Family *string
+	/*Generation
+	  Generation filters the products by the generation of their instance family
+
+	*/
+	Generation *int64
+	/*Limit
+	  Limit is the maximum number of the products returned in a page (every product by default)
+
+	*/
+	Limit *int64
+	/*MaxCPU
+	  MaxCPU filters out the products with more vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+
+	*/
+	MaxCPU *string
+	/*MaxGpu
+	  MaxGpu filters out the products with more gpus
+
+	*/
+	MaxGpu *string
+	/*MaxMem
+	  MaxMem filters out the products with more memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+
+	*/
+	MaxMem *string
+	/*MaxPrice
+	  MaxPrice filters out the products with a higher on demand price, given in the requested currency and pricing model
+
+	*/
+	MaxPrice *float64
+	/*MaxSpotPrice
+	  MaxSpotPrice filters out the products without a spot price up to the given value in any of their zones
+
+	*/
+	MaxSpotPrice *float64
+	/*MinBandwidth
+	  MinBandwidth filters out the products with a network bandwidth (Gbps) below the given value
+
+	*/
+	MinBandwidth *float64
+	/*MinCPU
+	  MinCPU filters out the products with less vCPUs, given as a Kubernetes style quantity (eg.: 2, 500m)
+
+	*/
+	MinCPU *string
+	/*MinGpu
+	  MinGpu filters out the products with less gpus
+
+	*/
+	MinGpu *string
+	/*MinMem
+	  MinMem filters out the products with less memory, given in GiB or as a Kubernetes style quantity (eg.: 4Gi, 512Mi)
+
+	*/
+	MinMem *string
+	/*NetworkCategory
+	  NetworkCategory filters the products by their network performance category: low, medium, high or extra
+
+	*/
+	NetworkCategory *string
+	/*Order
+	  Order is the sort order: asc (default) or desc
+
+	*/
+	Order *string
+	/*Os
+	  Os selects the operating system / license the on demand prices are given for (linux by default)
+
+	*/
+	Os *string
+	/*PaymentOption
+	  PaymentOption selects the payment option of the commitments: no-upfront (default), partial-upfront or all-upfront
+
+	*/
+	PaymentOption *string
+	/*PricingModel
+	  PricingModel selects the pricing model of the products: on-demand (default), commitment-1yr or commitment-3yr
+
+	*/
+	PricingModel *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+	/*Size
+	  Size filters the products by their size within the instance family (eg.: large)
+
+	*/
+	Size *string
+	/*Sort
+	  Sort orders the products by price or pricePerCpu, the products are ordered by their type when only paged
+
+	*/
+	Sort *string
+	/*Zone
+	  Zone selects the products available in a zone of the region, their spot prices are narrowed to the zone
+
+	*/
+	Zone *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get products v2 params
+func (o *GetProductsV2Params) WithTimeout(timeout time.Duration) *GetProductsV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get products v2 params
+func (o *GetProductsV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get products v2 params
+func (o *GetProductsV2Params) WithContext(ctx context.Context) *GetProductsV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get products v2 params
+func (o *GetProductsV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get products v2 params
+func (o *GetProductsV2Params) WithHTTPClient(client *http.Client) *GetProductsV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get products v2 params
+func (o *GetProductsV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBurst adds the burst to the get products v2 params
+func (o *GetProductsV2Params) WithBurst(burst *bool) *GetProductsV2Params {
+	o.SetBurst(burst)
+	return o
+}
+
+// SetBurst adds the burst to the get products v2 params
+func (o *GetProductsV2Params) SetBurst(burst *bool) {
+	o.Burst = burst
+}
+
+// WithCategory adds the category to the get products v2 params
+func (o *GetProductsV2Params) WithCategory(category *string) *GetProductsV2Params {
+	o.SetCategory(category)
+	return o
+}
+
+// SetCategory adds the category to the get products v2 params
+func (o *GetProductsV2Params) SetCategory(category *string) {
+	o.Category = category
+}
+
+// WithCurrency adds the currency to the get products v2 params
+func (o *GetProductsV2Params) WithCurrency(currency *string) *GetProductsV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get products v2 params
+func (o *GetProductsV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithCurrentGen adds the currentGen to the get products v2 params
+func (o *GetProductsV2Params) WithCurrentGen(currentGen *bool) *GetProductsV2Params {
+	o.SetCurrentGen(currentGen)
+	return o
+}
+
+// SetCurrentGen adds the currentGen to the get products v2 params
+func (o *GetProductsV2Params) SetCurrentGen(currentGen *bool) {
+	o.CurrentGen = currentGen
+}
+
+// WithCursor adds the cursor to the get products v2 params
+func (o *GetProductsV2Params) WithCursor(cursor *string) *GetProductsV2Params {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the get products v2 params
+func (o *GetProductsV2Params) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFamily adds the family to the get products v2 params
+func (o *GetProductsV2Params) WithFamily(family *string) *GetProductsV2Params {
+	o.SetFamily(family)
+	return o
+}
+
+// SetFamily adds the family to the get products v2 params
+func (o *GetProductsV2Params) SetFamily(family *string) {
+	o.Family = family
+}
+
+// WithGeneration adds the generation to the get products v2 params
+func (o *GetProductsV2Params) WithGeneration(generation *int64) *GetProductsV2Params {
+	o.SetGeneration(generation)
+	return o
+}
+
+// SetGeneration adds the generation to the get products v2 params
+func (o *GetProductsV2Params) SetGeneration(generation *int64) {
+	o.Generation = generation
+}
+
+// WithLimit adds the limit to the get products v2 params
+func (o *GetProductsV2Params) WithLimit(limit *int64) *GetProductsV2Params {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get products v2 params
+func (o *GetProductsV2Params) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxCPU adds the maxCPU to the get products v2 params
+func (o *GetProductsV2Params) WithMaxCPU(maxCPU *string) *GetProductsV2Params {
+	o.SetMaxCPU(maxCPU)
+	return o
+}
+
+// SetMaxCPU adds the maxCpu to the get products v2 params
+func (o *GetProductsV2Params) SetMaxCPU(maxCPU *string) {
+	o.MaxCPU = maxCPU
+}
+
+// WithMaxGpu adds the maxGpu to the get products v2 params
+func (o *GetProductsV2Params) WithMaxGpu(maxGpu *string) *GetProductsV2Params {
+	o.SetMaxGpu(maxGpu)
+	return o
+}
+
+// SetMaxGpu adds the maxGpu to the get products v2 params
+func (o *GetProductsV2Params) SetMaxGpu(maxGpu *string) {
+	o.MaxGpu = maxGpu
+}
+
+// WithMaxMem adds the maxMem to the get products v2 params
+func (o *GetProductsV2Params) WithMaxMem(maxMem *string) *GetProductsV2Params {
+	o.SetMaxMem(maxMem)
+	return o
+}
+
+// SetMaxMem adds the maxMem to the get products v2 params
+func (o *GetProductsV2Params) SetMaxMem(maxMem *string) {
+	o.MaxMem = maxMem
+}
+
+// WithMaxPrice adds the maxPrice to the get products v2 params
+func (o *GetProductsV2Params) WithMaxPrice(maxPrice *float64) *GetProductsV2Params {
+	o.SetMaxPrice(maxPrice)
+	return o
+}
+
+// SetMaxPrice adds the maxPrice to the get products v2 params
+func (o *GetProductsV2Params) SetMaxPrice(maxPrice *float64) {
+	o.MaxPrice = maxPrice
+}
+
+// WithMaxSpotPrice adds the maxSpotPrice to the get products v2 params
+func (o *GetProductsV2Params) WithMaxSpotPrice(maxSpotPrice *float64) *GetProductsV2Params {
+	o.SetMaxSpotPrice(maxSpotPrice)
+	return o
+}
+
+// SetMaxSpotPrice adds the maxSpotPrice to the get products v2 params
+func (o *GetProductsV2Params) SetMaxSpotPrice(maxSpotPrice *float64) {
+	o.MaxSpotPrice = maxSpotPrice
+}
+
+// WithMinBandwidth adds the minBandwidth to the get products v2 params
+func (o *GetProductsV2Params) WithMinBandwidth(minBandwidth *float64) *GetProductsV2Params {
+	o.SetMinBandwidth(minBandwidth)
+	return o
+}
+
+// SetMinBandwidth adds the minBandwidth to the get products v2 params
+func (o *GetProductsV2Params) SetMinBandwidth(minBandwidth *float64) {
+	o.MinBandwidth = minBandwidth
+}
+
+// WithMinCPU adds the minCPU to the get products v2 params
+func (o *GetProductsV2Params) WithMinCPU(minCPU *string) *GetProductsV2Params {
+	o.SetMinCPU(minCPU)
+	return o
+}
+
+// SetMinCPU adds the minCpu to the get products v2 params
+func (o *GetProductsV2Params) SetMinCPU(minCPU *string) {
+	o.MinCPU = minCPU
+}
+
+// WithMinGpu adds the minGpu to the get products v2 params
+func (o *GetProductsV2Params) WithMinGpu(minGpu *string) *GetProductsV2Params {
+	o.SetMinGpu(minGpu)
+	return o
+}
+
+// SetMinGpu adds the minGpu to the get products v2 params
+func (o *GetProductsV2Params) SetMinGpu(minGpu *string) {
+	o.MinGpu = minGpu
+}
+
+// WithMinMem adds the minMem to the get products v2 params
+func (o *GetProductsV2Params) WithMinMem(minMem *string) *GetProductsV2Params {
+	o.SetMinMem(minMem)
+	return o
+}
+
+// SetMinMem adds the minMem to the get products v2 params
+func (o *GetProductsV2Params) SetMinMem(minMem *string) {
+	o.MinMem = minMem
+}
+
+// WithNetworkCategory adds the networkCategory to the get products v2 params
+func (o *GetProductsV2Params) WithNetworkCategory(networkCategory *string) *GetProductsV2Params {
+	o.SetNetworkCategory(networkCategory)
+	return o
+}
+
+// SetNetworkCategory adds the networkCategory to the get products v2 params
+func (o *GetProductsV2Params) SetNetworkCategory(networkCategory *string) {
+	o.NetworkCategory = networkCategory
+}
+
+// WithOrder adds the order to the get products v2 params
+func (o *GetProductsV2Params) WithOrder(order *string) *GetProductsV2Params {
+	o.SetOrder(order)
+	return o
+}
+
+// SetOrder adds the order to the get products v2 params
+func (o *GetProductsV2Params) SetOrder(order *string) {
+	o.Order = order
+}
+
+// WithOs adds the os to the get products v2 params
+func (o *GetProductsV2Params) WithOs(os *string) *GetProductsV2Params {
+	o.SetOs(os)
+	return o
+}
+
+// SetOs adds the os to the get products v2 params
+func (o *GetProductsV2Params) SetOs(os *string) {
+	o.Os = os
+}
+
+// WithPaymentOption adds the paymentOption to the get products v2 params
+func (o *GetProductsV2Params) WithPaymentOption(paymentOption *string) *GetProductsV2Params {
+	o.SetPaymentOption(paymentOption)
+	return o
+}
+
+// SetPaymentOption adds the paymentOption to the get products v2 params
+func (o *GetProductsV2Params) SetPaymentOption(paymentOption *string) {
+	o.PaymentOption = paymentOption
+}
+
+// WithPricingModel adds the pricingModel to the get products v2 params
+func (o *GetProductsV2Params) WithPricingModel(pricingModel *string) *GetProductsV2Params {
+	o.SetPricingModel(pricingModel)
+	return o
+}
+
+// SetPricingModel adds the pricingModel to the get products v2 params
+func (o *GetProductsV2Params) SetPricingModel(pricingModel *string) {
+	o.PricingModel = pricingModel
+}
+
+// WithProvider adds the provider to the get products v2 params
+func (o *GetProductsV2Params) WithProvider(provider string) *GetProductsV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get products v2 params
+func (o *GetProductsV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get products v2 params
+func (o *GetProductsV2Params) WithRegion(region string) *GetProductsV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get products v2 params
+func (o *GetProductsV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get products v2 params
+func (o *GetProductsV2Params) WithService(service string) *GetProductsV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get products v2 params
+func (o *GetProductsV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WithSize adds the size to the get products v2 params
+func (o *GetProductsV2Params) WithSize(size *string) *GetProductsV2Params {
+	o.SetSize(size)
+	return o
+}
+
+// SetSize adds the size to the get products v2 params
+func (o *GetProductsV2Params) SetSize(size *string) {
+	o.Size = size
+}
+
+// WithSort adds the sort to the get products v2 params
+func (o *GetProductsV2Params) WithSort(sort *string) *GetProductsV2Params {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the get products v2 params
+func (o *GetProductsV2Params) SetSort(sort *string) {
+	o.Sort = sort
+}
+
+// WithZone adds the zone to the get products v2 params
+func (o *GetProductsV2Params) WithZone(zone *string) *GetProductsV2Params {
+	o.SetZone(zone)
+	return o
+}
+
+// SetZone adds the zone to the get products v2 params
+func (o *GetProductsV2Params) SetZone(zone *string) {
+	o.Zone = zone
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProductsV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Burst != nil {
+
+		// query param burst
+		var qrBurst bool
+		if o.Burst != nil {
+			qrBurst = *o.Burst
+		}
+		qBurst := swag.FormatBool(qrBurst)
+		if qBurst != "" {
+			if err := r.SetQueryParam("burst", qBurst); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Category != nil {
+
+		// query param category
+		var qrCategory string
+		if o.Category != nil {
+			qrCategory = *o.Category
+		}
+		qCategory := qrCategory
+		if qCategory != "" {
+			if err := r.SetQueryParam("category", qCategory); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.CurrentGen != nil {
+
+		// query param currentGen
+		var qrCurrentGen bool
+		if o.CurrentGen != nil {
+			qrCurrentGen = *o.CurrentGen
+		}
+		qCurrentGen := swag.FormatBool(qrCurrentGen)
+		if qCurrentGen != "" {
+			if err := r.SetQueryParam("currentGen", qCurrentGen); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Family != nil {
+
+		// query param family
+		var qrFamily string
+		if o.Family != nil {
+			qrFamily = *o.Family
+		}
+		qFamily := qrFamily
+		if qFamily != "" {
+			if err := r.SetQueryParam("family", qFamily); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Generation != nil {
+
+		// query param generation
+		var qrGeneration int64
+		if o.Generation != nil {
+			qrGeneration = *o.Generation
+		}
+		qGeneration := swag.FormatInt64(qrGeneration)
+		if qGeneration != "" {
+			if err := r.SetQueryParam("generation", qGeneration); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxCPU != nil {
+
+		// query param maxCpu
+		var qrMaxCPU string
+		if o.MaxCPU != nil {
+			qrMaxCPU = *o.MaxCPU
+		}
+		qMaxCPU := qrMaxCPU
+		if qMaxCPU != "" {
+			if err := r.SetQueryParam("maxCpu", qMaxCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxGpu != nil {
+
+		// query param maxGpu
+		var qrMaxGpu string
+		if o.MaxGpu != nil {
+			qrMaxGpu = *o.MaxGpu
+		}
+		qMaxGpu := qrMaxGpu
+		if qMaxGpu != "" {
+			if err := r.SetQueryParam("maxGpu", qMaxGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxMem != nil {
+
+		// query param maxMem
+		var qrMaxMem string
+		if o.MaxMem != nil {
+			qrMaxMem = *o.MaxMem
+		}
+		qMaxMem := qrMaxMem
+		if qMaxMem != "" {
+			if err := r.SetQueryParam("maxMem", qMaxMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxPrice != nil {
+
+		// query param maxPrice
+		var qrMaxPrice float64
+		if o.MaxPrice != nil {
+			qrMaxPrice = *o.MaxPrice
+		}
+		qMaxPrice := swag.FormatFloat64(qrMaxPrice)
+		if qMaxPrice != "" {
+			if err := r.SetQueryParam("maxPrice", qMaxPrice); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxSpotPrice != nil {
+
+		// query param maxSpotPrice
+		var qrMaxSpotPrice float64
+		if o.MaxSpotPrice != nil {
+			qrMaxSpotPrice = *o.MaxSpotPrice
+		}
+		qMaxSpotPrice := swag.FormatFloat64(qrMaxSpotPrice)
+		if qMaxSpotPrice != "" {
+			if err := r.SetQueryParam("maxSpotPrice", qMaxSpotPrice); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinBandwidth != nil {
+
+		// query param minBandwidth
+		var qrMinBandwidth float64
+		if o.MinBandwidth != nil {
+			qrMinBandwidth = *o.MinBandwidth
+		}
+		qMinBandwidth := swag.FormatFloat64(qrMinBandwidth)
+		if qMinBandwidth != "" {
+			if err := r.SetQueryParam("minBandwidth", qMinBandwidth); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinCPU != nil {
+
+		// query param minCpu
+		var qrMinCPU string
+		if o.MinCPU != nil {
+			qrMinCPU = *o.MinCPU
+		}
+		qMinCPU := qrMinCPU
+		if qMinCPU != "" {
+			if err := r.SetQueryParam("minCpu", qMinCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinGpu != nil {
+
+		// query param minGpu
+		var qrMinGpu string
+		if o.MinGpu != nil {
+			qrMinGpu = *o.MinGpu
+		}
+		qMinGpu := qrMinGpu
+		if qMinGpu != "" {
+			if err := r.SetQueryParam("minGpu", qMinGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinMem != nil {
+
+		// query param minMem
+		var qrMinMem string
+		if o.MinMem != nil {
+			qrMinMem = *o.MinMem
+		}
+		qMinMem := qrMinMem
+		if qMinMem != "" {
+			if err := r.SetQueryParam("minMem", qMinMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.NetworkCategory != nil {
+
+		// query param networkCategory
+		var qrNetworkCategory string
+		if o.NetworkCategory != nil {
+			qrNetworkCategory = *o.NetworkCategory
+		}
+		qNetworkCategory := qrNetworkCategory
+		if qNetworkCategory != "" {
+			if err := r.SetQueryParam("networkCategory", qNetworkCategory); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Order != nil {
+
+		// query param order
+		var qrOrder string
+		if o.Order != nil {
+			qrOrder = *o.Order
+		}
+		qOrder := qrOrder
+		if qOrder != "" {
+			if err := r.SetQueryParam("order", qOrder); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Os != nil {
+
+		// query param os
+		var qrOs string
+		if o.Os != nil {
+			qrOs = *o.Os
+		}
+		qOs := qrOs
+		if qOs != "" {
+			if err := r.SetQueryParam("os", qOs); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PaymentOption != nil {
+
+		// query param paymentOption
+		var qrPaymentOption string
+		if o.PaymentOption != nil {
+			qrPaymentOption = *o.PaymentOption
+		}
+		qPaymentOption := qrPaymentOption
+		if qPaymentOption != "" {
+			if err := r.SetQueryParam("paymentOption", qPaymentOption); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PricingModel != nil {
+
+		// query param pricingModel
+		var qrPricingModel string
+		if o.PricingModel != nil {
+			qrPricingModel = *o.PricingModel
+		}
+		qPricingModel := qrPricingModel
+		if qPricingModel != "" {
+			if err := r.SetQueryParam("pricingModel", qPricingModel); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if o.Size != nil {
+
+		// query param size
+		var qrSize string
+		if o.Size != nil {
+			qrSize = *o.Size
+		}
+		qSize := qrSize
+		if qSize != "" {
+			if err := r.SetQueryParam("size", qSize); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Sort != nil {
+
+		// query param sort
+		var qrSort string
+		if o.Sort != nil {
+			qrSort = *o.Sort
+		}
+		qSort := qrSort
+		if qSort != "" {
+			if err := r.SetQueryParam("sort", qSort); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Zone != nil {
+
+		// query param zone
+		var qrZone string
+		if o.Zone != nil {
+			qrZone = *o.Zone
+		}
+		qZone := qrZone
+		if qZone != "" {
+			if err := r.SetQueryParam("zone", qZone); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
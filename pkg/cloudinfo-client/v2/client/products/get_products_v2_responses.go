@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package products
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetProductsV2Reader is a Reader for the GetProductsV2 structure.
+type GetProductsV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProductsV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetProductsV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetProductsV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetProductsV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetProductsV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetProductsV2OK creates a GetProductsV2OK with default headers values
+func NewGetProductsV2OK() *GetProductsV2OK {
+	return &GetProductsV2OK{}
+}
+
+/*GetProductsV2OK handles this case with default header values.
+
+ProductsEnvelope
+*/
+type GetProductsV2OK struct {
+	Payload *models.ProductsEnvelope
+}
+
+func (o *GetProductsV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetProductsV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProductsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProductsV2BadRequest creates a GetProductsV2BadRequest with default headers values
+func NewGetProductsV2BadRequest() *GetProductsV2BadRequest {
+	return &GetProductsV2BadRequest{}
+}
+
+/*GetProductsV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetProductsV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetProductsV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetProductsV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProductsV2NotFound creates a GetProductsV2NotFound with default headers values
+func NewGetProductsV2NotFound() *GetProductsV2NotFound {
+	return &GetProductsV2NotFound{}
+}
+
+/*GetProductsV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetProductsV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetProductsV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetProductsV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProductsV2ServiceUnavailable creates a GetProductsV2ServiceUnavailable with default headers values
+func NewGetProductsV2ServiceUnavailable() *GetProductsV2ServiceUnavailable {
+	return &GetProductsV2ServiceUnavailable{}
+}
+
+/*GetProductsV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetProductsV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetProductsV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/products][%d] getProductsV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetProductsV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package provider
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetProviderV2Params creates a new GetProviderV2Params object
+// with the default values initialized.
+func NewGetProviderV2Params() *GetProviderV2Params {
+	var ()
+	return &GetProviderV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProviderV2ParamsWithTimeout creates a new GetProviderV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetProviderV2ParamsWithTimeout(timeout time.Duration) *GetProviderV2Params {
+	var ()
+	return &GetProviderV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetProviderV2ParamsWithContext creates a new GetProviderV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetProviderV2ParamsWithContext(ctx context.Context) *GetProviderV2Params {
+	var ()
+	return &GetProviderV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetProviderV2ParamsWithHTTPClient creates a new GetProviderV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetProviderV2ParamsWithHTTPClient(client *http.Client) *GetProviderV2Params {
+	var ()
+	return &GetProviderV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetProviderV2Params contains all the parameters to send to the API endpoint
+for the get provider v2 operation typically these are written to a http.Request
+*/
+type GetProviderV2Params struct {
+
+	/*Provider*/
+	Provider string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get provider v2 params
+func (o *GetProviderV2Params) WithTimeout(timeout time.Duration) *GetProviderV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get provider v2 params
+func (o *GetProviderV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get provider v2 params
+func (o *GetProviderV2Params) WithContext(ctx context.Context) *GetProviderV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get provider v2 params
+func (o *GetProviderV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get provider v2 params
+func (o *GetProviderV2Params) WithHTTPClient(client *http.Client) *GetProviderV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get provider v2 params
+func (o *GetProviderV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get provider v2 params
+func (o *GetProviderV2Params) WithProvider(provider string) *GetProviderV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get provider v2 params
+func (o *GetProviderV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProviderV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package provider
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetProviderV2Reader is a Reader for the GetProviderV2 structure.
+type GetProviderV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProviderV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetProviderV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetProviderV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetProviderV2OK creates a GetProviderV2OK with default headers values
+func NewGetProviderV2OK() *GetProviderV2OK {
+	return &GetProviderV2OK{}
+}
+
+/*GetProviderV2OK handles this case with default header values.
+
+ProviderEnvelope
+*/
+type GetProviderV2OK struct {
+	Payload *models.ProviderEnvelope
+}
+
+func (o *GetProviderV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}][%d] getProviderV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetProviderV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProviderEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProviderV2NotFound creates a GetProviderV2NotFound with default headers values
+func NewGetProviderV2NotFound() *GetProviderV2NotFound {
+	return &GetProviderV2NotFound{}
+}
+
+/*GetProviderV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetProviderV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetProviderV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}][%d] getProviderV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetProviderV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
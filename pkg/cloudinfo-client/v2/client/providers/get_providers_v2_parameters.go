@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package providers
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetProvidersV2Params creates a new GetProvidersV2Params object
+// with the default values initialized.
+func NewGetProvidersV2Params() *GetProvidersV2Params {
+
+	return &GetProvidersV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProvidersV2ParamsWithTimeout creates a new GetProvidersV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetProvidersV2ParamsWithTimeout(timeout time.Duration) *GetProvidersV2Params {
+
+	return &GetProvidersV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetProvidersV2ParamsWithContext creates a new GetProvidersV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetProvidersV2ParamsWithContext(ctx context.Context) *GetProvidersV2Params {
+
+	return &GetProvidersV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetProvidersV2ParamsWithHTTPClient creates a new GetProvidersV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetProvidersV2ParamsWithHTTPClient(client *http.Client) *GetProvidersV2Params {
+
+	return &GetProvidersV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetProvidersV2Params contains all the parameters to send to the API endpoint
+for the get providers v2 operation typically these are written to a http.Request
+*/
+type GetProvidersV2Params struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get providers v2 params
+func (o *GetProvidersV2Params) WithTimeout(timeout time.Duration) *GetProvidersV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get providers v2 params
+func (o *GetProvidersV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get providers v2 params
+func (o *GetProvidersV2Params) WithContext(ctx context.Context) *GetProvidersV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get providers v2 params
+func (o *GetProvidersV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get providers v2 params
+func (o *GetProvidersV2Params) WithHTTPClient(client *http.Client) *GetProvidersV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get providers v2 params
+func (o *GetProvidersV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProvidersV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package providers
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetProvidersV2Reader is a Reader for the GetProvidersV2 structure.
+type GetProvidersV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProvidersV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetProvidersV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 500:
+		result := NewGetProvidersV2InternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetProvidersV2OK creates a GetProvidersV2OK with default headers values
+func NewGetProvidersV2OK() *GetProvidersV2OK {
+	return &GetProvidersV2OK{}
+}
+
+/*GetProvidersV2OK handles this case with default header values.
+
+ProvidersEnvelope
+*/
+type GetProvidersV2OK struct {
+	Payload *models.ProvidersEnvelope
+}
+
+func (o *GetProvidersV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers][%d] getProvidersV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetProvidersV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProvidersEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProvidersV2InternalServerError creates a GetProvidersV2InternalServerError with default headers values
+func NewGetProvidersV2InternalServerError() *GetProvidersV2InternalServerError {
+	return &GetProvidersV2InternalServerError{}
+}
+
+/*GetProvidersV2InternalServerError handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetProvidersV2InternalServerError struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetProvidersV2InternalServerError) Error() string {
+	return fmt.Sprintf("[GET /providers][%d] getProvidersV2InternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GetProvidersV2InternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
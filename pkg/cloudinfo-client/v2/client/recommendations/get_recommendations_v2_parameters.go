@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package recommendations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// NewGetRecommendationsV2Params creates a new GetRecommendationsV2Params object
+// with the default values initialized.
+func NewGetRecommendationsV2Params() *GetRecommendationsV2Params {
+	var ()
+	return &GetRecommendationsV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRecommendationsV2ParamsWithTimeout creates a new GetRecommendationsV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRecommendationsV2ParamsWithTimeout(timeout time.Duration) *GetRecommendationsV2Params {
+	var ()
+	return &GetRecommendationsV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRecommendationsV2ParamsWithContext creates a new GetRecommendationsV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRecommendationsV2ParamsWithContext(ctx context.Context) *GetRecommendationsV2Params {
+	var ()
+	return &GetRecommendationsV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRecommendationsV2ParamsWithHTTPClient creates a new GetRecommendationsV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRecommendationsV2ParamsWithHTTPClient(client *http.Client) *GetRecommendationsV2Params {
+	var ()
+	return &GetRecommendationsV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetRecommendationsV2Params contains all the parameters to send to the API endpoint
+for the get recommendations v2 operation typically these are written to a http.Request
+*/
+type GetRecommendationsV2Params struct {
+
+	/*Body*/
+	Body *models.RecommendationRequest
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithTimeout(timeout time.Duration) *GetRecommendationsV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithContext(ctx context.Context) *GetRecommendationsV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithHTTPClient(client *http.Client) *GetRecommendationsV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithBody(body *models.RecommendationRequest) *GetRecommendationsV2Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetBody(body *models.RecommendationRequest) {
+	o.Body = body
+}
+
+// WithCurrency adds the currency to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithCurrency(currency *string) *GetRecommendationsV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithProvider(provider string) *GetRecommendationsV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithRegion(region string) *GetRecommendationsV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) WithService(service string) *GetRecommendationsV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get recommendations v2 params
+func (o *GetRecommendationsV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRecommendationsV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package recommendations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetRecommendationsV2Reader is a Reader for the GetRecommendationsV2 structure.
+type GetRecommendationsV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRecommendationsV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRecommendationsV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetRecommendationsV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetRecommendationsV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetRecommendationsV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetRecommendationsV2OK creates a GetRecommendationsV2OK with default headers values
+func NewGetRecommendationsV2OK() *GetRecommendationsV2OK {
+	return &GetRecommendationsV2OK{}
+}
+
+/*GetRecommendationsV2OK handles this case with default header values.
+
+RecommendationsEnvelope
+*/
+type GetRecommendationsV2OK struct {
+	Payload *models.RecommendationsEnvelope
+}
+
+func (o *GetRecommendationsV2OK) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetRecommendationsV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RecommendationsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRecommendationsV2BadRequest creates a GetRecommendationsV2BadRequest with default headers values
+func NewGetRecommendationsV2BadRequest() *GetRecommendationsV2BadRequest {
+	return &GetRecommendationsV2BadRequest{}
+}
+
+/*GetRecommendationsV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRecommendationsV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRecommendationsV2BadRequest) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetRecommendationsV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRecommendationsV2NotFound creates a GetRecommendationsV2NotFound with default headers values
+func NewGetRecommendationsV2NotFound() *GetRecommendationsV2NotFound {
+	return &GetRecommendationsV2NotFound{}
+}
+
+/*GetRecommendationsV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRecommendationsV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRecommendationsV2NotFound) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetRecommendationsV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRecommendationsV2ServiceUnavailable creates a GetRecommendationsV2ServiceUnavailable with default headers values
+func NewGetRecommendationsV2ServiceUnavailable() *GetRecommendationsV2ServiceUnavailable {
+	return &GetRecommendationsV2ServiceUnavailable{}
+}
+
+/*GetRecommendationsV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRecommendationsV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRecommendationsV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[POST /providers/{provider}/services/{service}/regions/{region}/recommendations][%d] getRecommendationsV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetRecommendationsV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,438 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetCheapestRegionsV2Params creates a new GetCheapestRegionsV2Params object
+// with the default values initialized.
+func NewGetCheapestRegionsV2Params() *GetCheapestRegionsV2Params {
+	var ()
+	return &GetCheapestRegionsV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetCheapestRegionsV2ParamsWithTimeout creates a new GetCheapestRegionsV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetCheapestRegionsV2ParamsWithTimeout(timeout time.Duration) *GetCheapestRegionsV2Params {
+	var ()
+	return &GetCheapestRegionsV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetCheapestRegionsV2ParamsWithContext creates a new GetCheapestRegionsV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetCheapestRegionsV2ParamsWithContext(ctx context.Context) *GetCheapestRegionsV2Params {
+	var ()
+	return &GetCheapestRegionsV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetCheapestRegionsV2ParamsWithHTTPClient creates a new GetCheapestRegionsV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetCheapestRegionsV2ParamsWithHTTPClient(client *http.Client) *GetCheapestRegionsV2Params {
+	var ()
+	return &GetCheapestRegionsV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetCheapestRegionsV2Params contains all the parameters to send to the API endpoint
+for the get cheapest regions v2 operation typically these are written to a http.Request
+*/
+type GetCheapestRegionsV2Params struct {
+
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are compared in (USD by default)
+
+	*/
+	Currency *string
+	/*Geography
+	  Geography is the comma separated list of the geographies of the regions: europe, north-america, south-america,
+	asia-pacific, middle-east or africa
+
+	*/
+	Geography *string
+	/*InstanceType
+	  InstanceType is the instance type priced in the regions, the minimum resources are used if empty
+
+	*/
+	InstanceType *string
+	/*Limit
+	  Limit is the maximum number of the regions returned (every region by default)
+
+	*/
+	Limit *int64
+	/*MaxAge
+	  MaxAge is the maximum age of the prices of a region in go syntax (eg.: 30m, 2h)
+
+	*/
+	MaxAge *string
+	/*MinCPU
+	  MinCPU is the minimum number of vCPUs of the instance types priced in the regions (eg.: 4, 500m)
+
+	*/
+	MinCPU *string
+	/*MinGpu
+	  MinGpu is the minimum number of gpus of the instance types priced in the regions
+
+	*/
+	MinGpu *string
+	/*MinMem
+	  MinMem is the minimum memory of the instance types priced in the regions in GiB or as a Kubernetes style quantity
+
+	*/
+	MinMem *string
+	/*PriceType
+	  PriceType is the price the regions are ranked by: on-demand (default) or spot
+
+	*/
+	PriceType *string
+	/*Providers
+	  Providers is the comma separated list of the providers whose regions are ranked (every provider by default)
+
+	*/
+	Providers *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithTimeout(timeout time.Duration) *GetCheapestRegionsV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithContext(ctx context.Context) *GetCheapestRegionsV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithHTTPClient(client *http.Client) *GetCheapestRegionsV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithCurrency(currency *string) *GetCheapestRegionsV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithGeography adds the geography to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithGeography(geography *string) *GetCheapestRegionsV2Params {
+	o.SetGeography(geography)
+	return o
+}
+
+// SetGeography adds the geography to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetGeography(geography *string) {
+	o.Geography = geography
+}
+
+// WithInstanceType adds the instanceType to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithInstanceType(instanceType *string) *GetCheapestRegionsV2Params {
+	o.SetInstanceType(instanceType)
+	return o
+}
+
+// SetInstanceType adds the instanceType to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetInstanceType(instanceType *string) {
+	o.InstanceType = instanceType
+}
+
+// WithLimit adds the limit to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithLimit(limit *int64) *GetCheapestRegionsV2Params {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithMaxAge adds the maxAge to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithMaxAge(maxAge *string) *GetCheapestRegionsV2Params {
+	o.SetMaxAge(maxAge)
+	return o
+}
+
+// SetMaxAge adds the maxAge to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetMaxAge(maxAge *string) {
+	o.MaxAge = maxAge
+}
+
+// WithMinCPU adds the minCPU to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithMinCPU(minCPU *string) *GetCheapestRegionsV2Params {
+	o.SetMinCPU(minCPU)
+	return o
+}
+
+// SetMinCPU adds the minCpu to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetMinCPU(minCPU *string) {
+	o.MinCPU = minCPU
+}
+
+// WithMinGpu adds the minGpu to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithMinGpu(minGpu *string) *GetCheapestRegionsV2Params {
+	o.SetMinGpu(minGpu)
+	return o
+}
+
+// SetMinGpu adds the minGpu to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetMinGpu(minGpu *string) {
+	o.MinGpu = minGpu
+}
+
+// WithMinMem adds the minMem to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithMinMem(minMem *string) *GetCheapestRegionsV2Params {
+	o.SetMinMem(minMem)
+	return o
+}
+
+// SetMinMem adds the minMem to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetMinMem(minMem *string) {
+	o.MinMem = minMem
+}
+
+// WithPriceType adds the priceType to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithPriceType(priceType *string) *GetCheapestRegionsV2Params {
+	o.SetPriceType(priceType)
+	return o
+}
+
+// SetPriceType adds the priceType to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetPriceType(priceType *string) {
+	o.PriceType = priceType
+}
+
+// WithProviders adds the providers to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) WithProviders(providers *string) *GetCheapestRegionsV2Params {
+	o.SetProviders(providers)
+	return o
+}
+
+// SetProviders adds the providers to the get cheapest regions v2 params
+func (o *GetCheapestRegionsV2Params) SetProviders(providers *string) {
+	o.Providers = providers
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetCheapestRegionsV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Geography != nil {
+
+		// query param geography
+		var qrGeography string
+		if o.Geography != nil {
+			qrGeography = *o.Geography
+		}
+		qGeography := qrGeography
+		if qGeography != "" {
+			if err := r.SetQueryParam("geography", qGeography); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.InstanceType != nil {
+
+		// query param instanceType
+		var qrInstanceType string
+		if o.InstanceType != nil {
+			qrInstanceType = *o.InstanceType
+		}
+		qInstanceType := qrInstanceType
+		if qInstanceType != "" {
+			if err := r.SetQueryParam("instanceType", qInstanceType); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MaxAge != nil {
+
+		// query param maxAge
+		var qrMaxAge string
+		if o.MaxAge != nil {
+			qrMaxAge = *o.MaxAge
+		}
+		qMaxAge := qrMaxAge
+		if qMaxAge != "" {
+			if err := r.SetQueryParam("maxAge", qMaxAge); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinCPU != nil {
+
+		// query param minCpu
+		var qrMinCPU string
+		if o.MinCPU != nil {
+			qrMinCPU = *o.MinCPU
+		}
+		qMinCPU := qrMinCPU
+		if qMinCPU != "" {
+			if err := r.SetQueryParam("minCpu", qMinCPU); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinGpu != nil {
+
+		// query param minGpu
+		var qrMinGpu string
+		if o.MinGpu != nil {
+			qrMinGpu = *o.MinGpu
+		}
+		qMinGpu := qrMinGpu
+		if qMinGpu != "" {
+			if err := r.SetQueryParam("minGpu", qMinGpu); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.MinMem != nil {
+
+		// query param minMem
+		var qrMinMem string
+		if o.MinMem != nil {
+			qrMinMem = *o.MinMem
+		}
+		qMinMem := qrMinMem
+		if qMinMem != "" {
+			if err := r.SetQueryParam("minMem", qMinMem); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PriceType != nil {
+
+		// query param priceType
+		var qrPriceType string
+		if o.PriceType != nil {
+			qrPriceType = *o.PriceType
+		}
+		qPriceType := qrPriceType
+		if qPriceType != "" {
+			if err := r.SetQueryParam("priceType", qPriceType); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Providers != nil {
+
+		// query param providers
+		var qrProviders string
+		if o.Providers != nil {
+			qrProviders = *o.Providers
+		}
+		qProviders := qrProviders
+		if qProviders != "" {
+			if err := r.SetQueryParam("providers", qProviders); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetCheapestRegionsV2Reader is a Reader for the GetCheapestRegionsV2 structure.
+type GetCheapestRegionsV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetCheapestRegionsV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetCheapestRegionsV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetCheapestRegionsV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetCheapestRegionsV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetCheapestRegionsV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetCheapestRegionsV2OK creates a GetCheapestRegionsV2OK with default headers values
+func NewGetCheapestRegionsV2OK() *GetCheapestRegionsV2OK {
+	return &GetCheapestRegionsV2OK{}
+}
+
+/*GetCheapestRegionsV2OK handles this case with default header values.
+
+CheapestRegionsEnvelope
+*/
+type GetCheapestRegionsV2OK struct {
+	Payload *models.CheapestRegionsEnvelope
+}
+
+func (o *GetCheapestRegionsV2OK) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetCheapestRegionsV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CheapestRegionsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsV2BadRequest creates a GetCheapestRegionsV2BadRequest with default headers values
+func NewGetCheapestRegionsV2BadRequest() *GetCheapestRegionsV2BadRequest {
+	return &GetCheapestRegionsV2BadRequest{}
+}
+
+/*GetCheapestRegionsV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetCheapestRegionsV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetCheapestRegionsV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetCheapestRegionsV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsV2NotFound creates a GetCheapestRegionsV2NotFound with default headers values
+func NewGetCheapestRegionsV2NotFound() *GetCheapestRegionsV2NotFound {
+	return &GetCheapestRegionsV2NotFound{}
+}
+
+/*GetCheapestRegionsV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetCheapestRegionsV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetCheapestRegionsV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetCheapestRegionsV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCheapestRegionsV2ServiceUnavailable creates a GetCheapestRegionsV2ServiceUnavailable with default headers values
+func NewGetCheapestRegionsV2ServiceUnavailable() *GetCheapestRegionsV2ServiceUnavailable {
+	return &GetCheapestRegionsV2ServiceUnavailable{}
+}
+
+/*GetCheapestRegionsV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetCheapestRegionsV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetCheapestRegionsV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /cheapest-regions][%d] getCheapestRegionsV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetCheapestRegionsV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
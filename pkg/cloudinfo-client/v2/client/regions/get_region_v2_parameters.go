@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetRegionV2Params creates a new GetRegionV2Params object
+// with the default values initialized.
+func NewGetRegionV2Params() *GetRegionV2Params {
+	var ()
+	return &GetRegionV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRegionV2ParamsWithTimeout creates a new GetRegionV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRegionV2ParamsWithTimeout(timeout time.Duration) *GetRegionV2Params {
+	var ()
+	return &GetRegionV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRegionV2ParamsWithContext creates a new GetRegionV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRegionV2ParamsWithContext(ctx context.Context) *GetRegionV2Params {
+	var ()
+	return &GetRegionV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRegionV2ParamsWithHTTPClient creates a new GetRegionV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRegionV2ParamsWithHTTPClient(client *http.Client) *GetRegionV2Params {
+	var ()
+	return &GetRegionV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetRegionV2Params contains all the parameters to send to the API endpoint
+for the get region v2 operation typically these are written to a http.Request
+*/
+type GetRegionV2Params struct {
+
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get region v2 params
+func (o *GetRegionV2Params) WithTimeout(timeout time.Duration) *GetRegionV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get region v2 params
+func (o *GetRegionV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get region v2 params
+func (o *GetRegionV2Params) WithContext(ctx context.Context) *GetRegionV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get region v2 params
+func (o *GetRegionV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get region v2 params
+func (o *GetRegionV2Params) WithHTTPClient(client *http.Client) *GetRegionV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get region v2 params
+func (o *GetRegionV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get region v2 params
+func (o *GetRegionV2Params) WithProvider(provider string) *GetRegionV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get region v2 params
+func (o *GetRegionV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get region v2 params
+func (o *GetRegionV2Params) WithRegion(region string) *GetRegionV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get region v2 params
+func (o *GetRegionV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get region v2 params
+func (o *GetRegionV2Params) WithService(service string) *GetRegionV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get region v2 params
+func (o *GetRegionV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRegionV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetRegionV2Reader is a Reader for the GetRegionV2 structure.
+type GetRegionV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRegionV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRegionV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetRegionV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetRegionV2BadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetRegionV2OK creates a GetRegionV2OK with default headers values
+func NewGetRegionV2OK() *GetRegionV2OK {
+	return &GetRegionV2OK{}
+}
+
+/*GetRegionV2OK handles this case with default header values.
+
+RegionEnvelope
+*/
+type GetRegionV2OK struct {
+	Payload *models.RegionEnvelope
+}
+
+func (o *GetRegionV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}][%d] getRegionV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetRegionV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RegionEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionV2NotFound creates a GetRegionV2NotFound with default headers values
+func NewGetRegionV2NotFound() *GetRegionV2NotFound {
+	return &GetRegionV2NotFound{}
+}
+
+/*GetRegionV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRegionV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRegionV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}][%d] getRegionV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetRegionV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionV2BadGateway creates a GetRegionV2BadGateway with default headers values
+func NewGetRegionV2BadGateway() *GetRegionV2BadGateway {
+	return &GetRegionV2BadGateway{}
+}
+
+/*GetRegionV2BadGateway handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRegionV2BadGateway struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRegionV2BadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}][%d] getRegionV2BadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetRegionV2BadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
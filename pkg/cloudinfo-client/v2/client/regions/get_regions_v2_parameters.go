@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetRegionsV2Params creates a new GetRegionsV2Params object
+// with the default values initialized.
+func NewGetRegionsV2Params() *GetRegionsV2Params {
+	var ()
+	return &GetRegionsV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetRegionsV2ParamsWithTimeout creates a new GetRegionsV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetRegionsV2ParamsWithTimeout(timeout time.Duration) *GetRegionsV2Params {
+	var ()
+	return &GetRegionsV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetRegionsV2ParamsWithContext creates a new GetRegionsV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetRegionsV2ParamsWithContext(ctx context.Context) *GetRegionsV2Params {
+	var ()
+	return &GetRegionsV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetRegionsV2ParamsWithHTTPClient creates a new GetRegionsV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetRegionsV2ParamsWithHTTPClient(client *http.Client) *GetRegionsV2Params {
+	var ()
+	return &GetRegionsV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetRegionsV2Params contains all the parameters to send to the API endpoint
+for the get regions v2 operation typically these are written to a http.Request
+*/
+type GetRegionsV2Params struct {
+
+	/*Provider*/
+	Provider string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get regions v2 params
+func (o *GetRegionsV2Params) WithTimeout(timeout time.Duration) *GetRegionsV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get regions v2 params
+func (o *GetRegionsV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get regions v2 params
+func (o *GetRegionsV2Params) WithContext(ctx context.Context) *GetRegionsV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get regions v2 params
+func (o *GetRegionsV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get regions v2 params
+func (o *GetRegionsV2Params) WithHTTPClient(client *http.Client) *GetRegionsV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get regions v2 params
+func (o *GetRegionsV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get regions v2 params
+func (o *GetRegionsV2Params) WithProvider(provider string) *GetRegionsV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get regions v2 params
+func (o *GetRegionsV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithService adds the service to the get regions v2 params
+func (o *GetRegionsV2Params) WithService(service string) *GetRegionsV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get regions v2 params
+func (o *GetRegionsV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetRegionsV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
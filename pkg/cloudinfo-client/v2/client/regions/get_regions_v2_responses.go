@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetRegionsV2Reader is a Reader for the GetRegionsV2 structure.
+type GetRegionsV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetRegionsV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetRegionsV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetRegionsV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetRegionsV2BadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetRegionsV2OK creates a GetRegionsV2OK with default headers values
+func NewGetRegionsV2OK() *GetRegionsV2OK {
+	return &GetRegionsV2OK{}
+}
+
+/*GetRegionsV2OK handles this case with default header values.
+
+RegionsEnvelope
+*/
+type GetRegionsV2OK struct {
+	Payload *models.RegionsEnvelope
+}
+
+func (o *GetRegionsV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions][%d] getRegionsV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetRegionsV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RegionsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionsV2NotFound creates a GetRegionsV2NotFound with default headers values
+func NewGetRegionsV2NotFound() *GetRegionsV2NotFound {
+	return &GetRegionsV2NotFound{}
+}
+
+/*GetRegionsV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRegionsV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRegionsV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions][%d] getRegionsV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetRegionsV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetRegionsV2BadGateway creates a GetRegionsV2BadGateway with default headers values
+func NewGetRegionsV2BadGateway() *GetRegionsV2BadGateway {
+	return &GetRegionsV2BadGateway{}
+}
+
+/*GetRegionsV2BadGateway handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetRegionsV2BadGateway struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetRegionsV2BadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions][%d] getRegionsV2BadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetRegionsV2BadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package regions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new regions API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for regions API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+GetCheapestRegionsV2 Ranks the regions of the providers by the lowest on demand or spot price of an instance type, or of the instance
+types providing the minimum resources.
+*/
+func (a *Client) GetCheapestRegionsV2(params *GetCheapestRegionsV2Params) (*GetCheapestRegionsV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetCheapestRegionsV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getCheapestRegionsV2",
+		Method:             "GET",
+		PathPattern:        "/cheapest-regions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetCheapestRegionsV2Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetCheapestRegionsV2OK), nil
+
+}
+
+/*
+GetRegionV2 Provides the detailed info of a specific region of a cloud provider
+*/
+func (a *Client) GetRegionV2(params *GetRegionV2Params) (*GetRegionV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRegionV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getRegionV2",
+		Method:             "GET",
+		PathPattern:        "/providers/{provider}/services/{service}/regions/{region}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetRegionV2Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetRegionV2OK), nil
+
+}
+
+/*
+GetRegionsV2 Provides the list of available regions of a cloud provider, ordered by their id
+*/
+func (a *Client) GetRegionsV2(params *GetRegionsV2Params) (*GetRegionsV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetRegionsV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getRegionsV2",
+		Method:             "GET",
+		PathPattern:        "/providers/{provider}/services/{service}/regions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{""},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetRegionsV2Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetRegionsV2OK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
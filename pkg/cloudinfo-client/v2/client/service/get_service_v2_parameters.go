@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetServiceV2Params creates a new GetServiceV2Params object
+// with the default values initialized.
+func NewGetServiceV2Params() *GetServiceV2Params {
+	var ()
+	return &GetServiceV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetServiceV2ParamsWithTimeout creates a new GetServiceV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetServiceV2ParamsWithTimeout(timeout time.Duration) *GetServiceV2Params {
+	var ()
+	return &GetServiceV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetServiceV2ParamsWithContext creates a new GetServiceV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetServiceV2ParamsWithContext(ctx context.Context) *GetServiceV2Params {
+	var ()
+	return &GetServiceV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetServiceV2ParamsWithHTTPClient creates a new GetServiceV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetServiceV2ParamsWithHTTPClient(client *http.Client) *GetServiceV2Params {
+	var ()
+	return &GetServiceV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetServiceV2Params contains all the parameters to send to the API endpoint
+for the get service v2 operation typically these are written to a http.Request
+*/
+type GetServiceV2Params struct {
+
+	/*Provider*/
+	Provider string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get service v2 params
+func (o *GetServiceV2Params) WithTimeout(timeout time.Duration) *GetServiceV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get service v2 params
+func (o *GetServiceV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get service v2 params
+func (o *GetServiceV2Params) WithContext(ctx context.Context) *GetServiceV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get service v2 params
+func (o *GetServiceV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get service v2 params
+func (o *GetServiceV2Params) WithHTTPClient(client *http.Client) *GetServiceV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get service v2 params
+func (o *GetServiceV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get service v2 params
+func (o *GetServiceV2Params) WithProvider(provider string) *GetServiceV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get service v2 params
+func (o *GetServiceV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithService adds the service to the get service v2 params
+func (o *GetServiceV2Params) WithService(service string) *GetServiceV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get service v2 params
+func (o *GetServiceV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetServiceV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
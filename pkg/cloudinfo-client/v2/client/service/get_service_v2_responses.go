@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package service
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetServiceV2Reader is a Reader for the GetServiceV2 structure.
+type GetServiceV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetServiceV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetServiceV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetServiceV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetServiceV2OK creates a GetServiceV2OK with default headers values
+func NewGetServiceV2OK() *GetServiceV2OK {
+	return &GetServiceV2OK{}
+}
+
+/*GetServiceV2OK handles this case with default header values.
+
+ServiceEnvelope
+*/
+type GetServiceV2OK struct {
+	Payload *models.ServiceEnvelope
+}
+
+func (o *GetServiceV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}][%d] getServiceV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetServiceV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServiceEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServiceV2NotFound creates a GetServiceV2NotFound with default headers values
+func NewGetServiceV2NotFound() *GetServiceV2NotFound {
+	return &GetServiceV2NotFound{}
+}
+
+/*GetServiceV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetServiceV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetServiceV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}][%d] getServiceV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetServiceV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
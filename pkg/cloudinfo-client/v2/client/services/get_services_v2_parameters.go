@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package services
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetServicesV2Params creates a new GetServicesV2Params object
+// with the default values initialized.
+func NewGetServicesV2Params() *GetServicesV2Params {
+	var ()
+	return &GetServicesV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetServicesV2ParamsWithTimeout creates a new GetServicesV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetServicesV2ParamsWithTimeout(timeout time.Duration) *GetServicesV2Params {
+	var ()
+	return &GetServicesV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetServicesV2ParamsWithContext creates a new GetServicesV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetServicesV2ParamsWithContext(ctx context.Context) *GetServicesV2Params {
+	var ()
+	return &GetServicesV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetServicesV2ParamsWithHTTPClient creates a new GetServicesV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetServicesV2ParamsWithHTTPClient(client *http.Client) *GetServicesV2Params {
+	var ()
+	return &GetServicesV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetServicesV2Params contains all the parameters to send to the API endpoint
+for the get services v2 operation typically these are written to a http.Request
+*/
+type GetServicesV2Params struct {
+
+	/*Provider*/
+	Provider string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get services v2 params
+func (o *GetServicesV2Params) WithTimeout(timeout time.Duration) *GetServicesV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get services v2 params
+func (o *GetServicesV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get services v2 params
+func (o *GetServicesV2Params) WithContext(ctx context.Context) *GetServicesV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get services v2 params
+func (o *GetServicesV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get services v2 params
+func (o *GetServicesV2Params) WithHTTPClient(client *http.Client) *GetServicesV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get services v2 params
+func (o *GetServicesV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProvider adds the provider to the get services v2 params
+func (o *GetServicesV2Params) WithProvider(provider string) *GetServicesV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get services v2 params
+func (o *GetServicesV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetServicesV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
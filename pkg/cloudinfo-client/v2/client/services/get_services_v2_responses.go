@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package services
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetServicesV2Reader is a Reader for the GetServicesV2 structure.
+type GetServicesV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetServicesV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetServicesV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetServicesV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 502:
+		result := NewGetServicesV2BadGateway()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetServicesV2OK creates a GetServicesV2OK with default headers values
+func NewGetServicesV2OK() *GetServicesV2OK {
+	return &GetServicesV2OK{}
+}
+
+/*GetServicesV2OK handles this case with default header values.
+
+ServicesEnvelope
+*/
+type GetServicesV2OK struct {
+	Payload *models.ServicesEnvelope
+}
+
+func (o *GetServicesV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetServicesV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServicesEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServicesV2NotFound creates a GetServicesV2NotFound with default headers values
+func NewGetServicesV2NotFound() *GetServicesV2NotFound {
+	return &GetServicesV2NotFound{}
+}
+
+/*GetServicesV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetServicesV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetServicesV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetServicesV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetServicesV2BadGateway creates a GetServicesV2BadGateway with default headers values
+func NewGetServicesV2BadGateway() *GetServicesV2BadGateway {
+	return &GetServicesV2BadGateway{}
+}
+
+/*GetServicesV2BadGateway handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetServicesV2BadGateway struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetServicesV2BadGateway) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services][%d] getServicesV2BadGateway  %+v", 502, o.Payload)
+}
+
+func (o *GetServicesV2BadGateway) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
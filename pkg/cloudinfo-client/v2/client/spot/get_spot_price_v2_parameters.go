@@ -0,0 +1,317 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spot
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetSpotPriceV2Params creates a new GetSpotPriceV2Params object
+// with the default values initialized.
+func NewGetSpotPriceV2Params() *GetSpotPriceV2Params {
+	var ()
+	return &GetSpotPriceV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetSpotPriceV2ParamsWithTimeout creates a new GetSpotPriceV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetSpotPriceV2ParamsWithTimeout(timeout time.Duration) *GetSpotPriceV2Params {
+	var ()
+	return &GetSpotPriceV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetSpotPriceV2ParamsWithContext creates a new GetSpotPriceV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetSpotPriceV2ParamsWithContext(ctx context.Context) *GetSpotPriceV2Params {
+	var ()
+	return &GetSpotPriceV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetSpotPriceV2ParamsWithHTTPClient creates a new GetSpotPriceV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetSpotPriceV2ParamsWithHTTPClient(client *http.Client) *GetSpotPriceV2Params {
+	var ()
+	return &GetSpotPriceV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetSpotPriceV2Params contains all the parameters to send to the API endpoint
+for the get spot price v2 operation typically these are written to a http.Request
+*/
+type GetSpotPriceV2Params struct {
+
+	/*Count
+	  Count is the number of the zones aggregated by the cheapest strategy
+
+	*/
+	Count *int64
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the price is converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*InstanceType*/
+	InstanceType string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+	/*Strategy
+	  Strategy is the aggregation strategy: mean (default), min, max, median or cheapest
+
+	*/
+	Strategy *string
+	/*Zones
+	  Zones is the comma separated list of the zones the spot prices are aggregated over (every zone with a spot price by default)
+
+	*/
+	Zones *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithTimeout(timeout time.Duration) *GetSpotPriceV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithContext(ctx context.Context) *GetSpotPriceV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithHTTPClient(client *http.Client) *GetSpotPriceV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCount adds the count to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithCount(count *int64) *GetSpotPriceV2Params {
+	o.SetCount(count)
+	return o
+}
+
+// SetCount adds the count to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetCount(count *int64) {
+	o.Count = count
+}
+
+// WithCurrency adds the currency to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithCurrency(currency *string) *GetSpotPriceV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithInstanceType adds the instanceType to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithInstanceType(instanceType string) *GetSpotPriceV2Params {
+	o.SetInstanceType(instanceType)
+	return o
+}
+
+// SetInstanceType adds the instanceType to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetInstanceType(instanceType string) {
+	o.InstanceType = instanceType
+}
+
+// WithProvider adds the provider to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithProvider(provider string) *GetSpotPriceV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithRegion(region string) *GetSpotPriceV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithService(service string) *GetSpotPriceV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WithStrategy adds the strategy to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithStrategy(strategy *string) *GetSpotPriceV2Params {
+	o.SetStrategy(strategy)
+	return o
+}
+
+// SetStrategy adds the strategy to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetStrategy(strategy *string) {
+	o.Strategy = strategy
+}
+
+// WithZones adds the zones to the get spot price v2 params
+func (o *GetSpotPriceV2Params) WithZones(zones *string) *GetSpotPriceV2Params {
+	o.SetZones(zones)
+	return o
+}
+
+// SetZones adds the zones to the get spot price v2 params
+func (o *GetSpotPriceV2Params) SetZones(zones *string) {
+	o.Zones = zones
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetSpotPriceV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Count != nil {
+
+		// query param count
+		var qrCount int64
+		if o.Count != nil {
+			qrCount = *o.Count
+		}
+		qCount := swag.FormatInt64(qrCount)
+		if qCount != "" {
+			if err := r.SetQueryParam("count", qCount); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param instanceType
+	if err := r.SetPathParam("instanceType", o.InstanceType); err != nil {
+		return err
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if o.Strategy != nil {
+
+		// query param strategy
+		var qrStrategy string
+		if o.Strategy != nil {
+			qrStrategy = *o.Strategy
+		}
+		qStrategy := qrStrategy
+		if qStrategy != "" {
+			if err := r.SetQueryParam("strategy", qStrategy); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Zones != nil {
+
+		// query param zones
+		var qrZones string
+		if o.Zones != nil {
+			qrZones = *o.Zones
+		}
+		qZones := qrZones
+		if qZones != "" {
+			if err := r.SetQueryParam("zones", qZones); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package spot
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetSpotPriceV2Reader is a Reader for the GetSpotPriceV2 structure.
+type GetSpotPriceV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSpotPriceV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetSpotPriceV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetSpotPriceV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetSpotPriceV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetSpotPriceV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetSpotPriceV2OK creates a GetSpotPriceV2OK with default headers values
+func NewGetSpotPriceV2OK() *GetSpotPriceV2OK {
+	return &GetSpotPriceV2OK{}
+}
+
+/*GetSpotPriceV2OK handles this case with default header values.
+
+SpotPriceEnvelope
+*/
+type GetSpotPriceV2OK struct {
+	Payload *models.SpotPriceEnvelope
+}
+
+func (o *GetSpotPriceV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetSpotPriceV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SpotPriceEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSpotPriceV2BadRequest creates a GetSpotPriceV2BadRequest with default headers values
+func NewGetSpotPriceV2BadRequest() *GetSpotPriceV2BadRequest {
+	return &GetSpotPriceV2BadRequest{}
+}
+
+/*GetSpotPriceV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetSpotPriceV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetSpotPriceV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetSpotPriceV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSpotPriceV2NotFound creates a GetSpotPriceV2NotFound with default headers values
+func NewGetSpotPriceV2NotFound() *GetSpotPriceV2NotFound {
+	return &GetSpotPriceV2NotFound{}
+}
+
+/*GetSpotPriceV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetSpotPriceV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetSpotPriceV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetSpotPriceV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetSpotPriceV2ServiceUnavailable creates a GetSpotPriceV2ServiceUnavailable with default headers values
+func NewGetSpotPriceV2ServiceUnavailable() *GetSpotPriceV2ServiceUnavailable {
+	return &GetSpotPriceV2ServiceUnavailable{}
+}
+
+/*GetSpotPriceV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetSpotPriceV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetSpotPriceV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/spot/{instanceType}][%d] getSpotPriceV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetSpotPriceV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
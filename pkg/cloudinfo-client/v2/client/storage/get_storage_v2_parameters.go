@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetStorageV2Params creates a new GetStorageV2Params object
+// with the default values initialized.
+func NewGetStorageV2Params() *GetStorageV2Params {
+	var ()
+	return &GetStorageV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetStorageV2ParamsWithTimeout creates a new GetStorageV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetStorageV2ParamsWithTimeout(timeout time.Duration) *GetStorageV2Params {
+	var ()
+	return &GetStorageV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetStorageV2ParamsWithContext creates a new GetStorageV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetStorageV2ParamsWithContext(ctx context.Context) *GetStorageV2Params {
+	var ()
+	return &GetStorageV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewGetStorageV2ParamsWithHTTPClient creates a new GetStorageV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetStorageV2ParamsWithHTTPClient(client *http.Client) *GetStorageV2Params {
+	var ()
+	return &GetStorageV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*GetStorageV2Params contains all the parameters to send to the API endpoint
+for the get storage v2 operation typically these are written to a http.Request
+*/
+type GetStorageV2Params struct {
+
+	/*Currency
+	  Currency is the ISO 4217 code of the currency the prices are converted to (the currency of the provider by default)
+
+	*/
+	Currency *string
+	/*Provider*/
+	Provider string
+	/*Region*/
+	Region string
+	/*Service*/
+	Service string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get storage v2 params
+func (o *GetStorageV2Params) WithTimeout(timeout time.Duration) *GetStorageV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get storage v2 params
+func (o *GetStorageV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get storage v2 params
+func (o *GetStorageV2Params) WithContext(ctx context.Context) *GetStorageV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get storage v2 params
+func (o *GetStorageV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get storage v2 params
+func (o *GetStorageV2Params) WithHTTPClient(client *http.Client) *GetStorageV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get storage v2 params
+func (o *GetStorageV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCurrency adds the currency to the get storage v2 params
+func (o *GetStorageV2Params) WithCurrency(currency *string) *GetStorageV2Params {
+	o.SetCurrency(currency)
+	return o
+}
+
+// SetCurrency adds the currency to the get storage v2 params
+func (o *GetStorageV2Params) SetCurrency(currency *string) {
+	o.Currency = currency
+}
+
+// WithProvider adds the provider to the get storage v2 params
+func (o *GetStorageV2Params) WithProvider(provider string) *GetStorageV2Params {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the get storage v2 params
+func (o *GetStorageV2Params) SetProvider(provider string) {
+	o.Provider = provider
+}
+
+// WithRegion adds the region to the get storage v2 params
+func (o *GetStorageV2Params) WithRegion(region string) *GetStorageV2Params {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the get storage v2 params
+func (o *GetStorageV2Params) SetRegion(region string) {
+	o.Region = region
+}
+
+// WithService adds the service to the get storage v2 params
+func (o *GetStorageV2Params) WithService(service string) *GetStorageV2Params {
+	o.SetService(service)
+	return o
+}
+
+// SetService adds the service to the get storage v2 params
+func (o *GetStorageV2Params) SetService(service string) {
+	o.Service = service
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetStorageV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Currency != nil {
+
+		// query param currency
+		var qrCurrency string
+		if o.Currency != nil {
+			qrCurrency = *o.Currency
+		}
+		qCurrency := qrCurrency
+		if qCurrency != "" {
+			if err := r.SetQueryParam("currency", qCurrency); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param provider
+	if err := r.SetPathParam("provider", o.Provider); err != nil {
+		return err
+	}
+
+	// path param region
+	if err := r.SetPathParam("region", o.Region); err != nil {
+		return err
+	}
+
+	// path param service
+	if err := r.SetPathParam("service", o.Service); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
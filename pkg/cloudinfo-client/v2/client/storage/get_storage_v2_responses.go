@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package storage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetStorageV2Reader is a Reader for the GetStorageV2 structure.
+type GetStorageV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetStorageV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetStorageV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 400:
+		result := NewGetStorageV2BadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 404:
+		result := NewGetStorageV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetStorageV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetStorageV2OK creates a GetStorageV2OK with default headers values
+func NewGetStorageV2OK() *GetStorageV2OK {
+	return &GetStorageV2OK{}
+}
+
+/*GetStorageV2OK handles this case with default header values.
+
+StorageEnvelope
+*/
+type GetStorageV2OK struct {
+	Payload *models.StorageEnvelope
+}
+
+func (o *GetStorageV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetStorageV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.StorageEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageV2BadRequest creates a GetStorageV2BadRequest with default headers values
+func NewGetStorageV2BadRequest() *GetStorageV2BadRequest {
+	return &GetStorageV2BadRequest{}
+}
+
+/*GetStorageV2BadRequest handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetStorageV2BadRequest struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetStorageV2BadRequest) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageV2BadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetStorageV2BadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageV2NotFound creates a GetStorageV2NotFound with default headers values
+func NewGetStorageV2NotFound() *GetStorageV2NotFound {
+	return &GetStorageV2NotFound{}
+}
+
+/*GetStorageV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetStorageV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetStorageV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetStorageV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetStorageV2ServiceUnavailable creates a GetStorageV2ServiceUnavailable with default headers values
+func NewGetStorageV2ServiceUnavailable() *GetStorageV2ServiceUnavailable {
+	return &GetStorageV2ServiceUnavailable{}
+}
+
+/*GetStorageV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetStorageV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetStorageV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/storage][%d] getStorageV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetStorageV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package versions
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/banzaicloud/cloudinfo/pkg/cloudinfo-client/v2/models"
+)
+
+// GetVersionsV2Reader is a Reader for the GetVersionsV2 structure.
+type GetVersionsV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetVersionsV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetVersionsV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	case 404:
+		result := NewGetVersionsV2NotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 503:
+		result := NewGetVersionsV2ServiceUnavailable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetVersionsV2OK creates a GetVersionsV2OK with default headers values
+func NewGetVersionsV2OK() *GetVersionsV2OK {
+	return &GetVersionsV2OK{}
+}
+
+/*GetVersionsV2OK handles this case with default header values.
+
+VersionsEnvelope
+*/
+type GetVersionsV2OK struct {
+	Payload *models.VersionsEnvelope
+}
+
+func (o *GetVersionsV2OK) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/versions][%d] getVersionsV2OK  %+v", 200, o.Payload)
+}
+
+func (o *GetVersionsV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VersionsEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetVersionsV2NotFound creates a GetVersionsV2NotFound with default headers values
+func NewGetVersionsV2NotFound() *GetVersionsV2NotFound {
+	return &GetVersionsV2NotFound{}
+}
+
+/*GetVersionsV2NotFound handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetVersionsV2NotFound struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetVersionsV2NotFound) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/versions][%d] getVersionsV2NotFound  %+v", 404, o.Payload)
+}
+
+func (o *GetVersionsV2NotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetVersionsV2ServiceUnavailable creates a GetVersionsV2ServiceUnavailable with default headers values
+func NewGetVersionsV2ServiceUnavailable() *GetVersionsV2ServiceUnavailable {
+	return &GetVersionsV2ServiceUnavailable{}
+}
+
+/*GetVersionsV2ServiceUnavailable handles this case with default header values.
+
+ErrorEnvelope
+*/
+type GetVersionsV2ServiceUnavailable struct {
+	Payload *models.ErrorEnvelope
+}
+
+func (o *GetVersionsV2ServiceUnavailable) Error() string {
+	return fmt.Sprintf("[GET /providers/{provider}/services/{service}/regions/{region}/versions][%d] getVersionsV2ServiceUnavailable  %+v", 503, o.Payload)
+}
+
+func (o *GetVersionsV2ServiceUnavailable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
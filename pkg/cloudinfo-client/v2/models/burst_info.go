@@ -0,0 +1,51 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// BurstInfo BurstInfo describes the cpu model of a burstable (shared core or cpu credit based) instance type
+// swagger:model BurstInfo
+type BurstInfo struct {
+
+	// BaselineCpu is the sustained cpu performance as a percentage of the vCPUs of the instance type, 0 if not published
+	BaselineCPU float64 `json:"baselineCpuPercent,omitempty"`
+
+	// CreditsPerHour is the number of cpu credits (one vCPU at full utilization for one minute) earned per hour, 0 if the
+	// instance type doesn't earn credits or the rate is not published
+	CreditsPerHour float64 `json:"creditsPerHour,omitempty"`
+
+	// Unlimited signals whether the instance type can run in unlimited mode, bursting above its earned credits for an
+	// additional charge; the rest of the burstable instance types are throttled to their baseline without credits
+	Unlimited bool `json:"unlimited,omitempty"`
+}
+
+// Validate validates this burst info
+func (m *BurstInfo) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BurstInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BurstInfo) UnmarshalBinary(b []byte) error {
+	var res BurstInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
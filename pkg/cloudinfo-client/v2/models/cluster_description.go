@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// ClusterDescription ClusterDescription describes the resources of a cluster its cost is estimated for
+// swagger:model ClusterDescription
+type ClusterDescription struct {
+
+	// ControlPlane signals whether the managed control plane fee of the service is charged for the cluster
+	ControlPlane bool `json:"controlPlane,omitempty"`
+
+	// Disks are the block storage volumes of the cluster
+	Disks []*DiskDescription `json:"disks"`
+
+	// EgressGbPerMonth is the monthly volume in GB transferred to the internet
+	EgressGbPerMonth float64 `json:"egressGbPerMonth,omitempty"`
+
+	// FreeTier signals that the cluster is among the free clusters of a free-tier control plane
+	FreeTier bool `json:"freeTier,omitempty"`
+
+	// InterZoneGbPerMonth is the monthly volume in GB transferred between the zones of the region
+	InterZoneGbPerMonth float64 `json:"interZoneGbPerMonth,omitempty"`
+
+	// LoadBalancers are the load balancers of the cluster
+	LoadBalancers []*LoadBalancerDescription `json:"loadBalancers"`
+
+	// NodePools are the node pools of the cluster
+	NodePools []*NodePoolDescription `json:"nodePools"`
+}
+
+// Validate validates this cluster description
+func (m *ClusterDescription) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLoadBalancers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodePools(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterDescription) validateDisks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Disks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Disks); i++ {
+		if swag.IsZero(m.Disks[i]) { // not required
+			continue
+		}
+
+		if m.Disks[i] != nil {
+			if err := m.Disks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("disks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterDescription) validateLoadBalancers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LoadBalancers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LoadBalancers); i++ {
+		if swag.IsZero(m.LoadBalancers[i]) { // not required
+			continue
+		}
+
+		if m.LoadBalancers[i] != nil {
+			if err := m.LoadBalancers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("loadBalancers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterDescription) validateNodePools(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NodePools) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NodePools); i++ {
+		if swag.IsZero(m.NodePools[i]) { // not required
+			continue
+		}
+
+		if m.NodePools[i] != nil {
+			if err := m.NodePools[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodePools" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterDescription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterDescription) UnmarshalBinary(b []byte) error {
+	var res ClusterDescription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
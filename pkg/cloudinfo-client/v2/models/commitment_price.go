@@ -0,0 +1,57 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// CommitmentPrice CommitmentPrice is the price of an instance type when capacity is committed for a term:
+//
+// reserved instances on amazon, committed use discounts on google, reservations on azure
+// swagger:model CommitmentPrice
+type CommitmentPrice struct {
+
+	// EffectiveHourly is the hourly price with the upfront price spread over the term
+	EffectiveHourly float64 `json:"effectiveHourly,omitempty"`
+
+	// Hourly is the recurring hourly price
+	Hourly float64 `json:"hourly,omitempty"`
+
+	// PaymentOption tells how the commitment is paid (no-upfront, partial-upfront or all-upfront)
+	PaymentOption string `json:"paymentOption,omitempty"`
+
+	// Term is the length of the commitment (1yr or 3yr)
+	Term string `json:"term,omitempty"`
+
+	// Upfront is the price paid upfront for the whole term
+	Upfront float64 `json:"upfront,omitempty"`
+}
+
+// Validate validates this commitment price
+func (m *CommitmentPrice) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CommitmentPrice) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CommitmentPrice) UnmarshalBinary(b []byte) error {
+	var res CommitmentPrice
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// CompareResponse CompareResponse holds the closest matches of a reference in every provider region
+// swagger:model CompareResponse
+type CompareResponse struct {
+
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency,omitempty"`
+
+	// matches
+	Matches []*InstanceMatch `json:"matches"`
+
+	// ReferencePrice is the hourly on demand price of the reference instance type, 0 for a reference shape
+	ReferencePrice float64 `json:"referencePrice,omitempty"`
+
+	// reference
+	Reference *Shape `json:"reference,omitempty"`
+}
+
+// Validate validates this compare response
+func (m *CompareResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatches(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReference(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CompareResponse) validateMatches(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Matches) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Matches); i++ {
+		if swag.IsZero(m.Matches[i]) { // not required
+			continue
+		}
+
+		if m.Matches[i] != nil {
+			if err := m.Matches[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("matches" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CompareResponse) validateReference(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Reference) { // not required
+		return nil
+	}
+
+	if m.Reference != nil {
+		if err := m.Reference.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("reference")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CompareResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CompareResponse) UnmarshalBinary(b []byte) error {
+	var res CompareResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
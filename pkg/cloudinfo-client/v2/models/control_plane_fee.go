@@ -0,0 +1,52 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// ControlPlaneFee ControlPlaneFee describes how the managed control plane of a kubernetes cluster is billed
+// swagger:model ControlPlaneFee
+type ControlPlaneFee struct {
+
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency,omitempty"`
+
+	// FreeClusters is the number of clusters per billing account whose control plane is not charged (free-tier only)
+	FreeClusters int64 `json:"freeClusters,omitempty"`
+
+	// Model is the billing model of the control plane: free, hourly or free-tier
+	Model string `json:"model,omitempty"`
+
+	// PricePerHour is the hourly price of the control plane of a cluster, 0 for free control planes
+	PricePerHour float64 `json:"pricePerHour,omitempty"`
+}
+
+// Validate validates this control plane fee
+func (m *ControlPlaneFee) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ControlPlaneFee) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ControlPlaneFee) UnmarshalBinary(b []byte) error {
+	var res ControlPlaneFee
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// CostEstimate CostEstimate is the estimated cost of a cluster
+// swagger:model CostEstimate
+type CostEstimate struct {
+
+	// Currency is the ISO 4217 code of the currency the costs are given in
+	Currency string `json:"currency,omitempty"`
+
+	// HourlyCost is the total hourly cost of the cluster
+	HourlyCost float64 `json:"hourlyCost,omitempty"`
+
+	// HourlySpotSavings is the hourly cost saved by the spot nodes compared to on demand nodes
+	HourlySpotSavings float64 `json:"hourlySpotSavings,omitempty"`
+
+	// HoursPerMonth is the number of hours the monthly costs are given for
+	HoursPerMonth float64 `json:"hoursPerMonth,omitempty"`
+
+	// LineItems breaks down the cost of the cluster
+	LineItems []*LineItem `json:"lineItems"`
+
+	// MonthlyCost is the total monthly cost of the cluster
+	MonthlyCost float64 `json:"monthlyCost,omitempty"`
+
+	// MonthlySpotSavings is the monthly cost saved by the spot nodes compared to on demand nodes
+	MonthlySpotSavings float64 `json:"monthlySpotSavings,omitempty"`
+
+	// NodePools holds the cost of the node pools
+	NodePools []*NodePoolCost `json:"nodePools"`
+}
+
+// Validate validates this cost estimate
+func (m *CostEstimate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLineItems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodePools(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CostEstimate) validateLineItems(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LineItems) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LineItems); i++ {
+		if swag.IsZero(m.LineItems[i]) { // not required
+			continue
+		}
+
+		if m.LineItems[i] != nil {
+			if err := m.LineItems[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lineItems" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CostEstimate) validateNodePools(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NodePools) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NodePools); i++ {
+		if swag.IsZero(m.NodePools[i]) { // not required
+			continue
+		}
+
+		if m.NodePools[i] != nil {
+			if err := m.NodePools[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodePools" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CostEstimate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CostEstimate) UnmarshalBinary(b []byte) error {
+	var res CostEstimate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,92 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Freshness Freshness holds the age of the data of a response
+// swagger:model Freshness
+type Freshness struct {
+
+	// AgeSeconds is the number of seconds elapsed since the last scrape of the provider
+	AgeSeconds int64 `json:"ageSeconds,omitempty"`
+
+	// PricesUpdatedAt is the retrieval time of the oldest prices of the data, missing if not priced
+	// Format: date-time
+	PricesUpdatedAt strfmt.DateTime `json:"pricesUpdatedAt,omitempty"`
+
+	// ScrapedAt is the completion time of the last scrape of the provider
+	// Format: date-time
+	ScrapedAt strfmt.DateTime `json:"scrapedAt,omitempty"`
+}
+
+// Validate validates this freshness
+func (m *Freshness) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePricesUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScrapedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Freshness) validatePricesUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PricesUpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("pricesUpdatedAt", "body", "date-time", m.PricesUpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Freshness) validateScrapedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ScrapedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scrapedAt", "body", "date-time", m.ScrapedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Freshness) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Freshness) UnmarshalBinary(b []byte) error {
+	var res Freshness
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
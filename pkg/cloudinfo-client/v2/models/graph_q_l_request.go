@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// GraphQLRequest GraphQLRequest is the body of a GraphQL request
+// swagger:model GraphQLRequest
+type GraphQLRequest struct {
+
+	// OperationName selects the operation to execute if the document holds more than one
+	OperationName string `json:"operationName,omitempty"`
+
+	// Query is the GraphQL query document
+	Query string `json:"query,omitempty"`
+
+	// Variables holds the values of the variables of the operation
+	Variables interface{} `json:"variables,omitempty"`
+}
+
+// Validate validates this graph q l request
+func (m *GraphQLRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GraphQLRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GraphQLRequest) UnmarshalBinary(b []byte) error {
+	var res GraphQLRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
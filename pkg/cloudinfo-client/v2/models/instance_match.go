@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// InstanceMatch InstanceMatch is an instance type of a provider region matching a reference
+// swagger:model InstanceMatch
+type InstanceMatch struct {
+
+	// Cpus is the number of vCPUs
+	Cpus float64 `json:"cpus,omitempty"`
+
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency,omitempty"`
+
+	// Gpus is the number of gpus
+	Gpus float64 `json:"gpus,omitempty"`
+
+	// Mem is the memory in GiB
+	Mem float64 `json:"mem,omitempty"`
+
+	// OnDemandPrice is the hourly on demand price of the instance type
+	OnDemandPrice float64 `json:"onDemandPrice,omitempty"`
+
+	// PriceDelta is the difference of the on demand price from the reference price
+	PriceDelta float64 `json:"priceDelta,omitempty"`
+
+	// PriceDeltaPercent is the difference of the on demand price from the reference price in percent of the reference
+	PriceDeltaPercent float64 `json:"priceDeltaPercent,omitempty"`
+
+	// provider
+	Provider string `json:"provider,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
+	// Similarity tells how close the instance type is to the reference between 0 and 1 (see Shape.Similarity)
+	Similarity float64 `json:"similarity,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this instance match
+func (m *InstanceMatch) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *InstanceMatch) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *InstanceMatch) UnmarshalBinary(b []byte) error {
+	var res InstanceMatch
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
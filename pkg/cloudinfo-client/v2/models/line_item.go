@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// LineItem LineItem is an item of the cost of a cluster
+// swagger:model LineItem
+type LineItem struct {
+
+	// Category is the category of the item: compute, storage, network or control-plane
+	Category string `json:"category,omitempty"`
+
+	// Description describes the item
+	Description string `json:"description,omitempty"`
+
+	// hourly cost
+	HourlyCost float64 `json:"hourlyCost,omitempty"`
+
+	// monthly cost
+	MonthlyCost float64 `json:"monthlyCost,omitempty"`
+
+	// Quantity is the billed quantity of the item in its unit
+	Quantity float64 `json:"quantity,omitempty"`
+
+	// Unit is the unit of the quantity (eg.: node, GiB, GB)
+	Unit string `json:"unit,omitempty"`
+}
+
+// Validate validates this line item
+func (m *LineItem) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LineItem) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LineItem) UnmarshalBinary(b []byte) error {
+	var res LineItem
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
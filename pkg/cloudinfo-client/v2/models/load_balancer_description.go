@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// LoadBalancerDescription LoadBalancerDescription describes load balancers of a cluster
+// swagger:model LoadBalancerDescription
+type LoadBalancerDescription struct {
+
+	// Count is the number of the load balancers (1 if 0)
+	Count int64 `json:"count,omitempty"`
+
+	// GbPerMonth is the monthly volume in GB processed by a load balancer
+	GbPerMonth float64 `json:"gbPerMonth,omitempty"`
+
+	// Type is the load balancer type (see LoadBalancerPrice)
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this load balancer description
+func (m *LoadBalancerDescription) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LoadBalancerDescription) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LoadBalancerDescription) UnmarshalBinary(b []byte) error {
+	var res LoadBalancerDescription
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
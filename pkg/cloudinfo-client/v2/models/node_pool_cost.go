@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// NodePoolCost NodePoolCost is the cost of a node pool
+// swagger:model NodePoolCost
+type NodePoolCost struct {
+
+	// hourly cost
+	HourlyCost float64 `json:"hourlyCost,omitempty"`
+
+	// HourlySpotSavings is the hourly cost saved by the spot nodes of the pool
+	HourlySpotSavings float64 `json:"hourlySpotSavings,omitempty"`
+
+	// monthly cost
+	MonthlyCost float64 `json:"monthlyCost,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// on demand nodes
+	OnDemandNodes int64 `json:"onDemandNodes,omitempty"`
+
+	// on demand price
+	OnDemandPrice float64 `json:"onDemandPrice,omitempty"`
+
+	// spot nodes
+	SpotNodes int64 `json:"spotNodes,omitempty"`
+
+	// spot price
+	SpotPrice float64 `json:"spotPrice,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this node pool cost
+func (m *NodePoolCost) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodePoolCost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodePoolCost) UnmarshalBinary(b []byte) error {
+	var res NodePoolCost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,19 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// OsPrices OsPrices holds the on demand prices per operating system / license, keyed by the Os constants
+// swagger:model OsPrices
+type OsPrices map[string]float64
+
+// Validate validates this os prices
+func (m OsPrices) Validate(formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,278 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ProductDetails ProductDetails extended view of the virtual machine details
+// swagger:model ProductDetails
+type ProductDetails struct {
+
+	// Arch is the cpu architecture of the instance type (x86_64 or arm64)
+	Arch string `json:"arch,omitempty"`
+
+	// attributes
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// Bandwidth is the network bandwidth of the instance type in Gbps, 0 if unknown
+	Bandwidth float64 `json:"bandwidth,omitempty"`
+
+	// BandwidthUpTo signals that the bandwidth is a burst ("up to") value, the sustained bandwidth is lower
+	BandwidthUpTo bool `json:"bandwidthUpTo,omitempty"`
+
+	// Burst signals whether the instance type is burstable, see BurstInfo for the details
+	Burst bool `json:"burst,omitempty"`
+
+	// Category is the normalized category of the instance type (general, compute, memory, storage, accelerated or burstable)
+	Category string `json:"category,omitempty"`
+
+	// Commitments holds the linux prices of the capacity commitments (reserved instances, committed use, reservations)
+	Commitments []*CommitmentPrice `json:"commitments"`
+
+	// CpuCores is the number of physical cores where the provider sells the instance type by cores (eg.: Oracle OCPUs), 0 otherwise
+	// Cpus is always the number of vCPUs
+	CPUCores float64 `json:"cpuCores,omitempty"`
+
+	// cpus
+	Cpus float64 `json:"cpusPerVm,omitempty"`
+
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency,omitempty"`
+
+	// CurrentGen signals whether the instance type generation is the current one. Only applies for amazon
+	CurrentGen bool `json:"currentGen,omitempty"`
+
+	// Family is the instance family as named by the provider (eg.: m5d, n1, Dv3, Standard2, g5)
+	Family string `json:"family,omitempty"`
+
+	// Generation is the generation of the instance family, 0 if the provider does not version the family
+	Generation int64 `json:"generation,omitempty"`
+
+	// GpuMem is the memory of a single gpu in GiB
+	GpuMem float64 `json:"gpuMemPerGpu,omitempty"`
+
+	// GpuModel is the model of the gpus of the instance type
+	GpuModel string `json:"gpuModel,omitempty"`
+
+	// gpus
+	Gpus float64 `json:"gpusPerVm,omitempty"`
+
+	// Hypervisor is the virtualization technology the instance type runs on
+	Hypervisor string `json:"hypervisor,omitempty"`
+
+	// LocalDiskSize is the size of a single local disk in GiB
+	LocalDiskSize float64 `json:"localDiskSize,omitempty"`
+
+	// LocalDiskType is the type of the local disks (ssd, nvme or hdd)
+	LocalDiskType string `json:"localDiskType,omitempty"`
+
+	// LocalDisks is the number of the local (instance store) disks
+	LocalDisks int64 `json:"localDisks,omitempty"`
+
+	// MaxNics is the maximum number of network interfaces of the instance type, 0 if unknown
+	MaxNics int64 `json:"maxNics,omitempty"`
+
+	// mem
+	Mem float64 `json:"memPerVm,omitempty"`
+
+	// ntw perf
+	NtwPerf string `json:"ntwPerf,omitempty"`
+
+	// ntw perf cat
+	NtwPerfCat string `json:"ntwPerfCategory,omitempty"`
+
+	// on demand price
+	OnDemandPrice float64 `json:"onDemandPrice,omitempty"`
+
+	// PremiumStorage signals whether the instance type supports optimized block storage (EBS optimized, premium storage)
+	PremiumStorage bool `json:"premiumStorage,omitempty"`
+
+	// ProcessorFamily is the processor of the instance type as reported by the provider (eg.: Intel Xeon Platinum 8175)
+	ProcessorFamily string `json:"processorFamily,omitempty"`
+
+	// Size is the size of the instance type within its family (eg.: large, 2)
+	Size string `json:"size,omitempty"`
+
+	// SpotStats holds the statistics of the spot prices per zone over the look-back window, empty if the provider has no spot history
+	SpotStats map[string]SpotStats `json:"spotStats,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+
+	// zones
+	Zones []string `json:"zones"`
+
+	// burst info
+	BurstInfo *BurstInfo `json:"burstInfo,omitempty"`
+
+	// os prices
+	OsPrices OsPrices `json:"osPrices,omitempty"`
+
+	// spot price
+	SpotPrice []*ZonePrice `json:"spotPrice"`
+}
+
+// Validate validates this product details
+func (m *ProductDetails) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCommitments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSpotStats(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBurstInfo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOsPrices(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSpotPrice(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProductDetails) validateCommitments(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Commitments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Commitments); i++ {
+		if swag.IsZero(m.Commitments[i]) { // not required
+			continue
+		}
+
+		if m.Commitments[i] != nil {
+			if err := m.Commitments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("commitments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductDetails) validateSpotStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SpotStats) { // not required
+		return nil
+	}
+
+	for k := range m.SpotStats {
+
+		if err := validate.Required("spotStats"+"."+k, "body", m.SpotStats[k]); err != nil {
+			return err
+		}
+		if val, ok := m.SpotStats[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductDetails) validateBurstInfo(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BurstInfo) { // not required
+		return nil
+	}
+
+	if m.BurstInfo != nil {
+		if err := m.BurstInfo.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("burstInfo")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ProductDetails) validateOsPrices(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OsPrices) { // not required
+		return nil
+	}
+
+	if err := m.OsPrices.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("osPrices")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProductDetails) validateSpotPrice(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SpotPrice) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SpotPrice); i++ {
+		if swag.IsZero(m.SpotPrice[i]) { // not required
+			continue
+		}
+
+		if m.SpotPrice[i] != nil {
+			if err := m.SpotPrice[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("spotPrice" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ProductDetails) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ProductDetails) UnmarshalBinary(b []byte) error {
+	var res ProductDetails
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// ProductDetailsResponse ProductDetailsResponse Api object to be mapped to product info response
+// swagger:model ProductDetailsResponse
+type ProductDetailsResponse struct {
+
+	// NextCursor is the cursor of the next page of the products, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// Products represents a slice of products for a given provider (VMs with attributes and process)
+	Products []*ProductDetails `json:"products"`
+
+	// ScrapingTime represents scraping time for a given provider in milliseconds
+	ScrapingTime string `json:"scrapingTime,omitempty"`
+
+	// units
+	Units *ResourceUnits `json:"units,omitempty"`
+}
+
+// Validate validates this product details response
+func (m *ProductDetailsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProducts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProductDetailsResponse) validateProducts(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Products) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Products); i++ {
+		if swag.IsZero(m.Products[i]) { // not required
+			continue
+		}
+
+		if m.Products[i] != nil {
+			if err := m.Products[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("products" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProductDetailsResponse) validateUnits(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Units) { // not required
+		return nil
+	}
+
+	if m.Units != nil {
+		if err := m.Units.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("units")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ProductDetailsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ProductDetailsResponse) UnmarshalBinary(b []byte) error {
+	var res ProductDetailsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
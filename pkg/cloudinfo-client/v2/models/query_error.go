@@ -0,0 +1,89 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// QueryError query error
+// swagger:model QueryError
+type QueryError struct {
+
+	// extensions
+	Extensions interface{} `json:"extensions,omitempty"`
+
+	// locations
+	Locations []*Location `json:"locations"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// path
+	Path []interface{} `json:"path"`
+}
+
+// Validate validates this query error
+func (m *QueryError) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLocations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QueryError) validateLocations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Locations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Locations); i++ {
+		if swag.IsZero(m.Locations[i]) { // not required
+			continue
+		}
+
+		if m.Locations[i] != nil {
+			if err := m.Locations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("locations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *QueryError) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *QueryError) UnmarshalBinary(b []byte) error {
+	var res QueryError
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
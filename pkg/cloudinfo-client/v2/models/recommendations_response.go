@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// RecommendationsResponse RecommendationsResponse holds the recommended node pools ranked by their cost, and the rejected instance types
+// swagger:model RecommendationsResponse
+type RecommendationsResponse struct {
+
+	// Currency is the ISO 4217 code of the currency the costs are given in
+	Currency string `json:"currency,omitempty"`
+
+	// recommendations
+	Recommendations []*Recommendation `json:"recommendations"`
+
+	// rejected
+	Rejected []*RejectedType `json:"rejected"`
+}
+
+// Validate validates this recommendations response
+func (m *RecommendationsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRecommendations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRejected(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RecommendationsResponse) validateRecommendations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Recommendations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Recommendations); i++ {
+		if swag.IsZero(m.Recommendations[i]) { // not required
+			continue
+		}
+
+		if m.Recommendations[i] != nil {
+			if err := m.Recommendations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("recommendations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RecommendationsResponse) validateRejected(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Rejected) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Rejected); i++ {
+		if swag.IsZero(m.Rejected[i]) { // not required
+			continue
+		}
+
+		if m.Rejected[i] != nil {
+			if err := m.Rejected[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("rejected" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RecommendationsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RecommendationsResponse) UnmarshalBinary(b []byte) error {
+	var res RecommendationsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
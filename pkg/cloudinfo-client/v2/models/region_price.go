@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RegionPrice RegionPrice is the lowest price of an instance type matching a query in a region
+// swagger:model RegionPrice
+type RegionPrice struct {
+
+	// Age is the age of the prices in seconds, 0 if unknown
+	Age float64 `json:"ageSeconds,omitempty"`
+
+	// Cpus is the number of vCPUs
+	Cpus float64 `json:"cpus,omitempty"`
+
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency,omitempty"`
+
+	// geography
+	Geography string `json:"geography,omitempty"`
+
+	// Gpus is the number of gpus
+	Gpus float64 `json:"gpus,omitempty"`
+
+	// Mem is the memory in GiB
+	Mem float64 `json:"mem,omitempty"`
+
+	// Price is the hourly on demand or spot price
+	Price float64 `json:"price,omitempty"`
+
+	// provider
+	Provider string `json:"provider,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+
+	// UpdatedAt is the retrieval time of the oldest prices of the region, zero if unknown
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
+
+	// Zone is the zone of the spot price, empty for on demand prices
+	Zone string `json:"zone,omitempty"`
+}
+
+// Validate validates this region price
+func (m *RegionPrice) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RegionPrice) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updatedAt", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RegionPrice) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RegionPrice) UnmarshalBinary(b []byte) error {
+	var res RegionPrice
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
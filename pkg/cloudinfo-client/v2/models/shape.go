@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// Shape Shape describes the resources the instance types are compared by
+// swagger:model Shape
+type Shape struct {
+
+	// Cpus is the number of vCPUs
+	Cpus float64 `json:"cpus,omitempty"`
+
+	// Gpus is the number of gpus
+	Gpus float64 `json:"gpus,omitempty"`
+
+	// Mem is the memory in GiB
+	Mem float64 `json:"mem,omitempty"`
+}
+
+// Validate validates this shape
+func (m *Shape) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Shape) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Shape) UnmarshalBinary(b []byte) error {
+	var res Shape
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// SpotPriceResponse SpotPriceResponse holds the spot price of an instance type aggregated over the zones of a region
+// swagger:model SpotPriceResponse
+type SpotPriceResponse struct {
+
+	// spot
+	Spot *SpotAggregate `json:"spot,omitempty"`
+}
+
+// Validate validates this spot price response
+func (m *SpotPriceResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSpot(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SpotPriceResponse) validateSpot(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Spot) { // not required
+		return nil
+	}
+
+	if m.Spot != nil {
+		if err := m.Spot.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("spot")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SpotPriceResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SpotPriceResponse) UnmarshalBinary(b []byte) error {
+	var res SpotPriceResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
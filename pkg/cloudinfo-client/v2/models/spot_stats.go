@@ -0,0 +1,61 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// SpotStats SpotStats describes the spot prices of an instance type in a zone over the look-back window
+// swagger:model SpotStats
+type SpotStats struct {
+
+	// max
+	Max float64 `json:"max,omitempty"`
+
+	// mean
+	Mean float64 `json:"mean,omitempty"`
+
+	// min
+	Min float64 `json:"min,omitempty"`
+
+	// p50
+	P50 float64 `json:"p50,omitempty"`
+
+	// p90
+	P90 float64 `json:"p90,omitempty"`
+
+	// Samples is the number of the price points the statistics are computed from
+	Samples int64 `json:"samples,omitempty"`
+
+	// Volatility is the coefficient of variation (standard deviation / mean) of the prices, 0 for stable prices
+	Volatility float64 `json:"volatility,omitempty"`
+}
+
+// Validate validates this spot stats
+func (m *SpotStats) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SpotStats) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SpotStats) UnmarshalBinary(b []byte) error {
+	var res SpotStats
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// StorageResponse StorageResponse holds the list of the block storage volume types and their prices
+// swagger:model StorageResponse
+type StorageResponse struct {
+
+	// storage
+	Storage []*StorageInfo `json:"storage"`
+}
+
+// Validate validates this storage response
+func (m *StorageResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStorage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StorageResponse) validateStorage(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Storage) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Storage); i++ {
+		if swag.IsZero(m.Storage[i]) { // not required
+			continue
+		}
+
+		if m.Storage[i] != nil {
+			if err := m.Storage[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("storage" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StorageResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StorageResponse) UnmarshalBinary(b []byte) error {
+	var res StorageResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,2296 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cloudinfo.proto
+
+package cloudinfogrpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetProvidersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProvidersRequest) Reset()         { *m = GetProvidersRequest{} }
+func (m *GetProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProvidersRequest) ProtoMessage()    {}
+func (*GetProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{0}
+}
+
+func (m *GetProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProvidersRequest.Unmarshal(m, b)
+}
+func (m *GetProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProvidersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProvidersRequest.Merge(m, src)
+}
+func (m *GetProvidersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProvidersRequest.Size(m)
+}
+func (m *GetProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProvidersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProvidersRequest proto.InternalMessageInfo
+
+type GetProvidersResponse struct {
+	Providers            []*Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetProvidersResponse) Reset()         { *m = GetProvidersResponse{} }
+func (m *GetProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProvidersResponse) ProtoMessage()    {}
+func (*GetProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{1}
+}
+
+func (m *GetProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProvidersResponse.Unmarshal(m, b)
+}
+func (m *GetProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProvidersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProvidersResponse.Merge(m, src)
+}
+func (m *GetProvidersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProvidersResponse.Size(m)
+}
+func (m *GetProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProvidersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProvidersResponse proto.InternalMessageInfo
+
+func (m *GetProvidersResponse) GetProviders() []*Provider {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+type GetProviderRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProviderRequest) Reset()         { *m = GetProviderRequest{} }
+func (m *GetProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProviderRequest) ProtoMessage()    {}
+func (*GetProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{2}
+}
+
+func (m *GetProviderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderRequest.Unmarshal(m, b)
+}
+func (m *GetProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderRequest.Merge(m, src)
+}
+func (m *GetProviderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProviderRequest.Size(m)
+}
+func (m *GetProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderRequest proto.InternalMessageInfo
+
+func (m *GetProviderRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type Provider struct {
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Services             []*Service `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Provider) Reset()         { *m = Provider{} }
+func (m *Provider) String() string { return proto.CompactTextString(m) }
+func (*Provider) ProtoMessage()    {}
+func (*Provider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{3}
+}
+
+func (m *Provider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Provider.Unmarshal(m, b)
+}
+func (m *Provider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Provider.Marshal(b, m, deterministic)
+}
+func (m *Provider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Provider.Merge(m, src)
+}
+func (m *Provider) XXX_Size() int {
+	return xxx_messageInfo_Provider.Size(m)
+}
+func (m *Provider) XXX_DiscardUnknown() {
+	xxx_messageInfo_Provider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Provider proto.InternalMessageInfo
+
+func (m *Provider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Provider) GetServices() []*Service {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type GetServicesRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServicesRequest) Reset()         { *m = GetServicesRequest{} }
+func (m *GetServicesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServicesRequest) ProtoMessage()    {}
+func (*GetServicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{4}
+}
+
+func (m *GetServicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServicesRequest.Unmarshal(m, b)
+}
+func (m *GetServicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServicesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetServicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServicesRequest.Merge(m, src)
+}
+func (m *GetServicesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetServicesRequest.Size(m)
+}
+func (m *GetServicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServicesRequest proto.InternalMessageInfo
+
+func (m *GetServicesRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type GetServicesResponse struct {
+	Services             []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetServicesResponse) Reset()         { *m = GetServicesResponse{} }
+func (m *GetServicesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServicesResponse) ProtoMessage()    {}
+func (*GetServicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{5}
+}
+
+func (m *GetServicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServicesResponse.Unmarshal(m, b)
+}
+func (m *GetServicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServicesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetServicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServicesResponse.Merge(m, src)
+}
+func (m *GetServicesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetServicesResponse.Size(m)
+}
+func (m *GetServicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServicesResponse proto.InternalMessageInfo
+
+func (m *GetServicesResponse) GetServices() []*Service {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type GetServiceRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServiceRequest) Reset()         { *m = GetServiceRequest{} }
+func (m *GetServiceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServiceRequest) ProtoMessage()    {}
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{6}
+}
+
+func (m *GetServiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServiceRequest.Unmarshal(m, b)
+}
+func (m *GetServiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServiceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetServiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceRequest.Merge(m, src)
+}
+func (m *GetServiceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetServiceRequest.Size(m)
+}
+func (m *GetServiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceRequest proto.InternalMessageInfo
+
+func (m *GetServiceRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetServiceRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type Service struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// control_plane is the fee of the managed kubernetes control plane, missing if the service has no control plane
+	ControlPlane         *ControlPlaneFee `protobuf:"bytes,2,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Service) Reset()         { *m = Service{} }
+func (m *Service) String() string { return proto.CompactTextString(m) }
+func (*Service) ProtoMessage()    {}
+func (*Service) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{7}
+}
+
+func (m *Service) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Service.Unmarshal(m, b)
+}
+func (m *Service) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Service.Marshal(b, m, deterministic)
+}
+func (m *Service) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Service.Merge(m, src)
+}
+func (m *Service) XXX_Size() int {
+	return xxx_messageInfo_Service.Size(m)
+}
+func (m *Service) XXX_DiscardUnknown() {
+	xxx_messageInfo_Service.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Service proto.InternalMessageInfo
+
+func (m *Service) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Service) GetControlPlane() *ControlPlaneFee {
+	if m != nil {
+		return m.ControlPlane
+	}
+	return nil
+}
+
+type ControlPlaneFee struct {
+	// model is the billing model of the control plane: free, hourly or free-tier
+	Model        string  `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	PricePerHour float64 `protobuf:"fixed64,2,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"`
+	// free_clusters is the number of clusters per billing account whose control plane is not charged (free-tier only)
+	FreeClusters         int32    `protobuf:"varint,3,opt,name=free_clusters,json=freeClusters,proto3" json:"free_clusters,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlPlaneFee) Reset()         { *m = ControlPlaneFee{} }
+func (m *ControlPlaneFee) String() string { return proto.CompactTextString(m) }
+func (*ControlPlaneFee) ProtoMessage()    {}
+func (*ControlPlaneFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{8}
+}
+
+func (m *ControlPlaneFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlPlaneFee.Unmarshal(m, b)
+}
+func (m *ControlPlaneFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlPlaneFee.Marshal(b, m, deterministic)
+}
+func (m *ControlPlaneFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlPlaneFee.Merge(m, src)
+}
+func (m *ControlPlaneFee) XXX_Size() int {
+	return xxx_messageInfo_ControlPlaneFee.Size(m)
+}
+func (m *ControlPlaneFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlPlaneFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlPlaneFee proto.InternalMessageInfo
+
+func (m *ControlPlaneFee) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *ControlPlaneFee) GetPricePerHour() float64 {
+	if m != nil {
+		return m.PricePerHour
+	}
+	return 0
+}
+
+func (m *ControlPlaneFee) GetFreeClusters() int32 {
+	if m != nil {
+		return m.FreeClusters
+	}
+	return 0
+}
+
+func (m *ControlPlaneFee) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type GetRegionsRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegionsRequest) Reset()         { *m = GetRegionsRequest{} }
+func (m *GetRegionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegionsRequest) ProtoMessage()    {}
+func (*GetRegionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{9}
+}
+
+func (m *GetRegionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegionsRequest.Unmarshal(m, b)
+}
+func (m *GetRegionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionsRequest.Merge(m, src)
+}
+func (m *GetRegionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegionsRequest.Size(m)
+}
+func (m *GetRegionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegionsRequest proto.InternalMessageInfo
+
+func (m *GetRegionsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetRegionsRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type GetRegionsResponse struct {
+	Regions              []*Region `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetRegionsResponse) Reset()         { *m = GetRegionsResponse{} }
+func (m *GetRegionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRegionsResponse) ProtoMessage()    {}
+func (*GetRegionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{10}
+}
+
+func (m *GetRegionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegionsResponse.Unmarshal(m, b)
+}
+func (m *GetRegionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRegionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionsResponse.Merge(m, src)
+}
+func (m *GetRegionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRegionsResponse.Size(m)
+}
+func (m *GetRegionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegionsResponse proto.InternalMessageInfo
+
+func (m *GetRegionsResponse) GetRegions() []*Region {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+type GetRegionRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRegionRequest) Reset()         { *m = GetRegionRequest{} }
+func (m *GetRegionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRegionRequest) ProtoMessage()    {}
+func (*GetRegionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{11}
+}
+
+func (m *GetRegionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRegionRequest.Unmarshal(m, b)
+}
+func (m *GetRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRegionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRegionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRegionRequest.Merge(m, src)
+}
+func (m *GetRegionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRegionRequest.Size(m)
+}
+func (m *GetRegionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRegionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRegionRequest proto.InternalMessageInfo
+
+func (m *GetRegionRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetRegionRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *GetRegionRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type Region struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// zones are the availability zones of the region, only set by GetRegion
+	Zones                []string `protobuf:"bytes,3,rep,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Region) Reset()         { *m = Region{} }
+func (m *Region) String() string { return proto.CompactTextString(m) }
+func (*Region) ProtoMessage()    {}
+func (*Region) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{12}
+}
+
+func (m *Region) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Region.Unmarshal(m, b)
+}
+func (m *Region) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Region.Marshal(b, m, deterministic)
+}
+func (m *Region) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Region.Merge(m, src)
+}
+func (m *Region) XXX_Size() int {
+	return xxx_messageInfo_Region.Size(m)
+}
+func (m *Region) XXX_DiscardUnknown() {
+	xxx_messageInfo_Region.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Region proto.InternalMessageInfo
+
+func (m *Region) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Region) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Region) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+// ListProductsRequest selects the products of a region; the empty filters select every product
+type ListProductsRequest struct {
+	Provider     string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service      string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Region       string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Category     string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Family       string `protobuf:"bytes,5,opt,name=family,proto3" json:"family,omitempty"`
+	Generation   string `protobuf:"bytes,6,opt,name=generation,proto3" json:"generation,omitempty"`
+	Size         string `protobuf:"bytes,7,opt,name=size,proto3" json:"size,omitempty"`
+	Burst        string `protobuf:"bytes,8,opt,name=burst,proto3" json:"burst,omitempty"`
+	MinBandwidth string `protobuf:"bytes,9,opt,name=min_bandwidth,json=minBandwidth,proto3" json:"min_bandwidth,omitempty"`
+	// the resource bounds are quantities, eg.: 2, 500m, 16Gi
+	MinCpu          string `protobuf:"bytes,10,opt,name=min_cpu,json=minCpu,proto3" json:"min_cpu,omitempty"`
+	MaxCpu          string `protobuf:"bytes,11,opt,name=max_cpu,json=maxCpu,proto3" json:"max_cpu,omitempty"`
+	MinMem          string `protobuf:"bytes,12,opt,name=min_mem,json=minMem,proto3" json:"min_mem,omitempty"`
+	MaxMem          string `protobuf:"bytes,13,opt,name=max_mem,json=maxMem,proto3" json:"max_mem,omitempty"`
+	MinGpu          string `protobuf:"bytes,14,opt,name=min_gpu,json=minGpu,proto3" json:"min_gpu,omitempty"`
+	MaxGpu          string `protobuf:"bytes,15,opt,name=max_gpu,json=maxGpu,proto3" json:"max_gpu,omitempty"`
+	NetworkCategory string `protobuf:"bytes,16,opt,name=network_category,json=networkCategory,proto3" json:"network_category,omitempty"`
+	CurrentGen      string `protobuf:"bytes,17,opt,name=current_gen,json=currentGen,proto3" json:"current_gen,omitempty"`
+	Zone            string `protobuf:"bytes,18,opt,name=zone,proto3" json:"zone,omitempty"`
+	Os              string `protobuf:"bytes,19,opt,name=os,proto3" json:"os,omitempty"`
+	PricingModel    string `protobuf:"bytes,20,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	PaymentOption   string `protobuf:"bytes,21,opt,name=payment_option,json=paymentOption,proto3" json:"payment_option,omitempty"`
+	Currency        string `protobuf:"bytes,22,opt,name=currency,proto3" json:"currency,omitempty"`
+	MaxPrice        string `protobuf:"bytes,23,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	MaxSpotPrice    string `protobuf:"bytes,24,opt,name=max_spot_price,json=maxSpotPrice,proto3" json:"max_spot_price,omitempty"`
+	// sort is price or pricePerCpu, the products are streamed ordered by type if empty
+	Sort string `protobuf:"bytes,25,opt,name=sort,proto3" json:"sort,omitempty"`
+	// order is asc (default) or desc
+	Order string `protobuf:"bytes,26,opt,name=order,proto3" json:"order,omitempty"`
+	// limit caps the number of the streamed products, every product is streamed if 0
+	Limit                int32    `protobuf:"varint,27,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProductsRequest) Reset()         { *m = ListProductsRequest{} }
+func (m *ListProductsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProductsRequest) ProtoMessage()    {}
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{13}
+}
+
+func (m *ListProductsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProductsRequest.Unmarshal(m, b)
+}
+func (m *ListProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProductsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProductsRequest.Merge(m, src)
+}
+func (m *ListProductsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProductsRequest.Size(m)
+}
+func (m *ListProductsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProductsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProductsRequest proto.InternalMessageInfo
+
+func (m *ListProductsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetFamily() string {
+	if m != nil {
+		return m.Family
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetGeneration() string {
+	if m != nil {
+		return m.Generation
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetBurst() string {
+	if m != nil {
+		return m.Burst
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMinBandwidth() string {
+	if m != nil {
+		return m.MinBandwidth
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMinCpu() string {
+	if m != nil {
+		return m.MinCpu
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMaxCpu() string {
+	if m != nil {
+		return m.MaxCpu
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMinMem() string {
+	if m != nil {
+		return m.MinMem
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMaxMem() string {
+	if m != nil {
+		return m.MaxMem
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMinGpu() string {
+	if m != nil {
+		return m.MinGpu
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMaxGpu() string {
+	if m != nil {
+		return m.MaxGpu
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetNetworkCategory() string {
+	if m != nil {
+		return m.NetworkCategory
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetCurrentGen() string {
+	if m != nil {
+		return m.CurrentGen
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetOs() string {
+	if m != nil {
+		return m.Os
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetPricingModel() string {
+	if m != nil {
+		return m.PricingModel
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetPaymentOption() string {
+	if m != nil {
+		return m.PaymentOption
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetMaxSpotPrice() string {
+	if m != nil {
+		return m.MaxSpotPrice
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *ListProductsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Product struct {
+	Type                 string                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Category             string                `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Family               string                `protobuf:"bytes,3,opt,name=family,proto3" json:"family,omitempty"`
+	Generation           int32                 `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+	Size                 string                `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	Cpus                 float64               `protobuf:"fixed64,6,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	CpuCores             float64               `protobuf:"fixed64,7,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	Mem                  float64               `protobuf:"fixed64,8,opt,name=mem,proto3" json:"mem,omitempty"`
+	Gpus                 float64               `protobuf:"fixed64,9,opt,name=gpus,proto3" json:"gpus,omitempty"`
+	GpuModel             string                `protobuf:"bytes,10,opt,name=gpu_model,json=gpuModel,proto3" json:"gpu_model,omitempty"`
+	GpuMem               float64               `protobuf:"fixed64,11,opt,name=gpu_mem,json=gpuMem,proto3" json:"gpu_mem,omitempty"`
+	Arch                 string                `protobuf:"bytes,12,opt,name=arch,proto3" json:"arch,omitempty"`
+	ProcessorFamily      string                `protobuf:"bytes,13,opt,name=processor_family,json=processorFamily,proto3" json:"processor_family,omitempty"`
+	NtwPerf              string                `protobuf:"bytes,14,opt,name=ntw_perf,json=ntwPerf,proto3" json:"ntw_perf,omitempty"`
+	NtwPerfCategory      string                `protobuf:"bytes,15,opt,name=ntw_perf_category,json=ntwPerfCategory,proto3" json:"ntw_perf_category,omitempty"`
+	Bandwidth            float64               `protobuf:"fixed64,16,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	BandwidthUpTo        bool                  `protobuf:"varint,17,opt,name=bandwidth_up_to,json=bandwidthUpTo,proto3" json:"bandwidth_up_to,omitempty"`
+	Zones                []string              `protobuf:"bytes,18,rep,name=zones,proto3" json:"zones,omitempty"`
+	CurrentGen           bool                  `protobuf:"varint,19,opt,name=current_gen,json=currentGen,proto3" json:"current_gen,omitempty"`
+	Burst                bool                  `protobuf:"varint,20,opt,name=burst,proto3" json:"burst,omitempty"`
+	BurstInfo            *BurstInfo            `protobuf:"bytes,21,opt,name=burst_info,json=burstInfo,proto3" json:"burst_info,omitempty"`
+	LocalDisks           int32                 `protobuf:"varint,22,opt,name=local_disks,json=localDisks,proto3" json:"local_disks,omitempty"`
+	LocalDiskSize        float64               `protobuf:"fixed64,23,opt,name=local_disk_size,json=localDiskSize,proto3" json:"local_disk_size,omitempty"`
+	LocalDiskType        string                `protobuf:"bytes,24,opt,name=local_disk_type,json=localDiskType,proto3" json:"local_disk_type,omitempty"`
+	PremiumStorage       bool                  `protobuf:"varint,25,opt,name=premium_storage,json=premiumStorage,proto3" json:"premium_storage,omitempty"`
+	MaxNics              int32                 `protobuf:"varint,26,opt,name=max_nics,json=maxNics,proto3" json:"max_nics,omitempty"`
+	Hypervisor           string                `protobuf:"bytes,27,opt,name=hypervisor,proto3" json:"hypervisor,omitempty"`
+	Attributes           map[string]string     `protobuf:"bytes,28,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Currency             string                `protobuf:"bytes,29,opt,name=currency,proto3" json:"currency,omitempty"`
+	OnDemandPrice        float64               `protobuf:"fixed64,30,opt,name=on_demand_price,json=onDemandPrice,proto3" json:"on_demand_price,omitempty"`
+	SpotPrices           []*ZonePrice          `protobuf:"bytes,31,rep,name=spot_prices,json=spotPrices,proto3" json:"spot_prices,omitempty"`
+	SpotStats            map[string]*SpotStats `protobuf:"bytes,32,rep,name=spot_stats,json=spotStats,proto3" json:"spot_stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OsPrices             map[string]float64    `protobuf:"bytes,33,rep,name=os_prices,json=osPrices,proto3" json:"os_prices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Commitments          []*CommitmentPrice    `protobuf:"bytes,34,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Product) Reset()         { *m = Product{} }
+func (m *Product) String() string { return proto.CompactTextString(m) }
+func (*Product) ProtoMessage()    {}
+func (*Product) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{14}
+}
+
+func (m *Product) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Product.Unmarshal(m, b)
+}
+func (m *Product) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Product.Marshal(b, m, deterministic)
+}
+func (m *Product) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Product.Merge(m, src)
+}
+func (m *Product) XXX_Size() int {
+	return xxx_messageInfo_Product.Size(m)
+}
+func (m *Product) XXX_DiscardUnknown() {
+	xxx_messageInfo_Product.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Product proto.InternalMessageInfo
+
+func (m *Product) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Product) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *Product) GetFamily() string {
+	if m != nil {
+		return m.Family
+	}
+	return ""
+}
+
+func (m *Product) GetGeneration() int32 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *Product) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
+func (m *Product) GetCpus() float64 {
+	if m != nil {
+		return m.Cpus
+	}
+	return 0
+}
+
+func (m *Product) GetCpuCores() float64 {
+	if m != nil {
+		return m.CpuCores
+	}
+	return 0
+}
+
+func (m *Product) GetMem() float64 {
+	if m != nil {
+		return m.Mem
+	}
+	return 0
+}
+
+func (m *Product) GetGpus() float64 {
+	if m != nil {
+		return m.Gpus
+	}
+	return 0
+}
+
+func (m *Product) GetGpuModel() string {
+	if m != nil {
+		return m.GpuModel
+	}
+	return ""
+}
+
+func (m *Product) GetGpuMem() float64 {
+	if m != nil {
+		return m.GpuMem
+	}
+	return 0
+}
+
+func (m *Product) GetArch() string {
+	if m != nil {
+		return m.Arch
+	}
+	return ""
+}
+
+func (m *Product) GetProcessorFamily() string {
+	if m != nil {
+		return m.ProcessorFamily
+	}
+	return ""
+}
+
+func (m *Product) GetNtwPerf() string {
+	if m != nil {
+		return m.NtwPerf
+	}
+	return ""
+}
+
+func (m *Product) GetNtwPerfCategory() string {
+	if m != nil {
+		return m.NtwPerfCategory
+	}
+	return ""
+}
+
+func (m *Product) GetBandwidth() float64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *Product) GetBandwidthUpTo() bool {
+	if m != nil {
+		return m.BandwidthUpTo
+	}
+	return false
+}
+
+func (m *Product) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *Product) GetCurrentGen() bool {
+	if m != nil {
+		return m.CurrentGen
+	}
+	return false
+}
+
+func (m *Product) GetBurst() bool {
+	if m != nil {
+		return m.Burst
+	}
+	return false
+}
+
+func (m *Product) GetBurstInfo() *BurstInfo {
+	if m != nil {
+		return m.BurstInfo
+	}
+	return nil
+}
+
+func (m *Product) GetLocalDisks() int32 {
+	if m != nil {
+		return m.LocalDisks
+	}
+	return 0
+}
+
+func (m *Product) GetLocalDiskSize() float64 {
+	if m != nil {
+		return m.LocalDiskSize
+	}
+	return 0
+}
+
+func (m *Product) GetLocalDiskType() string {
+	if m != nil {
+		return m.LocalDiskType
+	}
+	return ""
+}
+
+func (m *Product) GetPremiumStorage() bool {
+	if m != nil {
+		return m.PremiumStorage
+	}
+	return false
+}
+
+func (m *Product) GetMaxNics() int32 {
+	if m != nil {
+		return m.MaxNics
+	}
+	return 0
+}
+
+func (m *Product) GetHypervisor() string {
+	if m != nil {
+		return m.Hypervisor
+	}
+	return ""
+}
+
+func (m *Product) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Product) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Product) GetOnDemandPrice() float64 {
+	if m != nil {
+		return m.OnDemandPrice
+	}
+	return 0
+}
+
+func (m *Product) GetSpotPrices() []*ZonePrice {
+	if m != nil {
+		return m.SpotPrices
+	}
+	return nil
+}
+
+func (m *Product) GetSpotStats() map[string]*SpotStats {
+	if m != nil {
+		return m.SpotStats
+	}
+	return nil
+}
+
+func (m *Product) GetOsPrices() map[string]float64 {
+	if m != nil {
+		return m.OsPrices
+	}
+	return nil
+}
+
+func (m *Product) GetCommitments() []*CommitmentPrice {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+type BurstInfo struct {
+	BaselineCpuPercent   float64  `protobuf:"fixed64,1,opt,name=baseline_cpu_percent,json=baselineCpuPercent,proto3" json:"baseline_cpu_percent,omitempty"`
+	CreditsPerHour       float64  `protobuf:"fixed64,2,opt,name=credits_per_hour,json=creditsPerHour,proto3" json:"credits_per_hour,omitempty"`
+	Unlimited            bool     `protobuf:"varint,3,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BurstInfo) Reset()         { *m = BurstInfo{} }
+func (m *BurstInfo) String() string { return proto.CompactTextString(m) }
+func (*BurstInfo) ProtoMessage()    {}
+func (*BurstInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{15}
+}
+
+func (m *BurstInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurstInfo.Unmarshal(m, b)
+}
+func (m *BurstInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurstInfo.Marshal(b, m, deterministic)
+}
+func (m *BurstInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurstInfo.Merge(m, src)
+}
+func (m *BurstInfo) XXX_Size() int {
+	return xxx_messageInfo_BurstInfo.Size(m)
+}
+func (m *BurstInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurstInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurstInfo proto.InternalMessageInfo
+
+func (m *BurstInfo) GetBaselineCpuPercent() float64 {
+	if m != nil {
+		return m.BaselineCpuPercent
+	}
+	return 0
+}
+
+func (m *BurstInfo) GetCreditsPerHour() float64 {
+	if m != nil {
+		return m.CreditsPerHour
+	}
+	return 0
+}
+
+func (m *BurstInfo) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
+}
+
+type ZonePrice struct {
+	Zone                 string   `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZonePrice) Reset()         { *m = ZonePrice{} }
+func (m *ZonePrice) String() string { return proto.CompactTextString(m) }
+func (*ZonePrice) ProtoMessage()    {}
+func (*ZonePrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{16}
+}
+
+func (m *ZonePrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZonePrice.Unmarshal(m, b)
+}
+func (m *ZonePrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZonePrice.Marshal(b, m, deterministic)
+}
+func (m *ZonePrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZonePrice.Merge(m, src)
+}
+func (m *ZonePrice) XXX_Size() int {
+	return xxx_messageInfo_ZonePrice.Size(m)
+}
+func (m *ZonePrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZonePrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZonePrice proto.InternalMessageInfo
+
+func (m *ZonePrice) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZonePrice) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type SpotStats struct {
+	Min                  float64  `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64  `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean                 float64  `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	P50                  float64  `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90                  float64  `protobuf:"fixed64,5,opt,name=p90,proto3" json:"p90,omitempty"`
+	Volatility           float64  `protobuf:"fixed64,6,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	Samples              int32    `protobuf:"varint,7,opt,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpotStats) Reset()         { *m = SpotStats{} }
+func (m *SpotStats) String() string { return proto.CompactTextString(m) }
+func (*SpotStats) ProtoMessage()    {}
+func (*SpotStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{17}
+}
+
+func (m *SpotStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpotStats.Unmarshal(m, b)
+}
+func (m *SpotStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpotStats.Marshal(b, m, deterministic)
+}
+func (m *SpotStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotStats.Merge(m, src)
+}
+func (m *SpotStats) XXX_Size() int {
+	return xxx_messageInfo_SpotStats.Size(m)
+}
+func (m *SpotStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotStats proto.InternalMessageInfo
+
+func (m *SpotStats) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *SpotStats) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *SpotStats) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *SpotStats) GetP50() float64 {
+	if m != nil {
+		return m.P50
+	}
+	return 0
+}
+
+func (m *SpotStats) GetP90() float64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *SpotStats) GetVolatility() float64 {
+	if m != nil {
+		return m.Volatility
+	}
+	return 0
+}
+
+func (m *SpotStats) GetSamples() int32 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+type CommitmentPrice struct {
+	Term                 string   `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	PaymentOption        string   `protobuf:"bytes,2,opt,name=payment_option,json=paymentOption,proto3" json:"payment_option,omitempty"`
+	Upfront              float64  `protobuf:"fixed64,3,opt,name=upfront,proto3" json:"upfront,omitempty"`
+	Hourly               float64  `protobuf:"fixed64,4,opt,name=hourly,proto3" json:"hourly,omitempty"`
+	EffectiveHourly      float64  `protobuf:"fixed64,5,opt,name=effective_hourly,json=effectiveHourly,proto3" json:"effective_hourly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitmentPrice) Reset()         { *m = CommitmentPrice{} }
+func (m *CommitmentPrice) String() string { return proto.CompactTextString(m) }
+func (*CommitmentPrice) ProtoMessage()    {}
+func (*CommitmentPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{18}
+}
+
+func (m *CommitmentPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitmentPrice.Unmarshal(m, b)
+}
+func (m *CommitmentPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitmentPrice.Marshal(b, m, deterministic)
+}
+func (m *CommitmentPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentPrice.Merge(m, src)
+}
+func (m *CommitmentPrice) XXX_Size() int {
+	return xxx_messageInfo_CommitmentPrice.Size(m)
+}
+func (m *CommitmentPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentPrice proto.InternalMessageInfo
+
+func (m *CommitmentPrice) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *CommitmentPrice) GetPaymentOption() string {
+	if m != nil {
+		return m.PaymentOption
+	}
+	return ""
+}
+
+func (m *CommitmentPrice) GetUpfront() float64 {
+	if m != nil {
+		return m.Upfront
+	}
+	return 0
+}
+
+func (m *CommitmentPrice) GetHourly() float64 {
+	if m != nil {
+		return m.Hourly
+	}
+	return 0
+}
+
+func (m *CommitmentPrice) GetEffectiveHourly() float64 {
+	if m != nil {
+		return m.EffectiveHourly
+	}
+	return 0
+}
+
+type GetAttributeValuesRequest struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service  string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// attribute is cpu or memory
+	Attribute            string   `protobuf:"bytes,3,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAttributeValuesRequest) Reset()         { *m = GetAttributeValuesRequest{} }
+func (m *GetAttributeValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAttributeValuesRequest) ProtoMessage()    {}
+func (*GetAttributeValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{19}
+}
+
+func (m *GetAttributeValuesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAttributeValuesRequest.Unmarshal(m, b)
+}
+func (m *GetAttributeValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAttributeValuesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAttributeValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAttributeValuesRequest.Merge(m, src)
+}
+func (m *GetAttributeValuesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAttributeValuesRequest.Size(m)
+}
+func (m *GetAttributeValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAttributeValuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAttributeValuesRequest proto.InternalMessageInfo
+
+func (m *GetAttributeValuesRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetAttributeValuesRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *GetAttributeValuesRequest) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+type GetAttributeValuesResponse struct {
+	Attribute            string    `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Values               []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Unit                 string    `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetAttributeValuesResponse) Reset()         { *m = GetAttributeValuesResponse{} }
+func (m *GetAttributeValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAttributeValuesResponse) ProtoMessage()    {}
+func (*GetAttributeValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{20}
+}
+
+func (m *GetAttributeValuesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAttributeValuesResponse.Unmarshal(m, b)
+}
+func (m *GetAttributeValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAttributeValuesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAttributeValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAttributeValuesResponse.Merge(m, src)
+}
+func (m *GetAttributeValuesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAttributeValuesResponse.Size(m)
+}
+func (m *GetAttributeValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAttributeValuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAttributeValuesResponse proto.InternalMessageInfo
+
+func (m *GetAttributeValuesResponse) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *GetAttributeValuesResponse) GetValues() []float64 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *GetAttributeValuesResponse) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+type GetImagesRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetImagesRequest) Reset()         { *m = GetImagesRequest{} }
+func (m *GetImagesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImagesRequest) ProtoMessage()    {}
+func (*GetImagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{21}
+}
+
+func (m *GetImagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImagesRequest.Unmarshal(m, b)
+}
+func (m *GetImagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImagesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetImagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImagesRequest.Merge(m, src)
+}
+func (m *GetImagesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImagesRequest.Size(m)
+}
+func (m *GetImagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImagesRequest proto.InternalMessageInfo
+
+func (m *GetImagesRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetImagesRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *GetImagesRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type GetImagesResponse struct {
+	Images               []string `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetImagesResponse) Reset()         { *m = GetImagesResponse{} }
+func (m *GetImagesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImagesResponse) ProtoMessage()    {}
+func (*GetImagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{22}
+}
+
+func (m *GetImagesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImagesResponse.Unmarshal(m, b)
+}
+func (m *GetImagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImagesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetImagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImagesResponse.Merge(m, src)
+}
+func (m *GetImagesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImagesResponse.Size(m)
+}
+func (m *GetImagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetImagesResponse proto.InternalMessageInfo
+
+func (m *GetImagesResponse) GetImages() []string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type GetVersionsRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Service              string   `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionsRequest) Reset()         { *m = GetVersionsRequest{} }
+func (m *GetVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetVersionsRequest) ProtoMessage()    {}
+func (*GetVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{23}
+}
+
+func (m *GetVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionsRequest.Unmarshal(m, b)
+}
+func (m *GetVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionsRequest.Merge(m, src)
+}
+func (m *GetVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetVersionsRequest.Size(m)
+}
+func (m *GetVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionsRequest proto.InternalMessageInfo
+
+func (m *GetVersionsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *GetVersionsRequest) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+func (m *GetVersionsRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+type GetVersionsResponse struct {
+	Versions             []string `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetVersionsResponse) Reset()         { *m = GetVersionsResponse{} }
+func (m *GetVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVersionsResponse) ProtoMessage()    {}
+func (*GetVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_752025a360c7f82a, []int{24}
+}
+
+func (m *GetVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVersionsResponse.Unmarshal(m, b)
+}
+func (m *GetVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVersionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVersionsResponse.Merge(m, src)
+}
+func (m *GetVersionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetVersionsResponse.Size(m)
+}
+func (m *GetVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVersionsResponse proto.InternalMessageInfo
+
+func (m *GetVersionsResponse) GetVersions() []string {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetProvidersRequest)(nil), "cloudinfo.v1.GetProvidersRequest")
+	proto.RegisterType((*GetProvidersResponse)(nil), "cloudinfo.v1.GetProvidersResponse")
+	proto.RegisterType((*GetProviderRequest)(nil), "cloudinfo.v1.GetProviderRequest")
+	proto.RegisterType((*Provider)(nil), "cloudinfo.v1.Provider")
+	proto.RegisterType((*GetServicesRequest)(nil), "cloudinfo.v1.GetServicesRequest")
+	proto.RegisterType((*GetServicesResponse)(nil), "cloudinfo.v1.GetServicesResponse")
+	proto.RegisterType((*GetServiceRequest)(nil), "cloudinfo.v1.GetServiceRequest")
+	proto.RegisterType((*Service)(nil), "cloudinfo.v1.Service")
+	proto.RegisterType((*ControlPlaneFee)(nil), "cloudinfo.v1.ControlPlaneFee")
+	proto.RegisterType((*GetRegionsRequest)(nil), "cloudinfo.v1.GetRegionsRequest")
+	proto.RegisterType((*GetRegionsResponse)(nil), "cloudinfo.v1.GetRegionsResponse")
+	proto.RegisterType((*GetRegionRequest)(nil), "cloudinfo.v1.GetRegionRequest")
+	proto.RegisterType((*Region)(nil), "cloudinfo.v1.Region")
+	proto.RegisterType((*ListProductsRequest)(nil), "cloudinfo.v1.ListProductsRequest")
+	proto.RegisterType((*Product)(nil), "cloudinfo.v1.Product")
+	proto.RegisterMapType((map[string]string)(nil), "cloudinfo.v1.Product.AttributesEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "cloudinfo.v1.Product.OsPricesEntry")
+	proto.RegisterMapType((map[string]*SpotStats)(nil), "cloudinfo.v1.Product.SpotStatsEntry")
+	proto.RegisterType((*BurstInfo)(nil), "cloudinfo.v1.BurstInfo")
+	proto.RegisterType((*ZonePrice)(nil), "cloudinfo.v1.ZonePrice")
+	proto.RegisterType((*SpotStats)(nil), "cloudinfo.v1.SpotStats")
+	proto.RegisterType((*CommitmentPrice)(nil), "cloudinfo.v1.CommitmentPrice")
+	proto.RegisterType((*GetAttributeValuesRequest)(nil), "cloudinfo.v1.GetAttributeValuesRequest")
+	proto.RegisterType((*GetAttributeValuesResponse)(nil), "cloudinfo.v1.GetAttributeValuesResponse")
+	proto.RegisterType((*GetImagesRequest)(nil), "cloudinfo.v1.GetImagesRequest")
+	proto.RegisterType((*GetImagesResponse)(nil), "cloudinfo.v1.GetImagesResponse")
+	proto.RegisterType((*GetVersionsRequest)(nil), "cloudinfo.v1.GetVersionsRequest")
+	proto.RegisterType((*GetVersionsResponse)(nil), "cloudinfo.v1.GetVersionsResponse")
+}
+
+func init() { proto.RegisterFile("cloudinfo.proto", fileDescriptor_752025a360c7f82a) }
+
+var fileDescriptor_752025a360c7f82a = []byte{
+	// 1814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x1f, 0x48, 0xa6, 0x44, 0x3c, 0x4a, 0xa2, 0xbc, 0x92, 0x6d, 0x98, 0x71, 0x6c, 0x19, 0xf9,
+	0x52, 0xdb, 0x89, 0x2c, 0xbb, 0x4d, 0x27, 0x69, 0xa6, 0xd3, 0x5a, 0x74, 0x22, 0xbb, 0xe3, 0xd4,
+	0x2a, 0x14, 0xe7, 0x90, 0x0b, 0x0a, 0x82, 0x4b, 0x0a, 0x63, 0x02, 0x8b, 0xee, 0x2e, 0x64, 0xd1,
+	0xf7, 0x5e, 0x7b, 0xee, 0xb5, 0xc7, 0xde, 0xfa, 0xdf, 0xf5, 0x9a, 0x79, 0x6f, 0x17, 0x20, 0x40,
+	0x41, 0x8e, 0x67, 0x3c, 0xb9, 0xed, 0xfb, 0xbd, 0x8f, 0xdd, 0xf7, 0xb9, 0x58, 0x40, 0x3f, 0x9e,
+	0x89, 0x62, 0x9c, 0x64, 0x13, 0x71, 0x90, 0x4b, 0xa1, 0x05, 0xdb, 0x58, 0x00, 0xe7, 0x0f, 0xfd,
+	0x1b, 0xb0, 0x73, 0xcc, 0xf5, 0x89, 0x14, 0xe7, 0xc9, 0x98, 0x4b, 0x15, 0xf0, 0x7f, 0x14, 0x5c,
+	0x69, 0xff, 0x39, 0xec, 0x36, 0x61, 0x95, 0x8b, 0x4c, 0x71, 0xf6, 0x3b, 0x70, 0xf3, 0x12, 0xf4,
+	0x9c, 0xbd, 0xd5, 0xfd, 0xde, 0xa3, 0x9b, 0x07, 0x75, 0x83, 0x07, 0xa5, 0x4e, 0xb0, 0x10, 0xf4,
+	0x0f, 0x81, 0xd5, 0xac, 0xd9, 0x3d, 0xd8, 0x00, 0xba, 0xa5, 0x88, 0xe7, 0xec, 0x39, 0xfb, 0x6e,
+	0x50, 0xd1, 0xfe, 0xdf, 0xa0, 0x5b, 0x8a, 0x33, 0x06, 0xd7, 0xb2, 0x28, 0xe5, 0x56, 0x86, 0xd6,
+	0xec, 0x21, 0x74, 0x15, 0x97, 0xe7, 0x49, 0xcc, 0x95, 0xb7, 0x42, 0xc7, 0xb8, 0xd1, 0x3c, 0xc6,
+	0xa9, 0xe1, 0x06, 0x95, 0x98, 0x3d, 0x84, 0xc5, 0xd5, 0xbb, 0x1c, 0xe2, 0x29, 0xec, 0x34, 0x34,
+	0x6c, 0x0c, 0xea, 0x7b, 0x3b, 0xef, 0xb6, 0xf7, 0x33, 0xb8, 0xbe, 0xb0, 0xf4, 0x0e, 0x5b, 0x33,
+	0x0f, 0xd6, 0xad, 0xb2, 0xb7, 0x42, 0xac, 0x92, 0xf4, 0x23, 0x58, 0xb7, 0x76, 0x5a, 0x03, 0x73,
+	0x04, 0x9b, 0xb1, 0xc8, 0xb4, 0x14, 0xb3, 0x30, 0x9f, 0x45, 0x99, 0x51, 0xef, 0x3d, 0xfa, 0xb0,
+	0x79, 0xc2, 0xa1, 0x11, 0x39, 0x41, 0x89, 0x6f, 0x39, 0x0f, 0x36, 0xe2, 0x1a, 0xe0, 0xff, 0xcb,
+	0x81, 0xfe, 0x92, 0x04, 0xdb, 0x85, 0x4e, 0x2a, 0xc6, 0x7c, 0x66, 0x37, 0x33, 0x04, 0xfb, 0x18,
+	0xb6, 0x72, 0x99, 0xc4, 0x3c, 0xcc, 0xb9, 0x0c, 0xcf, 0x44, 0x21, 0x69, 0x3b, 0x27, 0xd8, 0x20,
+	0xf4, 0x84, 0xcb, 0xa7, 0xa2, 0x90, 0xec, 0x23, 0xd8, 0x9c, 0x48, 0xce, 0xc3, 0x78, 0x56, 0x28,
+	0x8d, 0x85, 0xb3, 0xba, 0xe7, 0xec, 0x77, 0x82, 0x0d, 0x04, 0x87, 0x16, 0xc3, 0x68, 0xc4, 0x85,
+	0x94, 0x3c, 0x8b, 0xe7, 0xde, 0x35, 0x13, 0x8d, 0x92, 0xb6, 0xe1, 0x0b, 0xf8, 0x34, 0x11, 0x99,
+	0x7a, 0xbf, 0xf0, 0x3d, 0x01, 0x56, 0x37, 0x65, 0x53, 0x7a, 0x00, 0xeb, 0xd2, 0x40, 0x36, 0xa3,
+	0xbb, 0xcd, 0x78, 0x19, 0xf9, 0xa0, 0x14, 0xf2, 0xff, 0x0e, 0xdb, 0x95, 0x95, 0xf7, 0x3a, 0x0f,
+	0xbb, 0x09, 0x6b, 0xc6, 0x28, 0x05, 0xc5, 0x0d, 0x2c, 0xe5, 0x1f, 0xc1, 0x9a, 0x31, 0xcf, 0xb6,
+	0x60, 0x25, 0x19, 0x5b, 0x8b, 0x2b, 0xc9, 0xb8, 0xca, 0xfa, 0x4a, 0x2d, 0xeb, 0xbb, 0xd0, 0x79,
+	0x23, 0x32, 0x8e, 0x91, 0x5d, 0xc5, 0xec, 0x10, 0xe1, 0xff, 0xbf, 0x03, 0x3b, 0xcf, 0x13, 0x85,
+	0x8d, 0x37, 0x2e, 0x62, 0xad, 0x7e, 0x91, 0x93, 0x52, 0xe2, 0x22, 0xcd, 0xa7, 0x42, 0x2e, 0x12,
+	0x67, 0x69, 0xd4, 0x99, 0x44, 0x69, 0x32, 0x9b, 0x7b, 0x1d, 0xa3, 0x63, 0x28, 0x76, 0x17, 0x60,
+	0xca, 0x33, 0x2e, 0x23, 0x8d, 0xf6, 0xd6, 0x88, 0x57, 0x43, 0xd0, 0x47, 0x95, 0xbc, 0xe1, 0xde,
+	0xba, 0xf1, 0x11, 0xd7, 0xe8, 0xe3, 0xa8, 0x90, 0x4a, 0x7b, 0x5d, 0x53, 0x81, 0x44, 0x60, 0x6d,
+	0xa5, 0x49, 0x16, 0x8e, 0xa2, 0x6c, 0xfc, 0x3a, 0x19, 0xeb, 0x33, 0xcf, 0x25, 0xee, 0x46, 0x9a,
+	0x64, 0x47, 0x25, 0xc6, 0x6e, 0xc1, 0x3a, 0x0a, 0xc5, 0x79, 0xe1, 0x81, 0x39, 0x47, 0x9a, 0x64,
+	0xc3, 0xbc, 0x20, 0x46, 0x74, 0x41, 0x8c, 0x9e, 0x65, 0x44, 0x17, 0x25, 0x23, 0xc9, 0xc2, 0x94,
+	0xa7, 0xde, 0x46, 0xa5, 0xf1, 0x1d, 0x4f, 0x4b, 0x0d, 0x64, 0x6c, 0x56, 0x1a, 0x25, 0x23, 0xc9,
+	0xc2, 0x69, 0x5e, 0x78, 0x5b, 0x95, 0xc6, 0xf1, 0x62, 0x0f, 0x64, 0xf4, 0x2b, 0x0d, 0x64, 0xfc,
+	0x0a, 0xb6, 0x33, 0xae, 0x5f, 0x0b, 0xf9, 0x2a, 0xac, 0x02, 0xb8, 0x4d, 0x12, 0x7d, 0x8b, 0x0f,
+	0xcb, 0x38, 0xde, 0x83, 0x9e, 0x69, 0x06, 0x1d, 0x4e, 0x79, 0xe6, 0x5d, 0x37, 0x01, 0xb3, 0xd0,
+	0x31, 0xa7, 0x80, 0x61, 0xce, 0x3d, 0x66, 0x02, 0x86, 0x6b, 0x2c, 0x1c, 0xa1, 0xbc, 0x1d, 0x53,
+	0x38, 0x42, 0x61, 0xa8, 0xb0, 0x2d, 0x93, 0x6c, 0x1a, 0x9a, 0x56, 0xde, 0x35, 0xa1, 0xb2, 0xe0,
+	0x77, 0x88, 0xb1, 0x4f, 0x60, 0x2b, 0x8f, 0xe6, 0x29, 0xee, 0x24, 0x72, 0xca, 0xce, 0x0d, 0x92,
+	0xda, 0xb4, 0xe8, 0x0b, 0x02, 0x1b, 0xdd, 0x7a, 0xb3, 0xd9, 0xad, 0xec, 0x03, 0x70, 0xd1, 0x61,
+	0x34, 0xcb, 0xbd, 0x5b, 0x86, 0x99, 0x46, 0x17, 0x27, 0x48, 0xe3, 0xc4, 0x40, 0xa6, 0xca, 0x85,
+	0xb6, 0x12, 0x9e, 0x4d, 0x58, 0x74, 0x71, 0x9a, 0x0b, 0x6d, 0xa4, 0x30, 0xff, 0x42, 0x6a, 0xef,
+	0xb6, 0xcd, 0xbf, 0x90, 0x1a, 0xf3, 0x2f, 0x24, 0x96, 0xec, 0xc0, 0xe4, 0x9f, 0x08, 0x44, 0x67,
+	0x49, 0x9a, 0x68, 0xef, 0x03, 0x9a, 0x29, 0x86, 0xf0, 0xff, 0xdd, 0x83, 0x75, 0x5b, 0xf5, 0x68,
+	0x4b, 0xcf, 0xf3, 0x6a, 0x4a, 0xe2, 0xba, 0x51, 0xb3, 0x2b, 0x57, 0xd6, 0xec, 0xea, 0x5b, 0x6a,
+	0xf6, 0x1a, 0x6d, 0xd7, 0x56, 0xb3, 0x9d, 0x5a, 0xcd, 0x32, 0xb8, 0x16, 0xe7, 0x85, 0xa2, 0x0a,
+	0x77, 0x02, 0x5a, 0x63, 0x78, 0xe2, 0xbc, 0x08, 0x63, 0x21, 0xb9, 0xa2, 0x02, 0x77, 0x82, 0x6e,
+	0x9c, 0x17, 0x43, 0xa4, 0xd9, 0x36, 0xac, 0x62, 0x69, 0x75, 0x09, 0xc6, 0x25, 0x9a, 0x98, 0xa2,
+	0x09, 0xd7, 0x98, 0x98, 0x5a, 0x13, 0xd3, 0xbc, 0xb0, 0x59, 0x34, 0x15, 0xdd, 0x9d, 0xe6, 0x85,
+	0xc9, 0xe0, 0x2d, 0x58, 0x27, 0x26, 0x4f, 0xa9, 0xa6, 0x9d, 0x60, 0x0d, 0x59, 0xc6, 0x52, 0x24,
+	0xe3, 0x33, 0x5b, 0xd0, 0xb4, 0xc6, 0x1a, 0xcc, 0xa5, 0x88, 0xb9, 0x52, 0x42, 0x86, 0xd6, 0x6d,
+	0x53, 0xd7, 0xfd, 0x0a, 0xff, 0xd6, 0xf8, 0x7f, 0x1b, 0xba, 0x99, 0x7e, 0x8d, 0x93, 0x7e, 0x62,
+	0x2b, 0x7c, 0x3d, 0xd3, 0xaf, 0x4f, 0xb8, 0x9c, 0xb0, 0x5f, 0xc3, 0xf5, 0x92, 0xb5, 0x28, 0xe5,
+	0xbe, 0x2d, 0x65, 0x23, 0x53, 0x95, 0xf2, 0x1d, 0x70, 0x17, 0xcd, 0xba, 0x4d, 0x07, 0x5c, 0x00,
+	0xec, 0x53, 0xe8, 0x57, 0x44, 0x58, 0xe4, 0xa1, 0x16, 0x54, 0xec, 0xdd, 0x60, 0xb3, 0x82, 0x5f,
+	0xe6, 0xdf, 0x8b, 0xc5, 0xc0, 0x63, 0xb5, 0x81, 0xb7, 0xdc, 0x26, 0x3b, 0xa4, 0x59, 0x6f, 0x93,
+	0x6a, 0x86, 0xec, 0x12, 0xcb, 0x10, 0xec, 0xf7, 0x00, 0xb4, 0x08, 0x71, 0xdc, 0x53, 0xbd, 0xf7,
+	0x1e, 0xdd, 0x6a, 0x5e, 0x00, 0x47, 0xc8, 0x7f, 0x96, 0x4d, 0x44, 0xe0, 0x8e, 0xca, 0x25, 0x6e,
+	0x37, 0x13, 0x71, 0x34, 0x0b, 0xc7, 0x89, 0x7a, 0xa5, 0xa8, 0x0f, 0x3a, 0x01, 0x10, 0xf4, 0x04,
+	0x11, 0xf4, 0x66, 0x21, 0x10, 0x52, 0x75, 0xdc, 0x22, 0x8f, 0x37, 0x2b, 0xa1, 0x53, 0x2c, 0x93,
+	0xa6, 0x1c, 0x55, 0xab, 0xe9, 0x8a, 0x85, 0xdc, 0xf7, 0x58, 0xb6, 0x9f, 0x41, 0x3f, 0x97, 0x3c,
+	0x4d, 0x8a, 0x34, 0x54, 0x5a, 0xc8, 0x68, 0xca, 0xa9, 0x43, 0xba, 0xc1, 0x96, 0x85, 0x4f, 0x0d,
+	0x8a, 0xb9, 0xc2, 0x2e, 0xcb, 0x92, 0x58, 0x51, 0xbb, 0x74, 0x02, 0x9c, 0x41, 0x7f, 0x4d, 0x62,
+	0x85, 0x65, 0x7c, 0x36, 0xcf, 0x71, 0xa6, 0x2b, 0x21, 0xa9, 0x6b, 0xdc, 0xa0, 0x86, 0xb0, 0x6f,
+	0x00, 0x22, 0xad, 0x65, 0x32, 0x2a, 0x34, 0x57, 0xde, 0x1d, 0xba, 0x0d, 0x3f, 0xb9, 0xf4, 0x89,
+	0x87, 0x9d, 0x75, 0xf0, 0xb8, 0x92, 0xfb, 0x26, 0xd3, 0x72, 0x1e, 0xd4, 0x14, 0x1b, 0x03, 0xe2,
+	0xc3, 0xa5, 0x01, 0xf1, 0x29, 0xf4, 0x45, 0x16, 0x8e, 0x79, 0x1a, 0x65, 0x63, 0x3b, 0x04, 0xee,
+	0x9a, 0xb0, 0x88, 0xec, 0x09, 0xa1, 0x66, 0x0a, 0x7c, 0x09, 0xbd, 0xc5, 0x9c, 0x50, 0xde, 0xbd,
+	0xbd, 0xd5, 0xcb, 0x89, 0xf9, 0x51, 0x64, 0x9c, 0xa4, 0x03, 0x50, 0xe5, 0xf8, 0x50, 0x6c, 0x08,
+	0x44, 0x85, 0x4a, 0x47, 0x5a, 0x79, 0x7b, 0xa4, 0xf8, 0x71, 0xbb, 0x13, 0x38, 0x74, 0x4e, 0x51,
+	0xcc, 0xf8, 0xe0, 0xaa, 0x92, 0x66, 0x7f, 0x06, 0x57, 0xa8, 0x72, 0xf3, 0xfb, 0x64, 0xe3, 0xa3,
+	0x76, 0x1b, 0x2f, 0x94, 0xd9, 0xd7, 0x98, 0xe8, 0x0a, 0x4b, 0xb2, 0x3f, 0x41, 0x2f, 0x16, 0x69,
+	0x9a, 0x68, 0x9c, 0x9c, 0xca, 0xf3, 0xf7, 0x56, 0xdb, 0x3e, 0xc5, 0x4a, 0x01, 0xe3, 0x46, 0x5d,
+	0x63, 0xf0, 0x47, 0xe8, 0x2f, 0x05, 0x19, 0x27, 0xc4, 0x2b, 0x3e, 0xb7, 0xd3, 0x0c, 0x97, 0x58,
+	0xd4, 0xe7, 0xd1, 0xac, 0x28, 0x2f, 0x6c, 0x43, 0xfc, 0x61, 0xe5, 0x4b, 0x67, 0xf0, 0x12, 0xb6,
+	0x9a, 0xee, 0xb5, 0x68, 0x7f, 0x5e, 0xd7, 0xbe, 0x14, 0xde, 0x4a, 0xbd, 0x6e, 0xf6, 0x6b, 0xd8,
+	0x6c, 0x78, 0xfc, 0x73, 0x67, 0x72, 0x6a, 0xca, 0xfe, 0x3f, 0x1d, 0x70, 0xab, 0x6e, 0x62, 0x87,
+	0xb0, 0x3b, 0x8a, 0x14, 0x9f, 0x25, 0x19, 0xc7, 0x5b, 0x18, 0x47, 0x48, 0xcc, 0x33, 0x4d, 0xa6,
+	0x9c, 0x80, 0x95, 0xbc, 0x61, 0x5e, 0x9c, 0x18, 0x0e, 0xdb, 0x87, 0xed, 0x58, 0xf2, 0x71, 0xa2,
+	0xd5, 0xf2, 0x47, 0xe7, 0x96, 0xc5, 0xcb, 0xcf, 0xce, 0x3b, 0xe0, 0x16, 0x19, 0xdd, 0x07, 0x7c,
+	0x4c, 0xb3, 0xbc, 0x1b, 0x2c, 0x00, 0xff, 0x0b, 0x70, 0xab, 0xda, 0xa9, 0xae, 0x4f, 0xa7, 0x76,
+	0x7d, 0xee, 0x42, 0xc7, 0xd4, 0xa6, 0x75, 0x81, 0x08, 0xff, 0x3f, 0x0e, 0xb8, 0x55, 0x50, 0x68,
+	0x5c, 0x27, 0x99, 0x3d, 0x2d, 0x2e, 0x09, 0x89, 0x2e, 0xac, 0x0e, 0x2e, 0xd1, 0x76, 0xca, 0x23,
+	0xf3, 0xd5, 0xe4, 0x04, 0xb4, 0x46, 0xa9, 0xfc, 0x8b, 0x43, 0xba, 0x44, 0x9c, 0x00, 0x97, 0x84,
+	0x7c, 0x75, 0xe8, 0x75, 0x2c, 0xf2, 0xd5, 0x21, 0x36, 0xea, 0xb9, 0x98, 0x45, 0x3a, 0x99, 0x25,
+	0x7a, 0x6e, 0x6f, 0x90, 0x1a, 0x42, 0x5f, 0x6a, 0x51, 0x9a, 0xcf, 0xec, 0x2d, 0xd2, 0x09, 0x4a,
+	0xd2, 0xff, 0x2f, 0x7d, 0xbf, 0x37, 0xca, 0x8a, 0x6e, 0x41, 0x2e, 0xd3, 0xea, 0x16, 0xe4, 0x32,
+	0x6d, 0xb9, 0xeb, 0x57, 0xda, 0xee, 0x7a, 0x0f, 0xd6, 0x8b, 0x7c, 0x22, 0x45, 0xa6, 0xad, 0x0f,
+	0x25, 0x89, 0x57, 0x25, 0xc6, 0x7f, 0x36, 0xb7, 0x9e, 0x58, 0x0a, 0x6f, 0x15, 0x3e, 0x99, 0xf0,
+	0x58, 0x27, 0xe7, 0x3c, 0xb4, 0x12, 0xc6, 0xb3, 0x7e, 0x85, 0x3f, 0x25, 0xd8, 0x17, 0x70, 0xfb,
+	0x98, 0xeb, 0xaa, 0xc8, 0x7f, 0xc0, 0x3a, 0x79, 0xcf, 0x0f, 0xd5, 0x3b, 0xe0, 0x56, 0x83, 0xc8,
+	0xde, 0xe1, 0x0b, 0xc0, 0x9f, 0xc0, 0xa0, 0x6d, 0x43, 0xfb, 0x10, 0x68, 0xe8, 0x3a, 0x4b, 0xba,
+	0xe8, 0x2f, 0x15, 0xb2, 0x79, 0x73, 0x3a, 0x81, 0xa5, 0x30, 0xb8, 0x45, 0x96, 0x68, 0xbb, 0x19,
+	0xad, 0xed, 0x13, 0xe1, 0x59, 0x1a, 0x4d, 0xf9, 0x2f, 0xf3, 0xe1, 0xed, 0xff, 0x06, 0xae, 0xd7,
+	0x76, 0xb0, 0x0e, 0xdc, 0x84, 0xb5, 0x84, 0x10, 0x7a, 0xc8, 0xb8, 0x81, 0xa5, 0xfc, 0x11, 0xbd,
+	0x7b, 0x7e, 0xe0, 0x52, 0xbd, 0xf7, 0x1b, 0xea, 0xca, 0x03, 0x3d, 0x84, 0x9d, 0xc6, 0x1e, 0xf6,
+	0x48, 0x03, 0xe8, 0x9e, 0x5b, 0xcc, 0x1e, 0xaa, 0xa2, 0x1f, 0xfd, 0x6f, 0x0d, 0xdc, 0x21, 0x0e,
+	0x1c, 0x9a, 0x06, 0x2f, 0x61, 0xa3, 0xfe, 0xd7, 0x81, 0xdd, 0x6f, 0x0e, 0xa3, 0x96, 0x1f, 0x15,
+	0x03, 0xff, 0x6d, 0x22, 0xf6, 0x00, 0xc7, 0xd0, 0xab, 0xe1, 0x6c, 0xef, 0x4a, 0x95, 0xd2, 0xe8,
+	0x15, 0xbf, 0x34, 0x58, 0x40, 0x86, 0xca, 0x1f, 0x02, 0x2d, 0x86, 0x96, 0xfe, 0x2e, 0x0c, 0xee,
+	0xbf, 0x45, 0xc2, 0x1e, 0xee, 0x09, 0xc0, 0x02, 0x66, 0xf7, 0xae, 0x52, 0x28, 0x2d, 0xb6, 0xff,
+	0x6a, 0x60, 0x2f, 0xc8, 0x8a, 0x7d, 0xd6, 0xb6, 0x58, 0x69, 0xbe, 0x9d, 0x07, 0x7b, 0x57, 0x0b,
+	0xd8, 0x63, 0x3d, 0x06, 0xb7, 0x42, 0xd9, 0xdd, 0x2b, 0xc4, 0x4b, 0x73, 0xad, 0xaf, 0x65, 0xf6,
+	0x17, 0xd8, 0xa8, 0xbf, 0x3e, 0x97, 0xb3, 0xd9, 0xf2, 0x32, 0x5d, 0xf6, 0xce, 0xb2, 0x0f, 0x1d,
+	0x36, 0x05, 0x76, 0xb9, 0x6b, 0xd9, 0x67, 0x97, 0xce, 0xd5, 0x3e, 0x48, 0x06, 0xfb, 0x3f, 0x2f,
+	0x68, 0xfd, 0x7e, 0x0e, 0x6e, 0xd5, 0x54, 0x2d, 0x7e, 0x37, 0xfa, 0x79, 0x70, 0xef, 0x4a, 0xbe,
+	0xb5, 0x66, 0x0a, 0xa6, 0xec, 0x88, 0x96, 0x82, 0x59, 0x6a, 0xc8, 0xc1, 0xfd, 0xb7, 0x48, 0x18,
+	0x9b, 0x47, 0xc3, 0x1f, 0x1f, 0x4f, 0x13, 0x7d, 0x56, 0x8c, 0x0e, 0x62, 0x91, 0x3e, 0x18, 0x45,
+	0xd9, 0x9b, 0x28, 0x21, 0xa5, 0x07, 0x95, 0xea, 0x83, 0xfc, 0xd5, 0x74, 0x41, 0x7d, 0x3e, 0x95,
+	0x79, 0xfc, 0x75, 0x45, 0x22, 0x35, 0x5a, 0xa3, 0x7f, 0x81, 0xbf, 0xfd, 0x69, 0x00, 0x81, 0xb6,
+	0x60, 0x60, 0x1e, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CloudInfoClient is the client API for CloudInfo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CloudInfoClient interface {
+	// GetProviders returns the supported providers with their services
+	GetProviders(ctx context.Context, in *GetProvidersRequest, opts ...grpc.CallOption) (*GetProvidersResponse, error)
+	// GetProvider returns a supported provider
+	GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*Provider, error)
+	// GetServices returns the services of a provider
+	GetServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error)
+	// GetService returns a service of a provider
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*Service, error)
+	// GetRegions returns the regions of a service
+	GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error)
+	// GetRegion returns a region of a service with its availability zones
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*Region, error)
+	// ListProducts streams the products of a region one by one, the filters work as the query parameters of the REST API
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (CloudInfo_ListProductsClient, error)
+	// GetAttributeValues returns the values of a product attribute (cpu or memory) of a service
+	GetAttributeValues(ctx context.Context, in *GetAttributeValuesRequest, opts ...grpc.CallOption) (*GetAttributeValuesResponse, error)
+	// GetImages returns the images of a service in a region
+	GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error)
+	// GetVersions returns the versions of a service in a region
+	GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*GetVersionsResponse, error)
+}
+
+type cloudInfoClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCloudInfoClient(cc *grpc.ClientConn) CloudInfoClient {
+	return &cloudInfoClient{cc}
+}
+
+func (c *cloudInfoClient) GetProviders(ctx context.Context, in *GetProvidersRequest, opts ...grpc.CallOption) (*GetProvidersResponse, error) {
+	out := new(GetProvidersResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetProvider(ctx context.Context, in *GetProviderRequest, opts ...grpc.CallOption) (*Provider, error) {
+	out := new(Provider)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error) {
+	out := new(GetServicesResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*Service, error) {
+	out := new(Service)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetRegions(ctx context.Context, in *GetRegionsRequest, opts ...grpc.CallOption) (*GetRegionsResponse, error) {
+	out := new(GetRegionsResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*Region, error) {
+	out := new(Region)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (CloudInfo_ListProductsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CloudInfo_serviceDesc.Streams[0], "/cloudinfo.v1.CloudInfo/ListProducts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cloudInfoListProductsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CloudInfo_ListProductsClient interface {
+	Recv() (*Product, error)
+	grpc.ClientStream
+}
+
+type cloudInfoListProductsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cloudInfoListProductsClient) Recv() (*Product, error) {
+	m := new(Product)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cloudInfoClient) GetAttributeValues(ctx context.Context, in *GetAttributeValuesRequest, opts ...grpc.CallOption) (*GetAttributeValuesResponse, error) {
+	out := new(GetAttributeValuesResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetAttributeValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetImages(ctx context.Context, in *GetImagesRequest, opts ...grpc.CallOption) (*GetImagesResponse, error) {
+	out := new(GetImagesResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetImages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudInfoClient) GetVersions(ctx context.Context, in *GetVersionsRequest, opts ...grpc.CallOption) (*GetVersionsResponse, error) {
+	out := new(GetVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cloudinfo.v1.CloudInfo/GetVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CloudInfoServer is the server API for CloudInfo service.
+type CloudInfoServer interface {
+	// GetProviders returns the supported providers with their services
+	GetProviders(context.Context, *GetProvidersRequest) (*GetProvidersResponse, error)
+	// GetProvider returns a supported provider
+	GetProvider(context.Context, *GetProviderRequest) (*Provider, error)
+	// GetServices returns the services of a provider
+	GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error)
+	// GetService returns a service of a provider
+	GetService(context.Context, *GetServiceRequest) (*Service, error)
+	// GetRegions returns the regions of a service
+	GetRegions(context.Context, *GetRegionsRequest) (*GetRegionsResponse, error)
+	// GetRegion returns a region of a service with its availability zones
+	GetRegion(context.Context, *GetRegionRequest) (*Region, error)
+	// ListProducts streams the products of a region one by one, the filters work as the query parameters of the REST API
+	ListProducts(*ListProductsRequest, CloudInfo_ListProductsServer) error
+	// GetAttributeValues returns the values of a product attribute (cpu or memory) of a service
+	GetAttributeValues(context.Context, *GetAttributeValuesRequest) (*GetAttributeValuesResponse, error)
+	// GetImages returns the images of a service in a region
+	GetImages(context.Context, *GetImagesRequest) (*GetImagesResponse, error)
+	// GetVersions returns the versions of a service in a region
+	GetVersions(context.Context, *GetVersionsRequest) (*GetVersionsResponse, error)
+}
+
+func RegisterCloudInfoServer(s *grpc.Server, srv CloudInfoServer) {
+	s.RegisterService(&_CloudInfo_serviceDesc, srv)
+}
+
+func _CloudInfo_GetProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetProviders(ctx, req.(*GetProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetProvider(ctx, req.(*GetProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetServices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetServices(ctx, req.(*GetServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetService",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetRegions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetRegions(ctx, req.(*GetRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_ListProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CloudInfoServer).ListProducts(m, &cloudInfoListProductsServer{stream})
+}
+
+type CloudInfo_ListProductsServer interface {
+	Send(*Product) error
+	grpc.ServerStream
+}
+
+type cloudInfoListProductsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cloudInfoListProductsServer) Send(m *Product) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CloudInfo_GetAttributeValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetAttributeValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetAttributeValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetAttributeValues(ctx, req.(*GetAttributeValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetImages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetImages(ctx, req.(*GetImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudInfo_GetVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudInfoServer).GetVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudinfo.v1.CloudInfo/GetVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudInfoServer).GetVersions(ctx, req.(*GetVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CloudInfo_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudinfo.v1.CloudInfo",
+	HandlerType: (*CloudInfoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProviders",
+			Handler:    _CloudInfo_GetProviders_Handler,
+		},
+		{
+			MethodName: "GetProvider",
+			Handler:    _CloudInfo_GetProvider_Handler,
+		},
+		{
+			MethodName: "GetServices",
+			Handler:    _CloudInfo_GetServices_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _CloudInfo_GetService_Handler,
+		},
+		{
+			MethodName: "GetRegions",
+			Handler:    _CloudInfo_GetRegions_Handler,
+		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _CloudInfo_GetRegion_Handler,
+		},
+		{
+			MethodName: "GetAttributeValues",
+			Handler:    _CloudInfo_GetAttributeValues_Handler,
+		},
+		{
+			MethodName: "GetImages",
+			Handler:    _CloudInfo_GetImages_Handler,
+		},
+		{
+			MethodName: "GetVersions",
+			Handler:    _CloudInfo_GetVersions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListProducts",
+			Handler:       _CloudInfo_ListProducts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cloudinfo.proto",
+}
@@ -0,0 +1,35 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alibaba
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// burstInfo returns the burst details of the burstable (t5, t6) instance types, nil for the rest of the instance types
+// The baseline credit reported for the instance type is its baseline performance summed over its vCPUs (a percentage of a
+// single vCPU); the burstable instance types can run in unlimited mode
+func burstInfo(vm cloudinfo.VmInfo, baselineCredit int) *cloudinfo.BurstInfo {
+	if vm.Category != cloudinfo.CategoryBurstable {
+		return nil
+	}
+	info := cloudinfo.BurstInfo{Unlimited: true}
+	if baselineCredit > 0 && vm.Cpus > 0 {
+		info.BaselineCpu = float64(baselineCredit) / vm.Cpus
+		// a cpu credit is a vCPU at full utilization for a minute
+		info.CreditsPerHour = float64(baselineCredit) * 60 / 100
+	}
+	return &info
+}
@@ -22,12 +22,17 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/spf13/viper"
 )
 
@@ -72,6 +77,18 @@ type AlibabaInfoer struct {
 	ecsClient      EcsSource
 	priceRetriever PriceRetriever
 	spotClient     func(region string) EcsSource
+	// spotWindow is the look-back window of the spot price statistics, only the current prices are retrieved if 0
+	spotWindow time.Duration
+
+	// spotHistories holds the spot price history retrieved per region, only the changes are retrieved on renewal
+	spotHistories   map[string]regionSpotHistory
+	spotHistoriesMu sync.Mutex
+}
+
+// regionSpotHistory is the spot price history of the instance types of a region as of its retrieval
+type regionSpotHistory struct {
+	histories   map[string]cloudinfo.SpotPriceHistory
+	retrievedAt time.Time
 }
 
 // EcsSource list of operations for retrieving ecs information
@@ -80,13 +97,19 @@ type EcsSource interface {
 	DescribeSpotPriceHistory(request *ecs.DescribeSpotPriceHistoryRequest) (response *ecs.DescribeSpotPriceHistoryResponse, err error)
 	DescribeZones(request *ecs.DescribeZonesRequest) (response *ecs.DescribeZonesResponse, err error)
 	DescribeRegions(request *ecs.DescribeRegionsRequest) (response *ecs.DescribeRegionsResponse, err error)
+	DescribePrice(request *ecs.DescribePriceRequest) (response *ecs.DescribePriceResponse, err error)
 }
 
-type onDemandPrice struct{}
+type onDemandPrice struct {
+	transport http.RoundTripper
+}
 
 const (
 	svcCompute = "compute"
 	svcAck     = "ack"
+
+	// spotTimeFormat is the format of the timestamps of the spot price history
+	spotTimeFormat = "2006-01-02T15:04:05Z"
 )
 
 // PriceRetriever collects on demand prices from a json file
@@ -96,13 +119,21 @@ type PriceRetriever interface {
 }
 
 // NewAlibabaInfoer creates a new instance of the Alibaba infoer
-func NewAlibabaInfoer(regionId, accessKeyId, accessKeySecret string) (*AlibabaInfoer, error) {
+// The Alibaba APIs are accessed through the given transport if it's not nil (see the recorder package)
+// The spot price history is collected over the spot window to compute the spot price statistics
+func NewAlibabaInfoer(regionId, accessKeyId, accessKeySecret string, spotWindow time.Duration, transport http.RoundTripper) (*AlibabaInfoer, error) {
+
+	config := sdk.NewConfig()
+	if transport != nil {
+		// the sdk accepts the concrete transport type only
+		config = config.WithHttpTransport(recorder.NewHTTPTransport(transport))
+	}
 
 	// Create an ECS client
-	ecsClient, err := ecs.NewClientWithAccessKey(
+	ecsClient, err := ecs.NewClientWithOptions(
 		regionId,
-		accessKeyId,
-		accessKeySecret,
+		config,
+		credentials.NewAccessKeyCredential(accessKeyId, accessKeySecret),
 	)
 
 	//ecsClient.GetConfig().WithAutoRetry(true)
@@ -117,10 +148,12 @@ func NewAlibabaInfoer(regionId, accessKeyId, accessKeySecret string) (*AlibabaIn
 
 	return &AlibabaInfoer{
 		ecsClient:      ecsClient,
-		priceRetriever: &onDemandPrice{},
+		priceRetriever: &onDemandPrice{transport: transport},
 		spotClient: func(region string) EcsSource {
 			return ecsClient
 		},
+		spotWindow:    spotWindow,
+		spotHistories: make(map[string]regionSpotHistory),
 	}, nil
 }
 
@@ -129,51 +162,103 @@ func (e *AlibabaInfoer) Initialize(ctx context.Context) (map[string]map[string]c
 	return nil, nil
 }
 
-func (e *AlibabaInfoer) getCurrentSpotPrices(ctx context.Context, region string, zones []string) (map[string]cloudinfo.SpotPriceInfo, error) {
+// getSpotPriceHistory retrieves the spot price history of the instance types in the zones of the region over the spot window
+// Without a spot window the history holds the recent prices only
+// The history of the previous retrieval is kept, only the prices changed since then are retrieved
+// The prices are given in the currency of the on demand price list, it's returned with the history
+func (e *AlibabaInfoer) getSpotPriceHistory(ctx context.Context, region string, zones []string) (map[string]cloudinfo.SpotPriceHistory, string, error) {
 	log := logger.Extract(ctx)
 	log.Debug("start retrieving spot price data")
-	priceInfo := make(map[string]cloudinfo.SpotPriceInfo)
-
-	request := ecs.CreateDescribeSpotPriceHistoryRequest()
-	request.RegionId = region
-	request.NetworkType = "vpc"
-	request.OSType = "linux"
-
-	log.Debug("created new client")
+	now := time.Now()
+	start := now.Add(-e.spotWindow)
+	histories := make(map[string]cloudinfo.SpotPriceHistory)
+
+	e.spotHistoriesMu.Lock()
+	previous, ok := e.spotHistories[region]
+	e.spotHistoriesMu.Unlock()
+	if ok && previous.retrievedAt.After(start) {
+		for instanceType, history := range previous.histories {
+			histories[instanceType] = history.Since(start)
+		}
+		start = previous.retrievedAt
+	}
 
 	dataFromJson, err := e.priceRetriever.getOnDemandPrice(viper.GetString(priceInfoUrl))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	for key := range dataFromJson.PricingInfo {
 		values := strings.Split(key, "::")
 		if values[0] == region && values[3] == "linux" {
+			request := ecs.CreateDescribeSpotPriceHistoryRequest()
+			request.RegionId = region
+			request.NetworkType = "vpc"
+			request.OSType = "linux"
 			request.InstanceType = values[1]
+			if e.spotWindow > 0 {
+				request.StartTime = start.UTC().Format(spotTimeFormat)
+			}
 
-			prices, err := e.spotClient(region).DescribeSpotPriceHistory(request)
+			prices, err := e.describeSpotPriceHistory(region, request, dataFromJson.Currency)
 			if err != nil {
 				log.WithField("region", region).WithError(err).Errorf("failed to get spot price history for instance type [%s].", values[1])
 				continue
 			}
 
-			price := priceInfo[values[1]]
-			spotPrice := make(cloudinfo.SpotPriceInfo)
-			priceTypes := prices.SpotPrices.SpotPriceType
-			for _, priceType := range priceTypes {
-				for _, z := range zones {
-					if z == priceType.ZoneId {
-						spotPrice[z] = priceType.SpotPrice
-						price = spotPrice
-						break
-					}
+			history := histories[values[1]]
+			if history == nil {
+				history = make(cloudinfo.SpotPriceHistory)
+			}
+			for _, priceType := range prices {
+				if !cloudinfo.Contains(zones, priceType.ZoneId) {
+					continue
+				}
+				timestamp, err := time.Parse(spotTimeFormat, priceType.Timestamp)
+				if err != nil {
+					log.WithField("region", region).WithError(err).Warnf("skipping the spot price of instance type [%s] in zone [%s] with an invalid timestamp: [%s]",
+						values[1], priceType.ZoneId, priceType.Timestamp)
+					continue
 				}
-				priceInfo[values[1]] = price
+				history.Add(priceType.ZoneId, timestamp, priceType.SpotPrice)
+			}
+			if len(history) > 0 {
+				histories[values[1]] = history
 			}
 		}
 	}
+
+	if e.spotWindow > 0 {
+		e.spotHistoriesMu.Lock()
+		e.spotHistories[region] = regionSpotHistory{histories: histories, retrievedAt: now}
+		e.spotHistoriesMu.Unlock()
+	}
 	log.WithField("region", region).Debug("finished retrieving spot price data")
-	return priceInfo, nil
+	return histories, dataFromJson.Currency, nil
+}
+
+// describeSpotPriceHistory retrieves every page of the spot price history described by the request
+// The history is rejected if it's given in another currency than the on demand prices
+func (e *AlibabaInfoer) describeSpotPriceHistory(region string, request *ecs.DescribeSpotPriceHistoryRequest, currency string) ([]ecs.SpotPriceType, error) {
+	var prices []ecs.SpotPriceType
+	offset := 0
+	for {
+		response, err := e.spotClient(region).DescribeSpotPriceHistory(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Currency != currency {
+			return nil, fmt.Errorf("the spot prices are given in [%s] instead of [%s]", response.Currency, currency)
+		}
+		prices = append(prices, response.SpotPrices.SpotPriceType...)
+
+		// the history is exhausted if the next offset doesn't move forward
+		if len(response.SpotPrices.SpotPriceType) == 0 || response.NextOffset <= offset {
+			return prices, nil
+		}
+		offset = response.NextOffset
+		request.Offset = requests.NewInteger(offset)
+	}
 }
 
 // GetAttributeValues gets the AttributeValues for the given attribute name
@@ -233,6 +318,20 @@ func (e *AlibabaInfoer) GetAttributeValues(ctx context.Context, service, attribu
 	return values, nil
 }
 
+// localDiskType maps the local storage category of an instance type (eg.: local_ssd_pro, local_hdd_pro) to a disk type
+func localDiskType(category string) string {
+	switch {
+	case strings.Contains(category, "nvme"):
+		return cloudinfo.DiskTypeNvme
+	case strings.Contains(category, "ssd"):
+		return cloudinfo.DiskTypeSsd
+	case strings.Contains(category, "hdd"):
+		return cloudinfo.DiskTypeHdd
+	default:
+		return ""
+	}
+}
+
 // GetProducts retrieves the available virtual machines based on the arguments provided
 func (e *AlibabaInfoer) GetProducts(ctx context.Context, service, regionId string) ([]cloudinfo.VmInfo, error) {
 	log := logger.Extract(ctx)
@@ -259,6 +358,22 @@ func (e *AlibabaInfoer) GetProducts(ctx context.Context, service, regionId strin
 		return nil, err
 	}
 
+	// the price list holds the license included windows prices next to the linux ones
+	windowsPrices := make(map[string]float64)
+	for key, prices := range dataFromJson.PricingInfo {
+		values := strings.Split(key, "::")
+		if values[0] != regionId || values[3] != "windows" {
+			continue
+		}
+		for _, price := range prices.Hours {
+			if price.Period == "1" {
+				if windowsPrice, err := strconv.ParseFloat(price.Price, 64); err == nil {
+					windowsPrices[values[1]] = windowsPrice
+				}
+			}
+		}
+	}
+
 	instanceTypes := vmSizes.InstanceTypes.InstanceType
 	for _, instanceType := range instanceTypes {
 		for key, prices := range dataFromJson.PricingInfo {
@@ -275,28 +390,48 @@ func (e *AlibabaInfoer) GetProducts(ctx context.Context, service, regionId strin
 								}
 							}
 						}
-						ntwMapper := newAlibabaNetworkMapper()
-						ntwPerf := fmt.Sprintf("%.1f Gbit/s", float64(instanceType.InstanceBandwidthRx)/1024000)
-						ntwPerfCat, err := ntwMapper.MapNetworkPerf(ntwPerf)
-						if err != nil {
-							log.WithError(err).Debug("could not get network performance category")
-						}
+						// the bandwidth is reported in Kbps
+						bandwidth := float64(instanceType.InstanceBandwidthRx) / 1024000
+						ntwPerf := fmt.Sprintf("%.1f Gbit/s", bandwidth)
 
 						onDemandPrice, err := strconv.ParseFloat(price.Price, 64)
 						if err != nil {
 							return nil, err
 						}
-						vms = append(vms, cloudinfo.VmInfo{
+						var osPrices cloudinfo.OsPrices
+						if windowsPrice, ok := windowsPrices[instanceType.InstanceTypeId]; ok {
+							osPrices = cloudinfo.OsPrices{
+								cloudinfo.OsLinux:   onDemandPrice,
+								cloudinfo.OsWindows: windowsPrice,
+							}
+						}
+						vm := cloudinfo.VmInfo{
 							Type:          instanceType.InstanceTypeId,
 							OnDemandPrice: onDemandPrice,
 							Cpus:          float64(instanceType.CpuCoreCount),
 							Mem:           instanceType.MemorySize,
 							Gpus:          float64(instanceType.GPUAmount),
 							NtwPerf:       ntwPerf,
-							NtwPerfCat:    ntwPerfCat,
+							Bandwidth:     bandwidth,
 							Zones:         zones,
-							Attributes:    cloudinfo.Attributes(fmt.Sprint(instanceType.CpuCoreCount), fmt.Sprint(instanceType.MemorySize), ntwPerfCat),
-						})
+							Attributes:    cloudinfo.Attributes(fmt.Sprint(instanceType.CpuCoreCount), fmt.Sprint(instanceType.MemorySize)),
+							OsPrices:      osPrices,
+							Currency:      dataFromJson.Currency,
+							GpuModel:      instanceType.GPUSpec,
+							LocalDisks:    instanceType.LocalStorageAmount,
+							LocalDiskSize: float64(instanceType.LocalStorageCapacity),
+							LocalDiskType: localDiskType(instanceType.LocalStorageCategory),
+							MaxNics:       instanceType.EniQuantity,
+							// the architecture, the hypervisor and the disk support of the instance types are not
+							// published by the ECS API: every io optimized instance type is x86_64 on kvm and supports
+							// enhanced ssd and ssd cloud disks
+							Arch:           cloudinfo.ArchX86,
+							PremiumStorage: true,
+							Hypervisor:     "kvm",
+						}
+						vm.Classify(taxonomyRules)
+						vm.BurstInfo = burstInfo(vm, instanceType.BaselineCredit)
+						vms = append(vms, vm)
 					}
 				}
 			}
@@ -349,8 +484,6 @@ func (e *AlibabaInfoer) HasShortLivedPriceInfo() bool {
 // GetCurrentPrices returns the current spot prices of every instance type in every availability zone in a given region
 func (e *AlibabaInfoer) GetCurrentPrices(ctx context.Context, region string) (map[string]cloudinfo.Price, error) {
 	log := logger.Extract(ctx)
-	var spotPrices map[string]cloudinfo.SpotPriceInfo
-	var err error
 
 	zones, err := e.GetZones(ctx, region)
 	if err != nil {
@@ -358,18 +491,25 @@ func (e *AlibabaInfoer) GetCurrentPrices(ctx context.Context, region string) (ma
 	}
 
 	log.WithField("region", region).Debug("getting current spot prices directly from the API")
-	spotPrices, err = e.getCurrentSpotPrices(ctx, region, zones)
+	histories, currency, err := e.getSpotPriceHistory(ctx, region, zones)
 	if err != nil {
 		log.WithField("region", region).WithError(err).Error("could not retrieve current prices.")
 		return nil, err
 	}
 
+	now := time.Now()
 	prices := make(map[string]cloudinfo.Price)
-	for instanceType, sp := range spotPrices {
-		prices[instanceType] = cloudinfo.Price{
+	for instanceType, history := range histories {
+		sp := history.Latest()
+		p := cloudinfo.Price{
 			SpotPrice:     sp,
 			OnDemandPrice: -1,
+			Currency:      currency,
+		}
+		if e.spotWindow > 0 {
+			p.SpotStats = history.Stats(now.Add(-e.spotWindow), now)
 		}
+		prices[instanceType] = p
 		for zone, price := range sp {
 			SpotPriceGauge.WithLabelValues(region, zone, instanceType).Set(price)
 		}
@@ -389,7 +529,7 @@ func (e *AlibabaInfoer) GetCpuAttrName() string {
 }
 
 func (p *onDemandPrice) getOnDemandPrice(url string) (OnDemandPrice, error) {
-	var myClient = &http.Client{Timeout: 10 * time.Second}
+	var myClient = &http.Client{Timeout: 10 * time.Second, Transport: p.transport}
 	var dataFromJson OnDemandPrice
 	r, err := myClient.Get(url)
 	if err != nil {
@@ -409,7 +549,7 @@ func (p *onDemandPrice) getOnDemandPrice(url string) (OnDemandPrice, error) {
 func (e *AlibabaInfoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
 	services := []cloudinfo.ServiceDescriber{
 		cloudinfo.NewService(svcCompute),
-		cloudinfo.NewService(svcAck)}
+		cloudinfo.NewKubernetesService(svcAck, cloudinfo.NewFreeControlPlane())}
 	return services, nil
 }
 
@@ -425,7 +565,7 @@ func (e *AlibabaInfoer) GetService(ctx context.Context, service string) (cloudin
 			return sd, nil
 		}
 	}
-	return nil, fmt.Errorf("the service [%s] is not supported", service)
+	return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
 }
 
 // HasImages - Alibaba doesn't support images
@@ -19,8 +19,11 @@ import (
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 //testStruct helps to mock external calls
@@ -34,6 +37,8 @@ const (
 	GetZonesError            = "could not get zones"
 	GetUrlError              = "could not get url"
 	GetSpotPriceHistoryError = "could not get spot price"
+	GetDiskPriceError        = "could not get disk price"
+	OtherSpotPriceCurrency   = "CNY"
 )
 
 func (dps *testStruct) DescribeInstanceTypes(request *ecs.DescribeInstanceTypesRequest) (response *ecs.DescribeInstanceTypesResponse, err error) {
@@ -75,32 +80,63 @@ func (dps *testStruct) DescribeInstanceTypes(request *ecs.DescribeInstanceTypesR
 }
 
 func (dps *testStruct) DescribeSpotPriceHistory(request *ecs.DescribeSpotPriceHistoryRequest) (response *ecs.DescribeSpotPriceHistoryResponse, err error) {
-	switch dps.TcId {
-	case GetSpotPriceHistoryError:
+	if dps.TcId == GetSpotPriceHistoryError {
 		return &ecs.DescribeSpotPriceHistoryResponse{}, fmt.Errorf(GetSpotPriceHistoryError)
-	default:
+	}
+	if dps.TcId == OtherSpotPriceCurrency {
+		return &ecs.DescribeSpotPriceHistoryResponse{Currency: OtherSpotPriceCurrency}, nil
+	}
+	if request.Offset == "2" {
+		// the last page, a point with an invalid timestamp is skipped
 		return &ecs.DescribeSpotPriceHistoryResponse{
-			Currency: "USD",
+			Currency:   "USD",
+			NextOffset: 2,
 			SpotPrices: ecs.SpotPrices{
 				SpotPriceType: []ecs.SpotPriceType{
 					{
-						InstanceType: "ecs.g5.2xlarge",
-						ZoneId:       "dummyZone",
+						InstanceType: "ecs.sn2ne.8xlarge",
+						ZoneId:       "us-east-1a",
 						NetworkType:  "vpc",
-						OriginPrice:  1.435,
-						SpotPrice:    0.652,
+						OriginPrice:  2.243,
+						SpotPrice:    0.985,
+						Timestamp:    "2018-11-20T10:00:00Z",
 					},
 					{
 						InstanceType: "ecs.sn2ne.8xlarge",
 						ZoneId:       "us-east-1b",
 						NetworkType:  "vpc",
 						OriginPrice:  2.243,
-						SpotPrice:    1.021,
+						SpotPrice:    5.0,
+						Timestamp:    "invalid",
 					},
 				},
 			},
 		}, nil
 	}
+	return &ecs.DescribeSpotPriceHistoryResponse{
+		Currency:   "USD",
+		NextOffset: 2,
+		SpotPrices: ecs.SpotPrices{
+			SpotPriceType: []ecs.SpotPriceType{
+				{
+					InstanceType: "ecs.g5.2xlarge",
+					ZoneId:       "dummyZone",
+					NetworkType:  "vpc",
+					OriginPrice:  1.435,
+					SpotPrice:    0.652,
+					Timestamp:    "2018-11-20T10:00:00Z",
+				},
+				{
+					InstanceType: "ecs.sn2ne.8xlarge",
+					ZoneId:       "us-east-1b",
+					NetworkType:  "vpc",
+					OriginPrice:  2.243,
+					SpotPrice:    1.021,
+					Timestamp:    "2018-11-20T10:00:00Z",
+				},
+			},
+		},
+	}, nil
 }
 
 func (dps *testStruct) DescribeZones(request *ecs.DescribeZonesRequest) (response *ecs.DescribeZonesResponse, err error) {
@@ -155,6 +191,23 @@ func (dps *testStruct) DescribeRegions(request *ecs.DescribeRegionsRequest) (res
 	}
 }
 
+func (dps *testStruct) DescribePrice(request *ecs.DescribePriceRequest) (response *ecs.DescribePriceResponse, err error) {
+	switch {
+	case dps.TcId == GetDiskPriceError:
+		return &ecs.DescribePriceResponse{}, fmt.Errorf(GetDiskPriceError)
+	case request.DataDisk1Category == "cloud":
+		return &ecs.DescribePriceResponse{}, fmt.Errorf("disk category not available in the region")
+	default:
+		response = &ecs.DescribePriceResponse{}
+		response.PriceInfo.Price.Currency = "USD"
+		response.PriceInfo.Price.TradePrice = 5.0
+		if request.DataDisk1Category == "cloud_ssd" {
+			response.PriceInfo.Price.TradePrice = 14.0
+		}
+		return response, nil
+	}
+}
+
 func (dps *testStruct) getOnDemandPrice(url string) (OnDemandPrice, error) {
 	switch dps.TcId {
 	case GetUrlError:
@@ -171,6 +224,14 @@ func (dps *testStruct) getOnDemandPrice(url string) (OnDemandPrice, error) {
 						},
 					},
 				},
+				"us-east-1::ecs.g5.2xlarge::vpc::windows::optimized": {
+					Hours: []Price{
+						{
+							Price:  "0.42",
+							Period: "1",
+						},
+					},
+				},
 				"us-east-1::ecs.sn2ne.8xlarge::vpc::linux::optimized": {
 					Hours: []Price{
 						{
@@ -217,7 +278,7 @@ func TestAlibabaInfoer_GetRegions(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewAlibabaInfoer("", "", "")
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
 			// override pricingSvc
 			cloudInfoer.ecsClient = test.client
 			if err != nil {
@@ -257,7 +318,7 @@ func TestAlibabaInfoer_GetZones(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewAlibabaInfoer("", "", "")
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
 			// override pricingSvc
 			cloudInfoer.ecsClient = test.client
 			if err != nil {
@@ -285,6 +346,14 @@ func TestAlibabaInfoer_GetProducts(t *testing.T) {
 			check: func(vms []cloudinfo.VmInfo, err error) {
 				assert.Nil(t, err, "the error should be nil")
 				assert.Equal(t, 2, len(vms))
+				for _, vm := range vms {
+					assert.Equal(t, "USD", vm.Currency, "the currency of the price list should be kept")
+					if vm.Type == "ecs.g5.2xlarge" {
+						assert.Equal(t, cloudinfo.OsPrices{cloudinfo.OsLinux: 0.336, cloudinfo.OsWindows: 0.42}, vm.OsPrices)
+					} else {
+						assert.Nil(t, vm.OsPrices, "only linux prices are published for [%s]", vm.Type)
+					}
+				}
 			},
 		},
 		{
@@ -310,7 +379,7 @@ func TestAlibabaInfoer_GetProducts(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewAlibabaInfoer("", "", "")
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
 			// override pricingSvc
 			cloudInfoer.ecsClient = test.ecsClient
 			cloudInfoer.priceRetriever = test.priceRetriever
@@ -394,7 +463,7 @@ func TestAlibabaInfoer_GetAttributeValues(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewAlibabaInfoer("", "", "")
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
 			// override pricingSvc
 			cloudInfoer.ecsClient = test.ecsClient
 			cloudInfoer.priceRetriever = test.priceRetriever
@@ -425,6 +494,21 @@ func TestAlibabaInfoer_GetCurrentPrices(t *testing.T) {
 			check: func(prices map[string]cloudinfo.Price, err error) {
 				assert.Nil(t, err, "the error should be nil")
 				assert.Equal(t, 2, len(prices))
+				for _, price := range prices {
+					assert.Equal(t, "USD", price.Currency, "the currency of the spot prices should be kept")
+				}
+			},
+		},
+		{
+			name:           "spot prices in another currency than the price list",
+			ecsClient:      &testStruct{},
+			priceRetriever: &testStruct{},
+			spotClient: func(region string) EcsSource {
+				return &testStruct{OtherSpotPriceCurrency}
+			},
+			check: func(prices map[string]cloudinfo.Price, err error) {
+				assert.Nil(t, err, "the err should be nil")
+				assert.Empty(t, prices, "the spot prices in another currency should be rejected")
 			},
 		},
 		{
@@ -466,7 +550,7 @@ func TestAlibabaInfoer_GetCurrentPrices(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewAlibabaInfoer("", "", "")
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
 			// override pricingSvc
 			cloudInfoer.ecsClient = test.ecsClient
 			cloudInfoer.priceRetriever = test.priceRetriever
@@ -479,3 +563,168 @@ func TestAlibabaInfoer_GetCurrentPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestAlibabaInfoer_GetCurrentPrices_spotStats(t *testing.T) {
+	cloudInfoer, err := NewAlibabaInfoer("", "", "", 24*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	cloudInfoer.ecsClient = &testStruct{}
+	cloudInfoer.priceRetriever = &testStruct{}
+	cloudInfoer.spotClient = func(region string) EcsSource {
+		return &testStruct{}
+	}
+
+	prices, err := cloudInfoer.GetCurrentPrices(context.TODO(), "us-east-1")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, cloudinfo.SpotPriceInfo{"us-east-1a": 0.985, "us-east-1b": 1.021}, prices["ecs.sn2ne.8xlarge"].SpotPrice,
+		"every page of the history should be retrieved")
+	assert.Equal(t, map[string]cloudinfo.SpotStats{
+		"us-east-1a": {Min: 0.985, Max: 0.985, Mean: 0.985, P50: 0.985, P90: 0.985, Samples: 1},
+		"us-east-1b": {Min: 1.021, Max: 1.021, Mean: 1.021, P50: 1.021, P90: 1.021, Samples: 1},
+	}, prices["ecs.sn2ne.8xlarge"].SpotStats)
+}
+
+// spotHistorySpy records the start time of the spot price history requests
+type spotHistorySpy struct {
+	testStruct
+	startTimes []string
+}
+
+func (s *spotHistorySpy) DescribeSpotPriceHistory(request *ecs.DescribeSpotPriceHistoryRequest) (*ecs.DescribeSpotPriceHistoryResponse, error) {
+	s.startTimes = append(s.startTimes, request.StartTime)
+	return s.testStruct.DescribeSpotPriceHistory(request)
+}
+
+func TestAlibabaInfoer_getSpotPriceHistory_renewal(t *testing.T) {
+	cloudInfoer, err := NewAlibabaInfoer("", "", "", 24*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	cloudInfoer.priceRetriever = &testStruct{}
+	spy := &spotHistorySpy{}
+	cloudInfoer.spotClient = func(region string) EcsSource {
+		return spy
+	}
+
+	_, _, err = cloudInfoer.getSpotPriceHistory(context.TODO(), "us-east-1", []string{"us-east-1a", "us-east-1b"})
+	assert.Nil(t, err, "the error should be nil")
+	retrievedAt := cloudInfoer.spotHistories["us-east-1"].retrievedAt
+	assert.False(t, retrievedAt.IsZero(), "the history should be kept")
+
+	spy.startTimes = nil
+	spy.TcId = GetSpotPriceHistoryError
+	histories, _, err := cloudInfoer.getSpotPriceHistory(context.TODO(), "us-east-1", []string{"us-east-1a", "us-east-1b"})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, cloudinfo.SpotPriceInfo{"us-east-1a": 0.985, "us-east-1b": 1.021}, histories["ecs.sn2ne.8xlarge"].Latest(),
+		"the history of the previous retrieval should be kept")
+	if assert.NotEmpty(t, spy.startTimes) {
+		assert.Equal(t, retrievedAt.UTC().Format(spotTimeFormat), spy.startTimes[0], "only the changes should be retrieved")
+	}
+}
+
+func TestAlibabaInfoer_GetStorage(t *testing.T) {
+	tests := []struct {
+		name      string
+		ecsClient EcsSource
+		check     func(storage []cloudinfo.StorageInfo, err error)
+	}{
+		{
+			name:      "disk prices are spread over the size of the disk",
+			ecsClient: &testStruct{},
+			check: func(storage []cloudinfo.StorageInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, 3, len(storage), "unavailable disk categories should be skipped")
+				for _, s := range storage {
+					assert.Equal(t, "USD", s.Currency)
+					if s.Type == "cloud_ssd" {
+						assert.Equal(t, cloudinfo.DiskTypeSsd, s.Category)
+						assert.Equal(t, 0.14, s.PricePerGbMonth)
+					}
+				}
+			},
+		},
+		{
+			name:      "error - DescribePrice",
+			ecsClient: &testStruct{TcId: GetDiskPriceError},
+			check: func(storage []cloudinfo.StorageInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Empty(t, storage)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
+			if err != nil {
+				t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+			}
+			cloudInfoer.ecsClient = test.ecsClient
+			test.check(cloudInfoer.GetStorage(context.TODO(), "us-east-1"))
+		})
+	}
+}
+
+func TestAlibabaInfoer_Conformance(t *testing.T) {
+	cloudInfoer, err := NewAlibabaInfoer("", "", "", 0, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	// override the external calls
+	cloudInfoer.ecsClient = &testStruct{}
+	cloudInfoer.priceRetriever = &testStruct{}
+	cloudInfoer.spotClient = func(region string) EcsSource {
+		return &testStruct{}
+	}
+
+	cloudinfotest.RunConformance(t, cloudInfoer, cloudinfotest.Options{
+		Regions: []string{"us-east-1"},
+	})
+}
+
+func TestOnDemandPrice_getOnDemandPrice(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	tests := []struct {
+		name  string
+		url   string
+		check func(prices OnDemandPrice, err error)
+	}{
+		{
+			name: "retrieve the recorded price info",
+			url:  "https://g.alicdn.com/aliyun/ecs-price-info-intl/2.0.8/price/download/instancePrice.json",
+			check: func(prices OnDemandPrice, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, "USD", prices.Currency)
+				assert.Equal(t, 2, len(prices.PricingInfo))
+				assert.Equal(t, "0.101", prices.PricingInfo["eu-central-1::ecs.g5.large::vpc::linux::optimized"].Hours[0].Price)
+			},
+		},
+		{
+			name: "could not retrieve price info",
+			url:  "https://g.alicdn.com/aliyun/ecs-price-info-intl/1.0.0/price/download/instancePrice.json",
+			check: func(prices OnDemandPrice, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			retriever := &onDemandPrice{transport: replayer}
+			test.check(retriever.getOnDemandPrice(test.url))
+		})
+	}
+}
+
+func TestBurstInfo(t *testing.T) {
+	t5 := cloudinfo.VmInfo{Type: "ecs.t5-c1m1.large", Cpus: 2}
+	t5.Classify(taxonomyRules)
+	assert.Equal(t, &cloudinfo.BurstInfo{BaselineCpu: 15, CreditsPerHour: 18, Unlimited: true}, burstInfo(t5, 30))
+	assert.Equal(t, &cloudinfo.BurstInfo{Unlimited: true}, burstInfo(t5, 0), "the baseline is not known without baseline credit")
+
+	g5 := cloudinfo.VmInfo{Type: "ecs.g5.large", Cpus: 2}
+	g5.Classify(taxonomyRules)
+	assert.Nil(t, burstInfo(g5, 0), "only the t families are burstable")
+}
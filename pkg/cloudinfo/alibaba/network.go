@@ -12,26 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package azure
+package alibaba
 
 import (
-	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
-)
+	"context"
 
-var (
-	// TODO
-	ntwPerfMap = map[string][]string{
-		cloudinfo.NTW_LOW:    {"Low"},
-		cloudinfo.NTW_MEDIUM: {"Moderate"},
-		cloudinfo.NTW_HIGH:   {""},
-	}
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
 )
 
-// AzureNetworkMapper module object for handling Azure specific VM to Networking capabilities mapping
-type AzureNetworkMapper struct {
-}
-
-// MapNetworkPerf maps the network performance of the azure instance to the category supported by telescopes
-func (nm *AzureNetworkMapper) MapNetworkPerf(ntwPerf string) (string, error) {
-	return ntwPerfMap[cloudinfo.NTW_MEDIUM][0], nil
+// GetNetworkPrices returns no network prices, the load balancers and the data transfer are not priced for alibaba yet
+func (e *AlibabaInfoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	return cloudinfo.NetworkPrices{Currency: cloudinfo.CurrencyUSD}, nil
 }
@@ -0,0 +1,75 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alibaba
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// diskCategory describes a cloud disk category
+type diskCategory struct {
+	name     string
+	category string
+	minSize  float64
+	maxSize  float64
+}
+
+// diskCategories lists the cloud disk categories the prices are queried for
+var diskCategories = []diskCategory{
+	{name: "cloud", category: cloudinfo.DiskTypeHdd, minSize: 5, maxSize: 2000},
+	{name: "cloud_efficiency", category: cloudinfo.DiskTypeHdd, minSize: 20, maxSize: 32768},
+	{name: "cloud_essd", category: cloudinfo.DiskTypeSsd, minSize: 20, maxSize: 32768},
+	{name: "cloud_ssd", category: cloudinfo.DiskTypeSsd, minSize: 20, maxSize: 32768},
+}
+
+// priceDiskSize is the size of the disk the monthly prices are queried for, in GiB
+const priceDiskSize = 100
+
+// GetStorage retrieves the cloud disk categories and their prices in the given region
+// The price of a disk is queried for every category, categories not offered in the region are skipped
+func (e *AlibabaInfoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	log := logger.Extract(ctx)
+
+	var storage []cloudinfo.StorageInfo
+	for _, dc := range diskCategories {
+		request := ecs.CreateDescribePriceRequest()
+		request.RegionId = region
+		request.ResourceType = "disk"
+		request.DataDisk1Category = dc.name
+		request.DataDisk1Size = requests.NewInteger(priceDiskSize)
+		request.PriceUnit = "Month"
+
+		response, err := e.ecsClient.DescribePrice(request)
+		if err != nil {
+			log.WithField("region", region).WithError(err).Debugf("could not get the price of disk category [%s]", dc.name)
+			continue
+		}
+
+		storage = append(storage, cloudinfo.StorageInfo{
+			Type:            dc.name,
+			Category:        dc.category,
+			PricePerGbMonth: response.PriceInfo.Price.TradePrice / priceDiskSize,
+			MinSize:         dc.minSize,
+			MaxSize:         dc.maxSize,
+			Currency:        response.PriceInfo.Price.Currency,
+		})
+	}
+	return storage, nil
+}
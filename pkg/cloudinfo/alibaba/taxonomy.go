@@ -0,0 +1,41 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alibaba
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// taxonomyRules classifies the instance types by their family, eg.: ecs.g5.large is the large size of the 5th generation
+// general purpose g5 family; the burstable t5 types carry their baseline in the family name (eg.: ecs.t5-lc1m2.small)
+var taxonomyRules = []cloudinfo.TaxonomyRule{
+	familyRule("t", cloudinfo.CategoryBurstable),
+	// the first generation of the sn family is compute optimized, the second one is general purpose
+	cloudinfo.NewTaxonomyRule(`^ecs\.(?P<family>sn(?P<generation>1)[a-z]*)\.(?P<size>[a-z0-9-]+)$`, "${family}", cloudinfo.CategoryCompute),
+	familyRule("g|sn|hfg|n|mn|xn|ebmg|ebmhfg", cloudinfo.CategoryGeneral),
+	familyRule("c|hfc|ic|ebmc|ebmhfc", cloudinfo.CategoryCompute),
+	familyRule("r|re|e|ebmr|se", cloudinfo.CategoryMemory),
+	familyRule("d|i", cloudinfo.CategoryStorage),
+	familyRule("gn|vgn|f|ga|ebmgn", cloudinfo.CategoryAccelerated),
+}
+
+// familyRule creates the rule of the given families
+func familyRule(families, category string) cloudinfo.TaxonomyRule {
+	return cloudinfo.NewTaxonomyRule(
+		fmt.Sprintf(`^ecs\.(?P<family>(?:%s)(?P<generation>[0-9]+)[a-z]*)(?:-[a-z0-9]+)?\.(?P<size>[a-z0-9-]+)$`, families),
+		"${family}", category)
+}
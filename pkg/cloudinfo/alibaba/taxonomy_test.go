@@ -0,0 +1,77 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alibaba
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyRules(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		expected     cloudinfo.VmInfo
+	}{
+		{
+			instanceType: "ecs.g5.large",
+			expected:     cloudinfo.VmInfo{Type: "ecs.g5.large", Category: cloudinfo.CategoryGeneral, Family: "g5", Generation: 5, Size: "large"},
+		},
+		{
+			instanceType: "ecs.sn2ne.xlarge",
+			expected:     cloudinfo.VmInfo{Type: "ecs.sn2ne.xlarge", Category: cloudinfo.CategoryGeneral, Family: "sn2ne", Generation: 2, Size: "xlarge"},
+		},
+		{
+			instanceType: "ecs.sn1ne.2xlarge",
+			expected:     cloudinfo.VmInfo{Type: "ecs.sn1ne.2xlarge", Category: cloudinfo.CategoryCompute, Family: "sn1ne", Generation: 1, Size: "2xlarge"},
+		},
+		{
+			instanceType: "ecs.hfc5.large",
+			expected:     cloudinfo.VmInfo{Type: "ecs.hfc5.large", Category: cloudinfo.CategoryCompute, Family: "hfc5", Generation: 5, Size: "large"},
+		},
+		{
+			instanceType: "ecs.t5-lc1m2.small",
+			expected:     cloudinfo.VmInfo{Type: "ecs.t5-lc1m2.small", Category: cloudinfo.CategoryBurstable, Family: "t5", Generation: 5, Size: "small"},
+		},
+		{
+			instanceType: "ecs.se1ne.large",
+			expected:     cloudinfo.VmInfo{Type: "ecs.se1ne.large", Category: cloudinfo.CategoryMemory, Family: "se1ne", Generation: 1, Size: "large"},
+		},
+		{
+			instanceType: "ecs.r5.8xlarge",
+			expected:     cloudinfo.VmInfo{Type: "ecs.r5.8xlarge", Category: cloudinfo.CategoryMemory, Family: "r5", Generation: 5, Size: "8xlarge"},
+		},
+		{
+			instanceType: "ecs.d1ne.4xlarge",
+			expected:     cloudinfo.VmInfo{Type: "ecs.d1ne.4xlarge", Category: cloudinfo.CategoryStorage, Family: "d1ne", Generation: 1, Size: "4xlarge"},
+		},
+		{
+			instanceType: "ecs.gn5i-c8g1.2xlarge",
+			expected:     cloudinfo.VmInfo{Type: "ecs.gn5i-c8g1.2xlarge", Category: cloudinfo.CategoryAccelerated, Family: "gn5i", Generation: 5, Size: "2xlarge"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			assert.True(t, vm.Classify(taxonomyRules), "the instance type should be classified")
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+
+	vm := cloudinfo.VmInfo{Type: "ecs.unknown.large"}
+	assert.False(t, vm.Classify(taxonomyRules), "unknown instance types should not be classified")
+	assert.Equal(t, "", vm.Category)
+}
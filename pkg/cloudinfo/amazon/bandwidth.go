@@ -0,0 +1,37 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// ntwPerfLevels holds the typical bandwidth (Gbps) of the named network performance levels of the older instance types,
+// amazon doesn't publish numbers for them
+var ntwPerfLevels = map[string]float64{
+	"Very Low":        0.05,
+	"Low":             0.1,
+	"Low to Moderate": 0.3,
+	"Moderate":        0.5,
+	"High":            1,
+}
+
+// bandwidth returns the bandwidth (Gbps) of the network performance of an instance type and whether it's a burst value
+func bandwidth(ntwPerf string) (float64, bool, error) {
+	if b, ok := ntwPerfLevels[ntwPerf]; ok {
+		return b, false, nil
+	}
+	return cloudinfo.ParseBandwidth(ntwPerf)
+}
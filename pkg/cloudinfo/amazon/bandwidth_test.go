@@ -0,0 +1,65 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+func TestBandwidth(t *testing.T) {
+	tests := []struct {
+		ntwPerf   string
+		bandwidth float64
+		upTo      bool
+	}{
+		{ntwPerf: "Very Low", bandwidth: 0.05},
+		{ntwPerf: "High", bandwidth: 1},
+		{ntwPerf: "10 Gigabit", bandwidth: 10},
+		{ntwPerf: "Up to 25 Gigabit", bandwidth: 25, upTo: true},
+	}
+	for _, test := range tests {
+		t.Run(test.ntwPerf, func(t *testing.T) {
+			bandwidth, upTo, err := bandwidth(test.ntwPerf)
+			assert.Nil(t, err, "the error should be nil")
+			assert.Equal(t, test.bandwidth, bandwidth)
+			assert.Equal(t, test.upTo, upTo)
+		})
+	}
+
+	_, _, err := bandwidth("NA")
+	assert.EqualError(t, err, "could not parse network performance: [NA]")
+}
+
+func TestBandwidth_defaultCategories(t *testing.T) {
+	// the categories of the network performance levels before they were derived from the bandwidth
+	categories := map[string][]string{
+		cloudinfo.NTW_LOW:    {"Very Low", "Low", "Low to Moderate"},
+		cloudinfo.NTW_MEDIUM: {"Moderate", "High"},
+		cloudinfo.NTW_HIGH:   {"Up to 10 Gigabit", "10 Gigabit"},
+		cloudinfo.NTW_EXTRA:  {"20 Gigabit", "25 Gigabit"},
+	}
+	bounds := cloudinfo.DefaultNetworkCategories().For("amazon")
+	for category, ntwPerfs := range categories {
+		for _, ntwPerf := range ntwPerfs {
+			bandwidth, _, err := bandwidth(ntwPerf)
+			assert.Nil(t, err, "the error should be nil")
+			assert.Equal(t, category, bounds.Category(bandwidth), "network performance: %s", ntwPerf)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+var (
+	// t2Credits holds the baseline performance (per vCPU) and the cpu credits earned per hour of the t2 sizes
+	t2Credits = map[string]cloudinfo.BurstInfo{
+		"nano":    {BaselineCpu: 5, CreditsPerHour: 3},
+		"micro":   {BaselineCpu: 10, CreditsPerHour: 6},
+		"small":   {BaselineCpu: 20, CreditsPerHour: 12},
+		"medium":  {BaselineCpu: 20, CreditsPerHour: 24},
+		"large":   {BaselineCpu: 30, CreditsPerHour: 36},
+		"xlarge":  {BaselineCpu: 22.5, CreditsPerHour: 54},
+		"2xlarge": {BaselineCpu: 17, CreditsPerHour: 81.6},
+	}
+
+	// t3Credits holds the baseline performance (per vCPU) and the cpu credits earned per hour of the t3, t3a and t4g sizes
+	t3Credits = map[string]cloudinfo.BurstInfo{
+		"nano":    {BaselineCpu: 5, CreditsPerHour: 6},
+		"micro":   {BaselineCpu: 10, CreditsPerHour: 12},
+		"small":   {BaselineCpu: 20, CreditsPerHour: 24},
+		"medium":  {BaselineCpu: 20, CreditsPerHour: 24},
+		"large":   {BaselineCpu: 30, CreditsPerHour: 36},
+		"xlarge":  {BaselineCpu: 40, CreditsPerHour: 96},
+		"2xlarge": {BaselineCpu: 40, CreditsPerHour: 192},
+	}
+
+	// familyCredits holds the cpu credit tables of the burstable performance families
+	familyCredits = map[string]map[string]cloudinfo.BurstInfo{
+		"t2":  t2Credits,
+		"t3":  t3Credits,
+		"t3a": t3Credits,
+		"t4g": t3Credits,
+	}
+)
+
+// burstInfo returns the burst details of the burstable performance instance types, nil for the rest of the instance types
+// Every burstable instance type with cpu credits can run in unlimited mode (the t3 families do by default)
+func burstInfo(vm cloudinfo.VmInfo) *cloudinfo.BurstInfo {
+	if vm.Category != cloudinfo.CategoryBurstable {
+		return nil
+	}
+	info, ok := familyCredits[vm.Family][vm.Size]
+	if !ok {
+		// the previous generation t1.micro has no published baseline
+		return &cloudinfo.BurstInfo{}
+	}
+	info.Unlimited = true
+	return &info
+}
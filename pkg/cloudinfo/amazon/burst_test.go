@@ -15,42 +15,39 @@
 package amazon
 
 import (
+	"testing"
+
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
-func TestEc2NetworkMapper_MapNetworkPerf(t *testing.T) {
-
-	mapper := AmazonNetworkMapper{}
+func TestBurstInfo(t *testing.T) {
 	tests := []struct {
-		name  string
-		vm    cloudinfo.VmInfo
-		check func(cat string, err error)
+		instanceType string
+		expected     *cloudinfo.BurstInfo
 	}{
 		{
-			name: "success - mapper maps to the lowest category",
-			vm: cloudinfo.VmInfo{
-				NtwPerf: "Very Low",
-			},
-			check: func(cat string, err error) {
-				assert.Equal(t, cloudinfo.NTW_LOW, cat, "not mapped to the right category")
-			},
+			instanceType: "t2.micro",
+			expected:     &cloudinfo.BurstInfo{BaselineCpu: 10, CreditsPerHour: 6, Unlimited: true},
+		},
+		{
+			instanceType: "t3a.2xlarge",
+			expected:     &cloudinfo.BurstInfo{BaselineCpu: 40, CreditsPerHour: 192, Unlimited: true},
+		},
+		{
+			instanceType: "t1.micro",
+			expected:     &cloudinfo.BurstInfo{},
 		},
 		{
-			name: "error - mapper doesn't map to a category",
-			vm: cloudinfo.VmInfo{
-				NtwPerf: "Error",
-			},
-			check: func(cat string, err error) {
-				assert.Equal(t, "", cat, "not mapped to the right category")
-				assert.Equal(t, "could not determine network performance for: [Error]", err.Error())
-			},
+			instanceType: "m5.large",
+			expected:     nil,
 		},
 	}
 	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			test.check(mapper.MapNetworkPerf(test.vm.NtwPerf))
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			vm.Classify(taxonomyRules)
+			assert.Equal(t, test.expected, burstInfo(vm))
 		})
 	}
 }
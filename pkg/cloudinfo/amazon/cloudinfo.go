@@ -19,16 +19,20 @@ import (
 	"errors"
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 	"github.com/prometheus/client_golang/api"
 	"github.com/prometheus/client_golang/api/prometheus/v1"
@@ -38,8 +42,18 @@ import (
 const (
 	// Cpu represents the cpu attribute for the recommender
 	Cpu = "vcpu"
+
+	// currency is the currency the prices are read in from the price list
+	currency = cloudinfo.CurrencyUSD
 )
 
+// eksControlPlane is the fee of the eks control plane, every cluster is charged per hour
+var eksControlPlane = cloudinfo.ControlPlaneFee{
+	Model:        cloudinfo.ControlPlaneHourly,
+	PricePerHour: 0.2,
+	Currency:     currency,
+}
+
 // SpotPriceGauge collects metrics for the prometheus
 var SpotPriceGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Namespace: "cloudinfo",
@@ -60,6 +74,18 @@ type Ec2Infoer struct {
 	prometheus   v1.API
 	promQuery    string
 	ec2Describer func(region string) Ec2Describer
+	// spotWindow is the look-back window of the spot price statistics, only the current prices are retrieved if 0
+	spotWindow time.Duration
+
+	// spotHistories holds the spot price history retrieved per region, only the changes are retrieved on renewal
+	spotHistories   map[string]regionSpotHistory
+	spotHistoriesMu sync.Mutex
+}
+
+// regionSpotHistory is the spot price history of the instance types of a region as of its retrieval
+type regionSpotHistory struct {
+	histories   map[string]cloudinfo.SpotPriceHistory
+	retrievedAt time.Time
 }
 
 // Ec2Describer interface for operations describing EC2 artifacts. (a subset of the Ec2 cli operations used by this app)
@@ -69,13 +95,26 @@ type Ec2Describer interface {
 }
 
 // NewEc2Infoer creates a new instance of the infoer
-func NewEc2Infoer(ctx context.Context, promAddr string, pq string) (*Ec2Infoer, error) {
+// The AWS API is accessed through the given transport if it's not nil (see the recorder package)
+// The spot price history is collected over the spot window to compute the spot price statistics
+func NewEc2Infoer(ctx context.Context, promAddr string, pq string, spotWindow time.Duration, transport http.RoundTripper) (*Ec2Infoer, error) {
 	log := logger.Extract(ctx)
-	s, err := session.NewSession()
+	cfg := aws.NewConfig()
+	if transport != nil {
+		cfg = cfg.WithHTTPClient(&http.Client{Transport: transport})
+	}
+	if recorder.IsReplaying(transport) {
+		// requests are not signed as they are served from fixtures
+		cfg = cfg.WithCredentials(credentials.AnonymousCredentials)
+	}
+	s, err := session.NewSession(cfg)
 	if err != nil {
 		log.WithError(err).Error("Error creating AWS session")
 		return nil, err
 	}
+	if promAddr != "" && spotWindow > 0 {
+		return nil, errors.New("spot price statistics are not available with the prometheus API, the spot history window should be 0")
+	}
 	var promApi v1.API
 	if promAddr == "" {
 		log.Warn("Prometheus API address is not set, fallback to direct API access.")
@@ -101,6 +140,8 @@ func NewEc2Infoer(ctx context.Context, promAddr string, pq string) (*Ec2Infoer,
 		ec2Describer: func(region string) Ec2Describer {
 			return ec2.New(s, aws.NewConfig().WithRegion(region))
 		},
+		spotWindow:    spotWindow,
+		spotHistories: make(map[string]regionSpotHistory),
 	}, nil
 }
 
@@ -144,6 +185,7 @@ func (e *Ec2Infoer) GetProducts(ctx context.Context, service, regionId string) (
 	log := logger.Extract(ctx)
 
 	missingAttributes := make(map[string][]string)
+	osPrices := make(map[string]cloudinfo.OsPrices)
 	var (
 		missingGpu []string
 		vms        []cloudinfo.VmInfo
@@ -168,6 +210,21 @@ func (e *Ec2Infoer) GetProducts(ctx context.Context, service, regionId string) (
 			log.WithError(err).Warnf("could not retrieve instance type [%s]", instanceType)
 			continue
 		}
+
+		// the prices of the operating systems other than linux are collected separately and attached to the linux vm
+		os := getOs(pd)
+		if os == "" {
+			continue
+		}
+		if os != cloudinfo.OsLinux {
+			if odPriceStr, err := pd.GetOnDemandPrice(); err == nil {
+				if price, err := strconv.ParseFloat(odPriceStr, 64); err == nil {
+					osPrices[instanceType] = osPrices[instanceType].With(os, price)
+				}
+			}
+			continue
+		}
+
 		cpusStr, err := pd.GetDataForKey(Cpu)
 		if err != nil {
 			missingAttributes[instanceType] = append(missingAttributes[instanceType], "cpu")
@@ -196,10 +253,9 @@ func (e *Ec2Infoer) GetProducts(ctx context.Context, service, regionId string) (
 			}
 		}
 
-		ntwMapper := newAmazonNetworkMapper()
-		ntwPerfCat, err := ntwMapper.MapNetworkPerf(ntwPerf)
+		bw, upTo, err := bandwidth(ntwPerf)
 		if err != nil {
-			log.WithError(err).Debug("could not get network performance category")
+			log.WithError(err).Debug("could not get network bandwidth")
 		}
 
 		onDemandPrice, _ := strconv.ParseFloat(odPriceStr, 64)
@@ -213,25 +269,33 @@ func (e *Ec2Infoer) GetProducts(ctx context.Context, service, regionId string) (
 			Mem:           mem,
 			Gpus:          gpus,
 			NtwPerf:       ntwPerf,
-			NtwPerfCat:    ntwPerfCat,
+			Bandwidth:     bw,
+			BandwidthUpTo: upTo,
 			CurrentGen:    currGen,
-			Attributes:    cloudinfo.Attributes(cpusStr, strings.Split(memStr, " ")[0], ntwPerfCat),
+			Attributes:    cloudinfo.Attributes(cpusStr, strings.Split(memStr, " ")[0]),
+			Currency:      currency,
+		}
+		if commitments, err := pd.GetCommitmentPrices(); err == nil {
+			vm.Commitments = commitments
+		} else {
+			log.WithError(err).Debugf("could not get commitment prices of [%s]", instanceType)
 		}
+		setHardware(&vm, pd)
+		vm.Classify(taxonomyRules)
+		vm.BurstInfo = burstInfo(vm)
 		vms = append(vms, vm)
 	}
+	for i, vm := range vms {
+		if prices, ok := osPrices[vm.Type]; ok {
+			vms[i].OsPrices = prices.With(cloudinfo.OsLinux, vm.OnDemandPrice)
+		}
+	}
 	log.Warnf("instance types with missing attributes %s", missingAttributes)
 	log.Debugf("instance types with missing gpu %s", missingGpu)
 	if vms == nil {
 		log.Debug("couldn't find any virtual machines to recommend")
 	}
 
-	if service == "eks" {
-		vms = append(vms, cloudinfo.VmInfo{
-			Type:          "EKS Control Plane",
-			OnDemandPrice: 0.2,
-		})
-	}
-
 	log.Debugf("found vms: %#v", vms)
 	return vms, nil
 }
@@ -286,7 +350,7 @@ func (pd *priceData) GetOnDemandPrice() (string, error) {
 			if err != nil {
 				return "", err
 			}
-			odPrice, ok := pricePerUnitMap["USD"].(string)
+			odPrice, ok := pricePerUnitMap[currency].(string)
 			if !ok {
 				return "", errors.New("could not get on demand price or could not cast on demand price to string")
 			}
@@ -334,11 +398,6 @@ func (e *Ec2Infoer) newGetProductsInput(regionId string) *pricing.GetProductsInp
 
 		ServiceCode: aws.String("AmazonEC2"),
 		Filters: []*pricing.Filter{
-			{
-				Type:  aws.String(pricing.FilterTypeTermMatch),
-				Field: aws.String("operatingSystem"),
-				Value: aws.String("Linux"),
-			},
 			{
 				Type:  aws.String(pricing.FilterTypeTermMatch),
 				Field: aws.String("location"),
@@ -351,8 +410,8 @@ func (e *Ec2Infoer) newGetProductsInput(regionId string) *pricing.GetProductsInp
 			},
 			{
 				Type:  aws.String(pricing.FilterTypeTermMatch),
-				Field: aws.String("preInstalledSw"),
-				Value: aws.String("NA"),
+				Field: aws.String("licenseModel"),
+				Value: aws.String("No License required"),
 			},
 			{
 				Type:  aws.String(pricing.FilterTypeTermMatch),
@@ -435,10 +494,26 @@ func (e *Ec2Infoer) getSpotPricesFromPrometheus(ctx context.Context, region stri
 	return priceInfo, nil
 }
 
-func (e *Ec2Infoer) getCurrentSpotPrices(ctx context.Context, region string) (map[string]cloudinfo.SpotPriceInfo, error) {
-	priceInfo := make(map[string]cloudinfo.SpotPriceInfo)
+// getSpotPriceHistory retrieves the spot price history of every instance type in the region over the spot window
+// The history of the previous retrieval is kept, only the prices changed since then are retrieved
+func (e *Ec2Infoer) getSpotPriceHistory(ctx context.Context, region string) (map[string]cloudinfo.SpotPriceHistory, error) {
+	now := time.Now()
+	start := now.Add(-e.spotWindow)
+	histories := make(map[string]cloudinfo.SpotPriceHistory)
+
+	e.spotHistoriesMu.Lock()
+	previous, ok := e.spotHistories[region]
+	e.spotHistoriesMu.Unlock()
+	if ok && previous.retrievedAt.After(start) {
+		for instanceType, history := range previous.histories {
+			histories[instanceType] = history.Since(start)
+		}
+		start = previous.retrievedAt
+	}
+
+	// the prices in effect at the start time are listed as well, they replace the already known points
 	err := e.ec2Describer(region).DescribeSpotPriceHistoryPages(&ec2.DescribeSpotPriceHistoryInput{
-		StartTime:           aws.Time(time.Now()),
+		StartTime:           aws.Time(start),
 		ProductDescriptions: []*string{aws.String("Linux/UNIX")},
 	}, func(history *ec2.DescribeSpotPriceHistoryOutput, lastPage bool) bool {
 		for _, pe := range history.SpotPriceHistory {
@@ -447,23 +522,30 @@ func (e *Ec2Infoer) getCurrentSpotPrices(ctx context.Context, region string) (ma
 				logger.Extract(ctx).WithError(err).Error("couldn't parse spot price from history")
 				continue
 			}
-			if priceInfo[*pe.InstanceType] == nil {
-				priceInfo[*pe.InstanceType] = make(cloudinfo.SpotPriceInfo)
+			if histories[*pe.InstanceType] == nil {
+				histories[*pe.InstanceType] = make(cloudinfo.SpotPriceHistory)
 			}
-			priceInfo[*pe.InstanceType][*pe.AvailabilityZone] = price
+			histories[*pe.InstanceType].Add(*pe.AvailabilityZone, aws.TimeValue(pe.Timestamp), price)
 		}
 		return true
 	})
 	if err != nil {
 		return nil, err
 	}
-	return priceInfo, nil
+
+	if e.spotWindow > 0 {
+		e.spotHistoriesMu.Lock()
+		e.spotHistories[region] = regionSpotHistory{histories: histories, retrievedAt: now}
+		e.spotHistoriesMu.Unlock()
+	}
+	return histories, nil
 }
 
 // GetCurrentPrices returns the current spot prices of every instance type in every availability zone in a given region
 func (e *Ec2Infoer) GetCurrentPrices(ctx context.Context, region string) (map[string]cloudinfo.Price, error) {
 	log := logger.Extract(ctx)
 	var spotPrices map[string]cloudinfo.SpotPriceInfo
+	var spotStats map[string]map[string]cloudinfo.SpotStats
 	var err error
 	if e.prometheus != nil {
 		spotPrices, err = e.getSpotPricesFromPrometheus(ctx, region)
@@ -474,18 +556,29 @@ func (e *Ec2Infoer) GetCurrentPrices(ctx context.Context, region string) (map[st
 
 	if len(spotPrices) == 0 {
 		log.Debug("getting current spot prices directly from the AWS API")
-		spotPrices, err = e.getCurrentSpotPrices(ctx, region)
+		histories, err := e.getSpotPriceHistory(ctx, region)
 		if err != nil {
 			log.WithError(err).Error("could not retrieve current prices")
 			return nil, err
 		}
+		now := time.Now()
+		spotPrices = make(map[string]cloudinfo.SpotPriceInfo, len(histories))
+		spotStats = make(map[string]map[string]cloudinfo.SpotStats, len(histories))
+		for instanceType, history := range histories {
+			spotPrices[instanceType] = history.Latest()
+			if e.spotWindow > 0 {
+				spotStats[instanceType] = history.Stats(now.Add(-e.spotWindow), now)
+			}
+		}
 	}
 
 	prices := make(map[string]cloudinfo.Price)
 	for instanceType, sp := range spotPrices {
 		prices[instanceType] = cloudinfo.Price{
 			SpotPrice:     sp,
+			SpotStats:     spotStats[instanceType],
 			OnDemandPrice: -1,
+			Currency:      currency,
 		}
 		for zone, price := range sp {
 			SpotPriceGauge.WithLabelValues(region, zone, instanceType).Set(price)
@@ -508,7 +601,7 @@ func (e *Ec2Infoer) GetCpuAttrName() string {
 func (e *Ec2Infoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
 	services := []cloudinfo.ServiceDescriber{
 		cloudinfo.NewService("compute"),
-		cloudinfo.NewService("eks")}
+		cloudinfo.NewKubernetesService("eks", eksControlPlane)}
 	return services, nil
 }
 
@@ -524,7 +617,7 @@ func (e *Ec2Infoer) GetService(ctx context.Context, service string) (cloudinfo.S
 			return sd, nil
 		}
 	}
-	return nil, fmt.Errorf("the service [%s] is not supported", service)
+	return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
 
 }
 
@@ -18,12 +18,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -179,14 +182,25 @@ func (dps *testStruct) DescribeSpotPriceHistoryPages(input *ec2.DescribeSpotPric
 	if dps.TcId == 11 {
 		return errors.New("invalid")
 	}
+	if dps.TcId == 12 {
+		now := time.Now()
+		fn(&ec2.DescribeSpotPriceHistoryOutput{
+			SpotPriceHistory: []*ec2.SpotPrice{
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("eu-central-1a"), SpotPrice: aws.String("0.040000"), Timestamp: aws.Time(now.Add(-time.Hour))},
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("eu-central-1a"), SpotPrice: aws.String("0.030000"), Timestamp: aws.Time(now.Add(-2 * time.Hour))},
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("eu-central-1b"), SpotPrice: aws.String("0.035000"), Timestamp: aws.Time(now.Add(-3 * time.Hour))},
+			},
+		}, true)
+	}
 	return nil
 }
 
 func TestNewEc2Infoer(t *testing.T) {
 	tests := []struct {
-		name  string
-		prom  string
-		check func(info *Ec2Infoer, err error)
+		name       string
+		prom       string
+		spotWindow time.Duration
+		check      func(info *Ec2Infoer, err error)
 	}{
 		{
 			name: "create Ec2Infoer - Prometheus API address is not set",
@@ -204,10 +218,19 @@ func TestNewEc2Infoer(t *testing.T) {
 				assert.NotNil(t, info, "the Ec2Infoer should not be nil")
 			},
 		},
+		{
+			name:       "error - spot price statistics with the Prometheus API",
+			prom:       "PromAPIAddress",
+			spotWindow: 24 * time.Hour,
+			check: func(info *Ec2Infoer, err error) {
+				assert.Nil(t, info, "the Ec2Infoer should be nil")
+				assert.EqualError(t, err, "spot price statistics are not available with the prometheus API, the spot history window should be 0")
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			test.check(NewEc2Infoer(context.Background(), test.prom, ""))
+			test.check(NewEc2Infoer(context.Background(), test.prom, "", test.spotWindow, nil))
 		})
 	}
 }
@@ -246,7 +269,7 @@ func TestEc2Infoer_GetAttributeValues(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudinfoer, err := NewEc2Infoer(context.Background(), "", "")
+			cloudinfoer, err := NewEc2Infoer(context.Background(), "", "", 0, nil)
 			// override pricingSvc
 			cloudinfoer.pricingSvc = test.pricingService
 			if err != nil {
@@ -286,7 +309,7 @@ func TestEc2Infoer_GetRegions(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudinfoer, err := NewEc2Infoer(context.Background(), "", "")
+			cloudinfoer, err := NewEc2Infoer(context.Background(), "", "", 0, nil)
 			if err != nil {
 				t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
 			}
@@ -323,7 +346,7 @@ func TestEc2Infoer_GetRegion(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewEc2Infoer(context.Background(), "", "")
+			cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, nil)
 			if err != nil {
 				t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
 			}
@@ -333,21 +356,21 @@ func TestEc2Infoer_GetRegion(t *testing.T) {
 	}
 }
 
-func TestEc2Infoer_getCurrentSpotPrices(t *testing.T) {
+func TestEc2Infoer_getSpotPriceHistory(t *testing.T) {
 	tests := []struct {
 		name       string
 		region     string
 		ec2CliMock func(region string) Ec2Describer
-		check      func(data map[string]cloudinfo.SpotPriceInfo, err error)
+		check      func(data map[string]cloudinfo.SpotPriceHistory, err error)
 	}{
 		{
-			name:   "successful - get current spot prices",
+			name:   "successful - get spot price history",
 			region: "dummyRegion",
 			ec2CliMock: func(region string) Ec2Describer {
 				return &testStruct{}
 			},
-			check: func(data map[string]cloudinfo.SpotPriceInfo, err error) {
-				assert.Equal(t, map[string]cloudinfo.SpotPriceInfo{}, data)
+			check: func(data map[string]cloudinfo.SpotPriceHistory, err error) {
+				assert.Equal(t, map[string]cloudinfo.SpotPriceHistory{}, data)
 				assert.Nil(t, err, "the error should be nil")
 			},
 		},
@@ -357,7 +380,7 @@ func TestEc2Infoer_getCurrentSpotPrices(t *testing.T) {
 			ec2CliMock: func(region string) Ec2Describer {
 				return &testStruct{TcId: 11}
 			},
-			check: func(data map[string]cloudinfo.SpotPriceInfo, err error) {
+			check: func(data map[string]cloudinfo.SpotPriceHistory, err error) {
 				assert.Nil(t, data, "the data should be nil")
 				assert.EqualError(t, err, "invalid")
 			},
@@ -365,18 +388,68 @@ func TestEc2Infoer_getCurrentSpotPrices(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewEc2Infoer(context.Background(), "", "")
+			cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, nil)
 			// override ec2cli
 			cloudInfoer.ec2Describer = test.ec2CliMock
 			if err != nil {
 				t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
 			}
 
-			test.check(cloudInfoer.getCurrentSpotPrices(context.Background(), test.region))
+			test.check(cloudInfoer.getSpotPriceHistory(context.Background(), test.region))
 		})
 	}
 }
 
+// spotHistoryDescriber lists the price points changed since the requested start time
+type spotHistoryDescriber struct {
+	testStruct
+	points     []*ec2.SpotPrice
+	startTimes []time.Time
+}
+
+func (d *spotHistoryDescriber) DescribeSpotPriceHistoryPages(input *ec2.DescribeSpotPriceHistoryInput, fn func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool) error {
+	d.startTimes = append(d.startTimes, aws.TimeValue(input.StartTime))
+	var points []*ec2.SpotPrice
+	for _, p := range d.points {
+		if !aws.TimeValue(p.Timestamp).Before(aws.TimeValue(input.StartTime)) {
+			points = append(points, p)
+		}
+	}
+	fn(&ec2.DescribeSpotPriceHistoryOutput{SpotPriceHistory: points}, true)
+	return nil
+}
+
+func TestEc2Infoer_getSpotPriceHistory_incremental(t *testing.T) {
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 24*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	now := time.Now()
+	describer := &spotHistoryDescriber{
+		points: []*ec2.SpotPrice{
+			{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("eu-central-1a"), SpotPrice: aws.String("0.030000"), Timestamp: aws.Time(now.Add(-2 * time.Hour))},
+		},
+	}
+	cloudInfoer.ec2Describer = func(region string) Ec2Describer {
+		return describer
+	}
+
+	_, err = cloudInfoer.getSpotPriceHistory(context.Background(), "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+
+	describer.points = append(describer.points, &ec2.SpotPrice{
+		InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("eu-central-1a"), SpotPrice: aws.String("0.040000"), Timestamp: aws.Time(time.Now()),
+	})
+	histories, err := cloudInfoer.getSpotPriceHistory(context.Background(), "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+
+	assert.Len(t, describer.startTimes, 2)
+	assert.WithinDuration(t, now.Add(-24*time.Hour), describer.startTimes[0], time.Minute, "the whole window should be retrieved first")
+	assert.WithinDuration(t, now, describer.startTimes[1], time.Minute, "only the changes should be retrieved on renewal")
+	assert.Len(t, histories["m5.large"]["eu-central-1a"], 2, "the known history should be kept")
+	assert.Equal(t, cloudinfo.SpotPriceInfo{"eu-central-1a": 0.04}, histories["m5.large"].Latest())
+}
+
 func TestEc2Infoer_GetCurrentPrices(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -409,7 +482,7 @@ func TestEc2Infoer_GetCurrentPrices(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewEc2Infoer(context.Background(), "PromAPIAddress", "")
+			cloudInfoer, err := NewEc2Infoer(context.Background(), "PromAPIAddress", "", 0, nil)
 			// override ec2cli
 			cloudInfoer.ec2Describer = test.ec2CliMock
 			if err != nil {
@@ -421,6 +494,27 @@ func TestEc2Infoer_GetCurrentPrices(t *testing.T) {
 	}
 }
 
+func TestEc2Infoer_GetCurrentPrices_spotStats(t *testing.T) {
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 24*time.Hour, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	cloudInfoer.ec2Describer = func(region string) Ec2Describer {
+		return &testStruct{TcId: 12}
+	}
+
+	prices, err := cloudInfoer.GetCurrentPrices(context.Background(), "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, cloudinfo.SpotPriceInfo{"eu-central-1a": 0.04, "eu-central-1b": 0.035}, prices["m5.large"].SpotPrice,
+		"the latest prices should be the current ones")
+	stats := prices["m5.large"].SpotStats
+	assert.Equal(t, 2, stats["eu-central-1a"].Samples)
+	assert.Equal(t, 0.03, stats["eu-central-1a"].Min)
+	assert.Equal(t, 0.04, stats["eu-central-1a"].Max)
+	assert.InDelta(t, 0.035, stats["eu-central-1a"].Mean, 1e-9)
+	assert.Equal(t, 1, stats["eu-central-1b"].Samples)
+}
+
 func TestEc2Infoer_GetZones(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -454,7 +548,7 @@ func TestEc2Infoer_GetZones(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfoer, err := NewEc2Infoer(context.Background(), "PromAPIAddress", "")
+			cloudInfoer, err := NewEc2Infoer(context.Background(), "PromAPIAddress", "", 0, nil)
 			// override ec2cli
 			cloudInfoer.ec2Describer = test.ec2CliMock
 			if err != nil {
@@ -778,3 +872,54 @@ func TestPriceData_newPriceData(t *testing.T) {
 		})
 	}
 }
+
+func TestEc2Infoer_Conformance(t *testing.T) {
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, nil)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	// override the external calls
+	cloudInfoer.pricingSvc = &testStruct{TcId: 4}
+	cloudInfoer.ec2Describer = func(region string) Ec2Describer {
+		return &testStruct{}
+	}
+
+	cloudinfotest.RunConformance(t, cloudInfoer, cloudinfotest.Options{
+		// the region supported by both the compute and the eks services
+		Regions: []string{"eu-west-1"},
+	})
+}
+
+func TestEc2Infoer_Replay(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	vms, err := cloudInfoer.GetProducts(context.Background(), "compute", "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+	if assert.Equal(t, 1, len(vms)) {
+		assert.Equal(t, "m5.large", vms[0].Type)
+		assert.Equal(t, float64(2), vms[0].Cpus)
+		assert.Equal(t, float64(8), vms[0].Mem)
+		assert.Equal(t, 0.115, vms[0].OnDemandPrice)
+		assert.Equal(t, cloudinfo.CurrencyUSD, vms[0].Currency)
+		assert.Equal(t, cloudinfo.OsPrices{
+			cloudinfo.OsLinux:              0.115,
+			cloudinfo.OsWindows:            0.207,
+			cloudinfo.OsWindowsSqlStandard: 0.687,
+		}, vms[0].OsPrices)
+		// the convertible offering is left out
+		if assert.Equal(t, 4, len(vms[0].Commitments)) {
+			assert.Equal(t, cloudinfo.NewCommitmentPrice(cloudinfo.Term1Year, cloudinfo.PaymentAllUpfront, 617, 0), vms[0].Commitments[0])
+			assert.Equal(t, cloudinfo.NewCommitmentPrice(cloudinfo.Term1Year, cloudinfo.PaymentNoUpfront, 0, 0.075), vms[0].Commitments[1])
+			assert.InDelta(t, 0.071959, vms[0].Commitments[2].EffectiveHourly, 1e-6)
+			assert.Equal(t, cloudinfo.Term3Year, vms[0].Commitments[3].Term)
+			assert.InDelta(t, 0.049924, vms[0].Commitments[3].EffectiveHourly, 1e-6)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// purchaseOptions maps the purchase options of the reserved terms to payment options
+var purchaseOptions = map[string]string{
+	"No Upfront":      cloudinfo.PaymentNoUpfront,
+	"Partial Upfront": cloudinfo.PaymentPartialUpfront,
+	"All Upfront":     cloudinfo.PaymentAllUpfront,
+}
+
+// GetCommitmentPrices returns the prices of the standard reserved instance offerings of the product
+// convertible offerings are left out, the terms without any known payment option or length are skipped
+func (pd *priceData) GetCommitmentPrices() ([]cloudinfo.CommitmentPrice, error) {
+	termsMap, err := getMapForKey("terms", pd.awsData)
+	if err != nil {
+		return nil, err
+	}
+	reservedMap, err := getMapForKey("Reserved", termsMap)
+	if err != nil {
+		// not every product can be reserved
+		return nil, nil
+	}
+
+	var commitments []cloudinfo.CommitmentPrice
+	for _, term := range reservedMap {
+		termMap, ok := term.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		attrs, err := getMapForKey("termAttributes", termMap)
+		if err != nil {
+			return nil, err
+		}
+		if attrs["OfferingClass"] != "standard" {
+			continue
+		}
+		length, _ := attrs["LeaseContractLength"].(string)
+		if length != cloudinfo.Term1Year && length != cloudinfo.Term3Year {
+			continue
+		}
+		purchaseOption, _ := attrs["PurchaseOption"].(string)
+		paymentOption, ok := purchaseOptions[purchaseOption]
+		if !ok {
+			continue
+		}
+
+		priceDimensionsMap, err := getMapForKey("priceDimensions", termMap)
+		if err != nil {
+			return nil, err
+		}
+		var upfront, hourly float64
+		for _, dimension := range priceDimensionsMap {
+			dimensionMap, ok := dimension.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pricePerUnitMap, err := getMapForKey("pricePerUnit", dimensionMap)
+			if err != nil {
+				return nil, err
+			}
+			priceStr, _ := pricePerUnitMap[currency].(string)
+			price, err := strconv.ParseFloat(priceStr, 64)
+			if err != nil {
+				return nil, err
+			}
+			// the upfront fee is given as a quantity, the recurring fee hourly
+			if dimensionMap["unit"] == "Quantity" {
+				upfront = price
+			} else {
+				hourly = price
+			}
+		}
+		commitments = append(commitments, cloudinfo.NewCommitmentPrice(length, paymentOption, upfront, hourly))
+	}
+	sort.Slice(commitments, func(i, j int) bool {
+		if commitments[i].Term != commitments[j].Term {
+			return commitments[i].Term < commitments[j].Term
+		}
+		return commitments[i].PaymentOption < commitments[j].PaymentOption
+	})
+	return commitments, nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// gpuSpec describes the gpus of an instance family
+type gpuSpec struct {
+	model string
+	mem   float64
+}
+
+var (
+	// storageRe matches the storage attribute of instance types with instance store volumes, eg.: 2 x 900 NVMe SSD
+	storageRe = regexp.MustCompile(`^(\d+) x ([\d,]+) (NVMe SSD|SSD|HDD)$`)
+
+	// gpuSpecs holds the gpu model and memory (GiB per gpu) of the gpu instance families
+	// the pricing API only reports the number of gpus
+	gpuSpecs = map[string]gpuSpec{
+		"p2":   {model: "NVIDIA Tesla K80", mem: 12},
+		"p3":   {model: "NVIDIA Tesla V100", mem: 16},
+		"p3dn": {model: "NVIDIA Tesla V100", mem: 32},
+		"g2":   {model: "NVIDIA GRID K520", mem: 4},
+		"g3":   {model: "NVIDIA Tesla M60", mem: 8},
+		"g3s":  {model: "NVIDIA Tesla M60", mem: 8},
+	}
+
+	// nitroFamilies are the instance families running on the Nitro hypervisor, the rest runs on Xen
+	nitroFamilies = map[string]bool{
+		"a1": true, "c5": true, "c5d": true, "c5n": true, "m5": true, "m5a": true, "m5d": true,
+		"p3dn": true, "r5": true, "r5a": true, "r5d": true, "t3": true, "z1d": true,
+	}
+)
+
+// setHardware fills the hardware details of the virtual machine from the attributes of the pricing API
+func setHardware(vm *cloudinfo.VmInfo, pd *priceData) {
+	family, size := splitInstanceType(vm.Type)
+
+	vm.Arch = cloudinfo.ArchX86
+	if processor, err := pd.GetDataForKey("physicalProcessor"); err == nil {
+		vm.ProcessorFamily = processor
+		if strings.Contains(processor, "Graviton") {
+			vm.Arch = cloudinfo.ArchArm64
+		}
+	}
+
+	if vm.Gpus > 0 {
+		if spec, ok := gpuSpecs[family]; ok {
+			vm.GpuModel = spec.model
+			vm.GpuMem = spec.mem
+		}
+	}
+
+	if storage, err := pd.GetDataForKey("storage"); err == nil {
+		vm.LocalDisks, vm.LocalDiskSize, vm.LocalDiskType = parseStorage(storage)
+	}
+
+	if _, err := pd.GetDataForKey("dedicatedEbsThroughput"); err == nil {
+		vm.PremiumStorage = true
+	}
+
+	switch {
+	case size == "metal":
+		vm.Hypervisor = "none"
+	case nitroFamilies[family]:
+		vm.Hypervisor = "nitro"
+	default:
+		vm.Hypervisor = "xen"
+	}
+}
+
+// parseStorage parses the storage attribute into the number, size (GiB) and type of the local disks
+// EBS only instance types have no local disks
+func parseStorage(storage string) (int, float64, string) {
+	matches := storageRe.FindStringSubmatch(storage)
+	if matches == nil {
+		return 0, 0, ""
+	}
+	count, _ := strconv.Atoi(matches[1])
+	size, _ := strconv.ParseFloat(strings.Replace(matches[2], ",", "", -1), 64)
+
+	diskType := cloudinfo.DiskTypeSsd
+	switch matches[3] {
+	case "NVMe SSD":
+		diskType = cloudinfo.DiskTypeNvme
+	case "HDD":
+		diskType = cloudinfo.DiskTypeHdd
+	}
+	return count, size, diskType
+}
+
+// splitInstanceType splits the instance type into family and size, eg.: m5.large -> m5, large
+func splitInstanceType(instanceType string) (string, string) {
+	parts := strings.SplitN(instanceType, ".", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
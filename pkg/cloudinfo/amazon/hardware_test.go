@@ -0,0 +1,102 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetHardware(t *testing.T) {
+	tests := []struct {
+		name  string
+		vm    cloudinfo.VmInfo
+		attrs map[string]interface{}
+		check func(vm cloudinfo.VmInfo)
+	}{
+		{
+			name: "ebs only nitro instance type",
+			vm:   cloudinfo.VmInfo{Type: "m5.large"},
+			attrs: map[string]interface{}{
+				"physicalProcessor":      "Intel Xeon Platinum 8175",
+				"storage":                "EBS only",
+				"dedicatedEbsThroughput": "Up to 2120 Mbps",
+			},
+			check: func(vm cloudinfo.VmInfo) {
+				assert.Equal(t, cloudinfo.ArchX86, vm.Arch)
+				assert.Equal(t, "Intel Xeon Platinum 8175", vm.ProcessorFamily)
+				assert.Equal(t, 0, vm.LocalDisks)
+				assert.Equal(t, "", vm.LocalDiskType)
+				assert.True(t, vm.PremiumStorage)
+				assert.Equal(t, "nitro", vm.Hypervisor)
+			},
+		},
+		{
+			name: "graviton instance type",
+			vm:   cloudinfo.VmInfo{Type: "a1.xlarge"},
+			attrs: map[string]interface{}{
+				"physicalProcessor": "AWS Graviton Processor",
+			},
+			check: func(vm cloudinfo.VmInfo) {
+				assert.Equal(t, cloudinfo.ArchArm64, vm.Arch)
+			},
+		},
+		{
+			name: "gpu instance type with local nvme disks",
+			vm:   cloudinfo.VmInfo{Type: "p3dn.24xlarge", Gpus: 8},
+			attrs: map[string]interface{}{
+				"storage": "2 x 900 NVMe SSD",
+			},
+			check: func(vm cloudinfo.VmInfo) {
+				assert.Equal(t, "NVIDIA Tesla V100", vm.GpuModel)
+				assert.Equal(t, float64(32), vm.GpuMem)
+				assert.Equal(t, 2, vm.LocalDisks)
+				assert.Equal(t, float64(900), vm.LocalDiskSize)
+				assert.Equal(t, cloudinfo.DiskTypeNvme, vm.LocalDiskType)
+				assert.False(t, vm.PremiumStorage)
+			},
+		},
+		{
+			name: "previous generation instance type with local hdd disks",
+			vm:   cloudinfo.VmInfo{Type: "d2.8xlarge"},
+			attrs: map[string]interface{}{
+				"storage": "24 x 2,000 HDD",
+			},
+			check: func(vm cloudinfo.VmInfo) {
+				assert.Equal(t, 24, vm.LocalDisks)
+				assert.Equal(t, float64(2000), vm.LocalDiskSize)
+				assert.Equal(t, cloudinfo.DiskTypeHdd, vm.LocalDiskType)
+				assert.Equal(t, "xen", vm.Hypervisor)
+			},
+		},
+		{
+			name:  "bare metal instance type",
+			vm:    cloudinfo.VmInfo{Type: "i3.metal"},
+			attrs: map[string]interface{}{},
+			check: func(vm cloudinfo.VmInfo) {
+				assert.Equal(t, "none", vm.Hypervisor)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vm := test.vm
+			setHardware(&vm, &priceData{attrMap: test.attrs})
+			test.check(vm)
+		})
+	}
+}
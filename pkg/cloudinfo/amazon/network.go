@@ -0,0 +1,203 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// loadBalancerTypes maps the product families of the elb price list to the load balancer types
+var loadBalancerTypes = map[string]string{
+	"Load Balancer-Application": "application",
+	"Load Balancer-Network":     "network",
+	"Load Balancer":             "classic",
+}
+
+// GetNetworkPrices retrieves the elastic load balancer and the data transfer prices in the given region
+func (e *Ec2Infoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	log := logger.Extract(ctx)
+	location := e.GetRegion(region).Description()
+
+	prices := cloudinfo.NetworkPrices{Currency: currency}
+
+	balancers, err := e.pricingSvc.GetPriceList(newGetNetworkInput("AWSELB", "location", location))
+	if err != nil {
+		return cloudinfo.NetworkPrices{}, err
+	}
+	lbPrices := make(map[string]cloudinfo.LoadBalancerPrice)
+	for _, balancer := range balancers {
+		pd, err := newPriceData(balancer)
+		if err != nil {
+			log.WithError(err).Warn("could not extract load balancer pricing info")
+			continue
+		}
+		family, _ := pd.getProductFamily()
+		lbType, ok := loadBalancerTypes[family]
+		if !ok {
+			continue
+		}
+		usageType, _ := pd.GetDataForKey("usagetype")
+		price, err := onDemandPrice(pd)
+		if err != nil {
+			log.WithError(err).Debugf("could not get the price of the [%s] load balancer", lbType)
+			continue
+		}
+
+		lb := lbPrices[lbType]
+		lb.Type = lbType
+		switch {
+		case strings.HasSuffix(usageType, "LoadBalancerUsage"):
+			lb.PricePerHour = price
+		case strings.HasSuffix(usageType, "LCUUsage"):
+			lb.PricePerCapacityUnitHour = price
+		case strings.HasSuffix(usageType, "DataProcessing-Bytes"):
+			lb.PricePerGb = price
+		default:
+			continue
+		}
+		lbPrices[lbType] = lb
+	}
+	for _, lb := range lbPrices {
+		prices.LoadBalancers = append(prices.LoadBalancers, lb)
+	}
+	sort.Slice(prices.LoadBalancers, func(i, j int) bool {
+		return prices.LoadBalancers[i].Type < prices.LoadBalancers[j].Type
+	})
+
+	transfers, err := e.pricingSvc.GetPriceList(newGetNetworkInput("AmazonEC2", "fromLocation", location, "productFamily", "Data Transfer"))
+	if err != nil {
+		return cloudinfo.NetworkPrices{}, err
+	}
+	for _, transfer := range transfers {
+		pd, err := newPriceData(transfer)
+		if err != nil {
+			log.WithError(err).Warn("could not extract data transfer pricing info")
+			continue
+		}
+		transferType, _ := pd.GetDataForKey("transferType")
+		toLocation, _ := pd.GetDataForKey("toLocation")
+		switch {
+		case transferType == "IntraRegion":
+			if prices.InterZonePerGb, err = onDemandPrice(pd); err != nil {
+				log.WithError(err).Debug("could not get the inter zone data transfer price")
+			}
+		case transferType == "AWS Outbound" && toLocation == "External":
+			if prices.InternetEgress, err = egressTiers(pd); err != nil {
+				log.WithError(err).Debug("could not get the internet egress prices")
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// getProductFamily returns the product family of a price list item
+func (pd *priceData) getProductFamily() (string, error) {
+	productMap, err := getMapForKey("product", pd.awsData)
+	if err != nil {
+		return "", err
+	}
+	if family, ok := productMap["productFamily"].(string); ok {
+		return family, nil
+	}
+	return "", fmt.Errorf("could not get the product family")
+}
+
+// onDemandPrice returns the parsed on demand price of a price list item
+func onDemandPrice(pd *priceData) (float64, error) {
+	priceStr, err := pd.GetOnDemandPrice()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(priceStr, 64)
+}
+
+// egressTiers returns the volume tiers of the on demand price dimensions of a data transfer item
+func egressTiers(pd *priceData) ([]cloudinfo.EgressTier, error) {
+	termsMap, err := getMapForKey("terms", pd.awsData)
+	if err != nil {
+		return nil, err
+	}
+	onDemandMap, err := getMapForKey("OnDemand", termsMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var tiers []cloudinfo.EgressTier
+	for _, term := range onDemandMap {
+		priceDimensionsMap, err := getMapForKey("priceDimensions", term.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		for _, dimension := range priceDimensionsMap {
+			dimensionMap := dimension.(map[string]interface{})
+			pricePerUnitMap, err := getMapForKey("pricePerUnit", dimensionMap)
+			if err != nil {
+				return nil, err
+			}
+			price, err := parseValue(pricePerUnitMap[currency])
+			if err != nil {
+				return nil, err
+			}
+			begin, err := parseValue(dimensionMap["beginRange"])
+			if err != nil {
+				return nil, err
+			}
+			end, err := parseValue(dimensionMap["endRange"])
+			if err != nil {
+				return nil, err
+			}
+			tiers = append(tiers, cloudinfo.EgressTier{StartGb: begin, EndGb: end, PricePerGb: price})
+		}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].StartGb < tiers[j].StartGb
+	})
+	return tiers, nil
+}
+
+// parseValue parses a numeric value of the price dimensions, the unbounded end of a range (Inf) is returned as 0
+func parseValue(value interface{}) (float64, error) {
+	str, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("could not cast %v to string", value)
+	}
+	if str == "Inf" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(str, 64)
+}
+
+// newGetNetworkInput creates the price list query of a service's products matching the given terms
+func newGetNetworkInput(serviceCode string, terms ...string) *pricing.GetProductsInput {
+	input := &pricing.GetProductsInput{ServiceCode: aws.String(serviceCode)}
+	for i := 0; i+1 < len(terms); i += 2 {
+		input.Filters = append(input.Filters, &pricing.Filter{
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Field: aws.String(terms[i]),
+			Value: aws.String(terms[i+1]),
+		})
+	}
+	return input
+}
@@ -0,0 +1,49 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEc2Infoer_GetNetworkPrices(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	prices, err := cloudInfoer.GetNetworkPrices(context.Background(), "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, cloudinfo.CurrencyUSD, prices.Currency)
+	assert.Equal(t, []cloudinfo.LoadBalancerPrice{
+		{Type: "application", PricePerHour: 0.027, PricePerCapacityUnitHour: 0.008},
+		{Type: "classic", PricePerHour: 0.03, PricePerGb: 0.008},
+		{Type: "network", PricePerHour: 0.027, PricePerCapacityUnitHour: 0.006},
+	}, prices.LoadBalancers)
+	assert.Equal(t, 0.01, prices.InterZonePerGb)
+	if assert.Equal(t, 4, len(prices.InternetEgress), "the inter region transfer should not be listed") {
+		assert.Equal(t, cloudinfo.EgressTier{StartGb: 0, EndGb: 10240, PricePerGb: 0.09}, prices.InternetEgress[0])
+		assert.Equal(t, cloudinfo.EgressTier{StartGb: 153600, EndGb: 0, PricePerGb: 0.05}, prices.InternetEgress[3])
+	}
+}
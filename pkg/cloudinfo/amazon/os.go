@@ -0,0 +1,46 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// oses maps the operatingSystem and preInstalledSw attributes of the pricing API to operating systems / licenses
+var oses = map[string]map[string]string{
+	"Linux": {"NA": cloudinfo.OsLinux},
+	"RHEL":  {"NA": cloudinfo.OsRhel},
+	"SUSE":  {"NA": cloudinfo.OsSuse},
+	"Windows": {
+		"NA":      cloudinfo.OsWindows,
+		"SQL Web": cloudinfo.OsWindowsSqlWeb,
+		"SQL Std": cloudinfo.OsWindowsSqlStandard,
+		"SQL Ent": cloudinfo.OsWindowsSqlEnterprise,
+	},
+}
+
+// getOs returns the operating system / license of the product, or an empty string if it's not supported
+// products without operating system information are considered to be plain linux
+func getOs(pd *priceData) string {
+	os, err := pd.GetDataForKey("operatingSystem")
+	if err != nil {
+		os = "Linux"
+	}
+	sw, err := pd.GetDataForKey("preInstalledSw")
+	if err != nil {
+		sw = "NA"
+	}
+	return oses[os][sw]
+}
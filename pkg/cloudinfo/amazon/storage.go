@@ -0,0 +1,180 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/logger"
+)
+
+// volumeType describes an ebs volume type
+type volumeType struct {
+	name    string
+	minSize float64
+}
+
+// volumeTypes maps the volume types of the price list to the ebs volume types, the minimum sizes are not listed
+var volumeTypes = map[string]volumeType{
+	"General Purpose":          {name: "gp2", minSize: 1},
+	"Provisioned IOPS":         {name: "io1", minSize: 4},
+	"Throughput Optimized HDD": {name: "st1", minSize: 500},
+	"Cold HDD":                 {name: "sc1", minSize: 500},
+	"Magnetic":                 {name: "standard", minSize: 1},
+}
+
+// GetStorage retrieves the ebs volume types and their prices in the given region
+// The capacity is priced per GB-month, the provisioned IOPS (io1 volumes) per IOPS-month
+func (e *Ec2Infoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	log := logger.Extract(ctx)
+
+	volumes, err := e.pricingSvc.GetPriceList(e.newGetStorageInput(region, "Storage", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	storage := make(map[string]cloudinfo.StorageInfo)
+	for _, volume := range volumes {
+		pd, err := newPriceData(volume)
+		if err != nil {
+			log.WithError(err).Warn("could not extract storage pricing info")
+			continue
+		}
+		vt, price, err := volumePrice(pd)
+		if err != nil {
+			log.WithError(err).Debug("could not get the price of the volume type")
+			continue
+		}
+
+		// magnetic volumes are backed by hdd as well
+		category := cloudinfo.DiskTypeHdd
+		if media, _ := pd.GetDataForKey("storageMedia"); strings.HasPrefix(media, "SSD") {
+			category = cloudinfo.DiskTypeSsd
+		}
+		maxSize, _ := pd.GetDataForKey("maxVolumeSize")
+		storage[vt.name] = cloudinfo.StorageInfo{
+			Type:            vt.name,
+			Category:        category,
+			PricePerGbMonth: price,
+			MinSize:         vt.minSize,
+			MaxSize:         parseVolumeSize(maxSize),
+			Currency:        currency,
+		}
+	}
+
+	iops, err := e.pricingSvc.GetPriceList(e.newGetStorageInput(region, "System Operation", "EBS IOPS"))
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range iops {
+		pd, err := newPriceData(op)
+		if err != nil {
+			log.WithError(err).Warn("could not extract iops pricing info")
+			continue
+		}
+		vt, price, err := volumePrice(pd)
+		if err != nil {
+			log.WithError(err).Debug("could not get the iops price of the volume type")
+			continue
+		}
+		if s, ok := storage[vt.name]; ok {
+			s.PricePerIopsMonth = price
+			storage[vt.name] = s
+		}
+	}
+
+	values := make([]cloudinfo.StorageInfo, 0, len(storage))
+	for _, s := range storage {
+		values = append(values, s)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Type < values[j].Type
+	})
+	return values, nil
+}
+
+// volumePrice returns the volume type and the on demand price of a storage related product
+func volumePrice(pd *priceData) (volumeType, float64, error) {
+	name, err := pd.GetDataForKey("volumeType")
+	if err != nil {
+		return volumeType{}, 0, err
+	}
+	vt, ok := volumeTypes[name]
+	if !ok {
+		return volumeType{}, 0, fmt.Errorf("unknown volume type: %s", name)
+	}
+	priceStr, err := pd.GetOnDemandPrice()
+	if err != nil {
+		return volumeType{}, 0, err
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil {
+		return volumeType{}, 0, err
+	}
+	return vt, price, nil
+}
+
+// parseVolumeSize parses the maximum volume size of the price list in GiB, eg.: 16 TiB -> 16384
+func parseVolumeSize(size string) float64 {
+	parts := strings.Fields(size)
+	if len(parts) != 2 {
+		return 0
+	}
+	value, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0
+	}
+	switch parts[1] {
+	case "TiB", "TB":
+		return value * 1024
+	case "GiB", "GB":
+		return value
+	}
+	return 0
+}
+
+// newGetStorageInput creates the price list query of the given product family (and group) in a region
+func (e *Ec2Infoer) newGetStorageInput(regionId, productFamily, group string) *pricing.GetProductsInput {
+	input := &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonEC2"),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("location"),
+				Value: aws.String(e.GetRegion(regionId).Description()),
+			},
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("productFamily"),
+				Value: aws.String(productFamily),
+			},
+		},
+	}
+	if group != "" {
+		input.Filters = append(input.Filters, &pricing.Filter{
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Field: aws.String("group"),
+			Value: aws.String(group),
+		})
+	}
+	return input
+}
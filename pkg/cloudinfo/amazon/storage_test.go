@@ -0,0 +1,60 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEc2Infoer_GetStorage(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	cloudInfoer, err := NewEc2Infoer(context.Background(), "", "", 0, replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	storage, err := cloudInfoer.GetStorage(context.Background(), "eu-central-1")
+	assert.Nil(t, err, "the error should be nil")
+	if assert.Equal(t, 5, len(storage)) {
+		assert.Equal(t, cloudinfo.StorageInfo{
+			Type:            "gp2",
+			Category:        cloudinfo.DiskTypeSsd,
+			PricePerGbMonth: 0.119,
+			MinSize:         1,
+			MaxSize:         16384,
+			Currency:        cloudinfo.CurrencyUSD,
+		}, storage[0])
+		assert.Equal(t, "io1", storage[1].Type)
+		assert.Equal(t, 0.078, storage[1].PricePerIopsMonth, "the provisioned iops should be priced")
+		assert.Equal(t, "sc1", storage[2].Type)
+		assert.Equal(t, cloudinfo.DiskTypeHdd, storage[2].Category)
+		assert.Equal(t, cloudinfo.DiskTypeHdd, storage[4].Category)
+		assert.Equal(t, float64(1024), storage[4].MaxSize)
+	}
+}
+
+func TestParseVolumeSize(t *testing.T) {
+	assert.Equal(t, float64(16384), parseVolumeSize("16 TiB"))
+	assert.Equal(t, float64(500), parseVolumeSize("500 GiB"))
+	assert.Equal(t, float64(0), parseVolumeSize("unlimited"))
+}
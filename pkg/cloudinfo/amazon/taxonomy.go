@@ -0,0 +1,37 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// taxonomyRules classifies the instance types by their family prefix, eg.: m5d.large is the large size of the 5th generation m5d family
+var taxonomyRules = []cloudinfo.TaxonomyRule{
+	familyRule("t", cloudinfo.CategoryBurstable),
+	familyRule("m|a", cloudinfo.CategoryGeneral),
+	familyRule("c", cloudinfo.CategoryCompute),
+	familyRule("r|x|z|u-[0-9]+tb", cloudinfo.CategoryMemory),
+	familyRule("i|d|h", cloudinfo.CategoryStorage),
+	familyRule("p|g|f|inf", cloudinfo.CategoryAccelerated),
+}
+
+// familyRule creates the rule of the families starting with one of the given prefixes
+func familyRule(prefixes, category string) cloudinfo.TaxonomyRule {
+	return cloudinfo.NewTaxonomyRule(
+		fmt.Sprintf(`^(?P<family>(?:%s)(?P<generation>[0-9])[a-z-]*)\.(?P<size>[a-z0-9]+)$`, prefixes), "${family}", category)
+}
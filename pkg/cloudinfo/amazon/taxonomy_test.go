@@ -0,0 +1,81 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyRules(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		expected     cloudinfo.VmInfo
+	}{
+		{
+			instanceType: "m5.large",
+			expected:     cloudinfo.VmInfo{Type: "m5.large", Category: cloudinfo.CategoryGeneral, Family: "m5", Generation: 5, Size: "large"},
+		},
+		{
+			instanceType: "m5dn.4xlarge",
+			expected:     cloudinfo.VmInfo{Type: "m5dn.4xlarge", Category: cloudinfo.CategoryGeneral, Family: "m5dn", Generation: 5, Size: "4xlarge"},
+		},
+		{
+			instanceType: "a1.medium",
+			expected:     cloudinfo.VmInfo{Type: "a1.medium", Category: cloudinfo.CategoryGeneral, Family: "a1", Generation: 1, Size: "medium"},
+		},
+		{
+			instanceType: "t3a.micro",
+			expected:     cloudinfo.VmInfo{Type: "t3a.micro", Category: cloudinfo.CategoryBurstable, Family: "t3a", Generation: 3, Size: "micro"},
+		},
+		{
+			instanceType: "c5n.18xlarge",
+			expected:     cloudinfo.VmInfo{Type: "c5n.18xlarge", Category: cloudinfo.CategoryCompute, Family: "c5n", Generation: 5, Size: "18xlarge"},
+		},
+		{
+			instanceType: "x1e.32xlarge",
+			expected:     cloudinfo.VmInfo{Type: "x1e.32xlarge", Category: cloudinfo.CategoryMemory, Family: "x1e", Generation: 1, Size: "32xlarge"},
+		},
+		{
+			instanceType: "u-6tb1.metal",
+			expected:     cloudinfo.VmInfo{Type: "u-6tb1.metal", Category: cloudinfo.CategoryMemory, Family: "u-6tb1", Generation: 1, Size: "metal"},
+		},
+		{
+			instanceType: "i3en.large",
+			expected:     cloudinfo.VmInfo{Type: "i3en.large", Category: cloudinfo.CategoryStorage, Family: "i3en", Generation: 3, Size: "large"},
+		},
+		{
+			instanceType: "p3dn.24xlarge",
+			expected:     cloudinfo.VmInfo{Type: "p3dn.24xlarge", Category: cloudinfo.CategoryAccelerated, Family: "p3dn", Generation: 3, Size: "24xlarge"},
+		},
+		{
+			instanceType: "inf1.xlarge",
+			expected:     cloudinfo.VmInfo{Type: "inf1.xlarge", Category: cloudinfo.CategoryAccelerated, Family: "inf1", Generation: 1, Size: "xlarge"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			assert.True(t, vm.Classify(taxonomyRules), "the instance type should be classified")
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+
+	vm := cloudinfo.VmInfo{Type: "mac1.metal"}
+	assert.False(t, vm.Classify(taxonomyRules), "unknown instance types should not be classified")
+	assert.Equal(t, "", vm.Category)
+}
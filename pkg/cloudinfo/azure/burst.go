@@ -0,0 +1,51 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// bSeriesCredits holds the baseline performance of the B series sizes as published (a percentage of a single vCPU, summed
+// over the vCPUs of the size) and the cpu credits banked per hour
+var bSeriesCredits = map[string]cloudinfo.BurstInfo{
+	"Standard_B1ls":  {BaselineCpu: 5, CreditsPerHour: 3},
+	"Standard_B1s":   {BaselineCpu: 10, CreditsPerHour: 6},
+	"Standard_B1ms":  {BaselineCpu: 20, CreditsPerHour: 12},
+	"Standard_B2s":   {BaselineCpu: 40, CreditsPerHour: 24},
+	"Standard_B2ms":  {BaselineCpu: 60, CreditsPerHour: 36},
+	"Standard_B4ms":  {BaselineCpu: 90, CreditsPerHour: 54},
+	"Standard_B8ms":  {BaselineCpu: 135, CreditsPerHour: 81},
+	"Standard_B12ms": {BaselineCpu: 202, CreditsPerHour: 121},
+	"Standard_B16ms": {BaselineCpu: 270, CreditsPerHour: 162},
+	"Standard_B20ms": {BaselineCpu: 337, CreditsPerHour: 202},
+}
+
+// burstInfo returns the burst details of the B series sizes, nil for the rest of the sizes
+// The B series sizes are throttled to their baseline when their credits run out, they have no unlimited mode
+func burstInfo(vm cloudinfo.VmInfo) *cloudinfo.BurstInfo {
+	if vm.Category != cloudinfo.CategoryBurstable {
+		return nil
+	}
+	info, ok := bSeriesCredits[vm.Type]
+	if !ok {
+		return &cloudinfo.BurstInfo{}
+	}
+	if vm.Cpus > 0 {
+		// the baseline is given per vCPU as for the other providers
+		info.BaselineCpu /= vm.Cpus
+	}
+	return &info
+}
@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
@@ -38,8 +40,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 )
 
+// currency is the currency the rate card and the retail prices are queried in
+const currency = cloudinfo.CurrencyUSD
+
 // SpotPriceGauge collects metrics for the prometheus
 var SpotPriceGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	Namespace: "cloudinfo",
@@ -95,8 +101,11 @@ type AzureInfoer struct {
 	subscriptionsClient LocationRetriever
 	vmSizesClient       VmSizesRetriever
 	rateCardClient      PriceRetriever
+	reservationClient   ReservationPriceRetriever
 	providersClient     ProviderSource
 	containerSvcClient  *containerservice.ContainerServicesClient
+	storagePrices       map[string][]cloudinfo.StorageInfo
+	networkPrices       map[string]cloudinfo.NetworkPrices
 }
 
 // VmSizesRetriever list of operations for retrieving virtual machines information
@@ -120,11 +129,18 @@ type PriceRetriever interface {
 }
 
 // NewAzureInfoer creates a new instance of the Azure infoer
-func NewAzureInfoer(authLocation string) (*AzureInfoer, error) {
+// The Azure APIs are accessed through the given transport if it's not nil (see the recorder package)
+func NewAzureInfoer(authLocation string, transport http.RoundTripper) (*AzureInfoer, error) {
 	os.Setenv("AZURE_AUTH_LOCATION", authLocation)
 
-	authorizer, err := auth.NewAuthorizerFromFile(azure.PublicCloud.ResourceManagerEndpoint)
-	if err != nil {
+	var (
+		authorizer autorest.Authorizer
+		err        error
+	)
+	if recorder.IsReplaying(transport) {
+		// requests are not authorized as they are served from fixtures
+		authorizer = autorest.NullAuthorizer{}
+	} else if authorizer, err = auth.NewAuthorizerFromFile(azure.PublicCloud.ResourceManagerEndpoint); err != nil {
 		return nil, err
 	}
 
@@ -153,11 +169,21 @@ func NewAzureInfoer(authLocation string) (*AzureInfoer, error) {
 	containerServiceClient := containerservice.NewContainerServicesClient(auth.SubscriptionID)
 	containerServiceClient.Authorizer = authorizer
 
+	if transport != nil {
+		sender := &http.Client{Transport: transport}
+		sClient.Sender = sender
+		vmClient.Sender = sender
+		rcClient.Sender = sender
+		providersClient.Sender = sender
+		containerServiceClient.Sender = sender
+	}
+
 	return &AzureInfoer{
 		subscriptionId:      auth.SubscriptionID,
 		subscriptionsClient: sClient,
 		vmSizesClient:       vmClient,
 		rateCardClient:      rcClient,
+		reservationClient:   &retailPriceClient{httpClient: &http.Client{Transport: transport}},
 		providersClient:     providersClient,
 		containerSvcClient:  &containerServiceClient,
 	}, nil
@@ -206,11 +232,13 @@ func (a *AzureInfoer) checkRegionID(regionID string, regions map[string]string)
 	return false
 }
 
-// Initialize downloads and parses the Rate Card API's meter list on Azure
+// Initialize downloads and parses the Rate Card API's meter list on Azure, reservation prices are added from the retail prices
 func (a *AzureInfoer) Initialize(ctx context.Context) (map[string]map[string]cloudinfo.Price, error) {
 	log := logger.Extract(ctx)
 	log.Debug("initializing price info")
 	allPrices := make(map[string]map[string]cloudinfo.Price)
+	storage := make(map[string][]cloudinfo.StorageInfo)
+	var networkMeters []commerce.MeterInfo
 
 	regions, err := a.GetRegions(ctx, "compute")
 	if err != nil {
@@ -219,61 +247,135 @@ func (a *AzureInfoer) Initialize(ctx context.Context) (map[string]map[string]clo
 
 	log.Debugf("queried regions: %v", regions)
 
-	rateCardFilter := "OfferDurableId eq 'MS-AZR-0003p' and Currency eq 'USD' and Locale eq 'en-US' and RegionInfo eq 'US'"
+	rateCardFilter := fmt.Sprintf("OfferDurableId eq 'MS-AZR-0003p' and Currency eq '%s' and Locale eq 'en-US' and RegionInfo eq 'US'", currency)
 	result, err := a.rateCardClient.Get(context.TODO(), rateCardFilter)
 	if err != nil {
 		return nil, err
 	}
 	for _, v := range *result.Meters {
+		if isNetworkMeter(v) {
+			networkMeters = append(networkMeters, v)
+			continue
+		}
+		if *v.MeterCategory == "Storage" && *v.MeterRegion != "" {
+			tier, err := diskTier(v)
+			if err != nil {
+				continue
+			}
+			region, err := a.toRegionID(*v.MeterRegion, regions)
+			if err != nil {
+				log.WithError(err).Debug()
+				continue
+			}
+			storage[region] = append(storage[region], tier)
+		}
 		if *v.MeterCategory == "Virtual Machines" && len(*v.MeterTags) == 0 && *v.MeterRegion != "" {
-			if !strings.Contains(*v.MeterSubCategory, "Windows") {
-				region, err := a.toRegionID(*v.MeterRegion, regions)
-				if err != nil {
-					log.WithError(err).Debug()
-					continue
-				}
+			// the windows meters hold the license included prices of the same sizes
+			windows := strings.Contains(*v.MeterSubCategory, "Windows")
+			lowPriority := strings.Contains(*v.MeterName, "Low Priority")
+			if windows && lowPriority {
+				// spot (low priority) prices are given for linux only
+				continue
+			}
+			region, err := a.toRegionID(*v.MeterRegion, regions)
+			if err != nil {
+				log.WithError(err).Debug()
+				continue
+			}
 
-				instanceTypes := a.machineType(*v.MeterName, *v.MeterSubCategory)
+			instanceTypes := a.machineType(*v.MeterName, *v.MeterSubCategory)
 
-				var priceInUsd float64
+			var priceInUsd float64
 
-				if len(v.MeterRates) < 1 {
-					log.WithField("region", *v.MeterRegion).Debugf("%s doesn't have rate info in region %s", *v.MeterSubCategory, *v.MeterRegion)
-					continue
-				}
-				for _, rate := range v.MeterRates {
-					priceInUsd += *rate
+			if len(v.MeterRates) < 1 {
+				log.WithField("region", *v.MeterRegion).Debugf("%s doesn't have rate info in region %s", *v.MeterSubCategory, *v.MeterRegion)
+				continue
+			}
+			for _, rate := range v.MeterRates {
+				priceInUsd += *rate
+			}
+			if allPrices[region] == nil {
+				allPrices[region] = make(map[string]cloudinfo.Price)
+			}
+			for _, instanceType := range instanceTypes {
+				price := allPrices[region][instanceType]
+				price.Currency = currency
+				switch {
+				case windows:
+					price.OsPrices = price.OsPrices.With(cloudinfo.OsWindows, priceInUsd)
+				case !lowPriority:
+					price.OnDemandPrice = priceInUsd
+					price.OsPrices = price.OsPrices.With(cloudinfo.OsLinux, priceInUsd)
+				default:
+					spotPrice := make(cloudinfo.SpotPriceInfo)
+					spotPrice[region] = priceInUsd
+					price.SpotPrice = spotPrice
+					SpotPriceGauge.WithLabelValues(region, instanceType).Set(priceInUsd)
 				}
-				if allPrices[region] == nil {
-					allPrices[region] = make(map[string]cloudinfo.Price)
+
+				allPrices[region][instanceType] = price
+				log.WithField("region", region).Debugf("price info added: [machinetype=%s, price=%v]", instanceType, price)
+				mts := a.getMachineTypeVariants(instanceType)
+				for _, mt := range mts {
+					allPrices[region][mt] = price
+					log.WithField("region", region).Debugf("price info added: [machinetype=%s, price=%v]", mt, price)
 				}
-				for _, instanceType := range instanceTypes {
-					price := allPrices[region][instanceType]
-					if !strings.Contains(*v.MeterName, "Low Priority") {
-						price.OnDemandPrice = priceInUsd
-					} else {
-						spotPrice := make(cloudinfo.SpotPriceInfo)
-						spotPrice[region] = priceInUsd
-						price.SpotPrice = spotPrice
-						SpotPriceGauge.WithLabelValues(region, instanceType).Set(priceInUsd)
-					}
+			}
+		}
+	}
 
-					allPrices[region][instanceType] = price
-					log.WithField("region", region).Debugf("price info added: [machinetype=%s, price=%v]", instanceType, price)
-					mts := a.getMachineTypeVariants(instanceType)
-					for _, mt := range mts {
-						allPrices[region][mt] = price
-						log.WithField("region", region).Debugf("price info added: [machinetype=%s, price=%v]", mt, price)
-					}
+	// the sizes without linux meters can't be listed with a linux price, their windows prices are dropped
+	for region, prices := range allPrices {
+		for instanceType, price := range prices {
+			if _, ok := price.OsPrices[cloudinfo.OsLinux]; !ok && price.SpotPrice == nil {
+				log.WithField("region", region).Debugf("skipping windows only prices: [machinetype=%s]", instanceType)
+				delete(prices, instanceType)
+			}
+		}
+	}
+
+	// reservations are optional, the on demand and spot prices are kept if they can't be retrieved
+	reservations, err := a.reservationClient.getReservationPrices(ctx)
+	if err != nil {
+		log.WithError(err).Warn("could not retrieve reservation prices")
+	}
+	commitments := make(map[string]map[string][]cloudinfo.CommitmentPrice)
+	for _, r := range reservations {
+		if commitments[r.ArmRegionName] == nil {
+			commitments[r.ArmRegionName] = make(map[string][]cloudinfo.CommitmentPrice)
+		}
+		commitments[r.ArmRegionName][r.ArmSkuName] = append(commitments[r.ArmRegionName][r.ArmSkuName], reservationPrices(r)...)
+	}
+	for region, skus := range commitments {
+		for sku, cps := range skus {
+			setCommitments(allPrices[region], sku, cps)
+			// the variants of a size share its reservation prices unless they have their own
+			for _, mt := range a.getMachineTypeVariants(sku) {
+				if _, ok := skus[mt]; !ok {
+					setCommitments(allPrices[region], mt, cps)
 				}
 			}
 		}
 	}
 
+	sortStorage(storage)
+	a.storagePrices = storage
+	a.networkPrices = a.collectNetworkPrices(networkMeters, regions)
+
 	log.Debug("finished initializing price info")
 	return allPrices, nil
 }
 
+// setCommitments sets the commitment prices of an instance type, instance types without price are skipped
+func setCommitments(prices map[string]cloudinfo.Price, instanceType string, commitments []cloudinfo.CommitmentPrice) {
+	price, ok := prices[instanceType]
+	if !ok {
+		return
+	}
+	price.Commitments = commitments
+	prices[instanceType] = price
+}
+
 func (a *AzureInfoer) machineType(meterName string, subCategory string) []string {
 	var instanceTypes []string
 	name := strings.TrimSuffix(meterName, " Low Priority")
@@ -391,7 +493,7 @@ func (a *AzureInfoer) GetAttributeValues(ctx context.Context, service, attribute
 						case cloudinfo.Memory:
 							valueSet[cloudinfo.AttrValue{
 								Value:    float64(*v.MemoryInMB) / 1024,
-								StrValue: fmt.Sprintf("%v", *v.MemoryInMB),
+								StrValue: fmt.Sprintf("%v", float64(*v.MemoryInMB)/1024),
 							}] = ""
 						}
 					}
@@ -408,7 +510,7 @@ func (a *AzureInfoer) GetAttributeValues(ctx context.Context, service, attribute
 				case cloudinfo.Memory:
 					valueSet[cloudinfo.AttrValue{
 						Value:    float64(*v.MemoryInMB) / 1024,
-						StrValue: fmt.Sprintf("%v", *v.MemoryInMB),
+						StrValue: fmt.Sprintf("%v", float64(*v.MemoryInMB)/1024),
 					}] = ""
 				}
 			}
@@ -438,25 +540,13 @@ func (a *AzureInfoer) GetProducts(ctx context.Context, service, regionId string)
 		for _, v := range *vmSizes.Value {
 			for _, vm := range possibleVmTypes {
 				if string(vm) == *v.Name {
-					vms = append(vms, cloudinfo.VmInfo{
-						Type:       *v.Name,
-						Cpus:       float64(*v.NumberOfCores),
-						Mem:        float64(*v.MemoryInMB) / 1024,
-						Attributes: cloudinfo.Attributes(fmt.Sprint(*v.NumberOfCores), fmt.Sprint(float64(*v.MemoryInMB)/1024), "unknown"),
-						// TODO: netw perf
-					})
+					vms = append(vms, newVmInfo(v))
 				}
 			}
 		}
 	default:
 		for _, v := range *vmSizes.Value {
-			vms = append(vms, cloudinfo.VmInfo{
-				Type:       *v.Name,
-				Cpus:       float64(*v.NumberOfCores),
-				Mem:        float64(*v.MemoryInMB) / 1024,
-				Attributes: cloudinfo.Attributes(fmt.Sprint(*v.NumberOfCores), fmt.Sprint(float64(*v.MemoryInMB)/1024), "unknown"),
-				// TODO: netw perf
-			})
+			vms = append(vms, newVmInfo(v))
 		}
 	}
 
@@ -565,7 +655,7 @@ func (a *AzureInfoer) GetCpuAttrName() string {
 func (a *AzureInfoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
 	services := []cloudinfo.ServiceDescriber{
 		cloudinfo.NewService("compute"),
-		cloudinfo.NewService("aks")}
+		cloudinfo.NewKubernetesService("aks", cloudinfo.NewFreeControlPlane())}
 	return services, nil
 }
 
@@ -581,7 +671,7 @@ func (a *AzureInfoer) GetService(ctx context.Context, service string) (cloudinfo
 			return sd, nil
 		}
 	}
-	return nil, fmt.Errorf("the service [%s] is not supported", service)
+	return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
 
 }
 
@@ -26,6 +26,8 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-04-01/compute"
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,10 +43,12 @@ type test struct {
 }
 
 const (
-	GetVmsError      = "could not get virtual machines"
-	GetRegionsError  = "could not get regions"
-	GetLocationError = "could not get location"
-	GetPriceError    = "could not get prices"
+	GetVmsError          = "could not get virtual machines"
+	GetRegionsError      = "could not get regions"
+	GetLocationError     = "could not get location"
+	GetPriceError        = "could not get prices"
+	ListedVmPrices       = "prices of the listed virtual machines"
+	GetReservationsError = "could not get reservation prices"
 )
 
 func (dps *testStruct) List(ctx context.Context, location string) (result compute.VirtualMachineSizeListResult, err error) {
@@ -125,6 +129,31 @@ func (dps *test) Get(ctx context.Context, filter string) (result commerce.Resour
 	switch dps.TcId {
 	case GetPriceError:
 		return commerce.ResourceRateCardInfo{}, fmt.Errorf(GetPriceError)
+	case ListedVmPrices:
+		return commerce.ResourceRateCardInfo{
+			Meters: &[]commerce.MeterInfo{
+				{
+					MeterName:        strPointer("A4m v2 Low Priority"),
+					MeterCategory:    strPointer("Virtual Machines"),
+					MeterSubCategory: strPointer("Av2 Series"),
+					MeterRegion:      strPointer("EU West"),
+					MeterRates: map[string]*float64{
+						"0": floatPointer(0.071),
+					},
+					MeterTags: &[]string{},
+				},
+				{
+					MeterName:        strPointer("A4m v2"),
+					MeterCategory:    strPointer("Virtual Machines"),
+					MeterSubCategory: strPointer("Av2 Series"),
+					MeterRegion:      strPointer("EU West"),
+					MeterRates: map[string]*float64{
+						"0": floatPointer(0.355),
+					},
+					MeterTags: &[]string{},
+				},
+			},
+		}, nil
 	default:
 		return commerce.ResourceRateCardInfo{
 			Meters: &[]commerce.MeterInfo{
@@ -163,6 +192,30 @@ func (dps *test) Get(ctx context.Context, filter string) (result commerce.Resour
 	}
 }
 
+func (dps *test) getReservationPrices(ctx context.Context) ([]RetailPrice, error) {
+	switch dps.TcId {
+	case GetReservationsError:
+		return nil, fmt.Errorf(GetReservationsError)
+	default:
+		return []RetailPrice{
+			{
+				CurrencyCode:    "USD",
+				RetailPrice:     1752,
+				ArmRegionName:   "westeurope",
+				ArmSkuName:      "Standard_F2",
+				ReservationTerm: "1 Year",
+			},
+			{
+				CurrencyCode:    "USD",
+				RetailPrice:     3504,
+				ArmRegionName:   "westeurope",
+				ArmSkuName:      "Standard_F64",
+				ReservationTerm: "1 Year",
+			},
+		}, nil
+	}
+}
+
 // strPointer gets the pointer to the passed string
 func strPointer(str string) *string {
 	return &str
@@ -578,6 +631,74 @@ func TestAzureInfoer_GetProducts(t *testing.T) {
 				assert.ElementsMatch(t, mems, []float64{32, 32})
 			},
 		},
+		{
+			name:    "hardware details are derived from the vm sizes",
+			service: "compute",
+			vmSizes: &testStruct{},
+			check: func(vms []cloudinfo.VmInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				premium := make(map[string]bool)
+				for _, vm := range vms {
+					premium[vm.Type] = vm.PremiumStorage
+					assert.Equal(t, cloudinfo.ArchX86, vm.Arch)
+					assert.Equal(t, 1, vm.LocalDisks)
+					assert.Equal(t, cloudinfo.DiskTypeSsd, vm.LocalDiskType)
+				}
+				assert.Equal(t, map[string]bool{"Standard_B1ms": true, "Standard_A4m_v2": false, "Standard_D8s_v3": true}, premium)
+				assert.Equal(t, float64(64), vms[2].LocalDiskSize)
+			},
+		},
+		{
+			name:    "the vm sizes are classified by their series",
+			service: "compute",
+			vmSizes: &testStruct{},
+			check: func(vms []cloudinfo.VmInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				categories := make(map[string]string)
+				for _, vm := range vms {
+					categories[vm.Type] = vm.Category
+				}
+				assert.Equal(t, map[string]string{
+					"Standard_B1ms":   cloudinfo.CategoryBurstable,
+					"Standard_A4m_v2": cloudinfo.CategoryGeneral,
+					"Standard_D8s_v3": cloudinfo.CategoryGeneral,
+				}, categories)
+			},
+		},
+		{
+			name:    "the B series sizes are burstable",
+			service: "compute",
+			vmSizes: &testStruct{},
+			check: func(vms []cloudinfo.VmInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				bursts := make(map[string]*cloudinfo.BurstInfo)
+				for _, vm := range vms {
+					bursts[vm.Type] = vm.BurstInfo
+				}
+				assert.Equal(t, map[string]*cloudinfo.BurstInfo{
+					"Standard_B1ms":   {BaselineCpu: 20, CreditsPerHour: 12},
+					"Standard_A4m_v2": nil,
+					"Standard_D8s_v3": nil,
+				}, bursts)
+			},
+		},
+		{
+			name:    "the bandwidth is derived from the series and the number of vCPUs",
+			service: "compute",
+			vmSizes: &testStruct{},
+			check: func(vms []cloudinfo.VmInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				bandwidths := make(map[string]float64)
+				for _, vm := range vms {
+					bandwidths[vm.Type] = vm.Bandwidth
+				}
+				assert.Equal(t, map[string]float64{
+					"Standard_B1ms":   0,
+					"Standard_A4m_v2": 1,
+					"Standard_D8s_v3": 4,
+				}, bandwidths)
+			},
+		},
 		{
 			name:    "could not retrieve virtual machines",
 			service: "compute",
@@ -761,17 +882,19 @@ func TestAzureInfoer_GetAttributeValues(t *testing.T) {
 
 func TestAzureInfoer_Initialize(t *testing.T) {
 	tests := []struct {
-		name      string
-		location  LocationRetriever
-		providers ProviderSource
-		price     PriceRetriever
-		check     func(prices map[string]map[string]cloudinfo.Price, err error)
+		name         string
+		location     LocationRetriever
+		providers    ProviderSource
+		price        PriceRetriever
+		reservations ReservationPriceRetriever
+		check        func(prices map[string]map[string]cloudinfo.Price, err error)
 	}{
 		{
-			name:      "success",
-			location:  &testStruct{},
-			providers: &testStruct{},
-			price:     &test{},
+			name:         "success",
+			location:     &testStruct{},
+			providers:    &testStruct{},
+			price:        &test{},
+			reservations: &test{},
 			check: func(prices map[string]map[string]cloudinfo.Price, err error) {
 				var onDemandPrice []float64
 				var spotPrice []float64
@@ -786,23 +909,43 @@ func TestAzureInfoer_Initialize(t *testing.T) {
 				assert.ElementsMatch(t, onDemandPrice, []float64{0.332, 0.332, 0.132, 0.132})
 				assert.ElementsMatch(t, spotPrice, []float64{0.077, 0.077})
 				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []cloudinfo.CommitmentPrice{
+					cloudinfo.NewCommitmentPrice(cloudinfo.Term1Year, cloudinfo.PaymentAllUpfront, 1752, 0),
+					cloudinfo.NewCommitmentPrice(cloudinfo.Term1Year, cloudinfo.PaymentNoUpfront, 0, 0.2),
+				}, prices["westeurope"]["Standard_F2"].Commitments)
+				assert.Equal(t, prices["westeurope"]["Standard_F2"].Commitments, prices["westeurope"]["Standard_F2s"].Commitments,
+					"the variants should share the reservation prices of the size")
 			},
 		},
 		{
-			name:      "could not retrieve regions",
-			location:  &testStruct{GetRegionsError},
-			providers: &testStruct{},
-			price:     &test{},
+			name:         "reservation prices are optional",
+			location:     &testStruct{},
+			providers:    &testStruct{},
+			price:        &test{},
+			reservations: &test{GetReservationsError},
+			check: func(prices map[string]map[string]cloudinfo.Price, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, 0.332, prices["westeurope"]["Standard_F2"].OnDemandPrice)
+				assert.Nil(t, prices["westeurope"]["Standard_F2"].Commitments, "the commitments should be nil")
+			},
+		},
+		{
+			name:         "could not retrieve regions",
+			location:     &testStruct{GetRegionsError},
+			providers:    &testStruct{},
+			price:        &test{},
+			reservations: &test{},
 			check: func(prices map[string]map[string]cloudinfo.Price, err error) {
 				assert.EqualError(t, err, GetRegionsError)
 				assert.Nil(t, prices, "the prices should be nil")
 			},
 		},
 		{
-			name:      "could not retrieve prices",
-			location:  &testStruct{},
-			providers: &testStruct{},
-			price:     &test{GetPriceError},
+			name:         "could not retrieve prices",
+			location:     &testStruct{},
+			providers:    &testStruct{},
+			price:        &test{GetPriceError},
+			reservations: &test{},
 			check: func(prices map[string]map[string]cloudinfo.Price, err error) {
 				assert.EqualError(t, err, GetPriceError)
 				assert.Nil(t, prices, "the prices should be nil")
@@ -816,7 +959,77 @@ func TestAzureInfoer_Initialize(t *testing.T) {
 			azureInfoer.subscriptionsClient = test.location
 			azureInfoer.providersClient = test.providers
 			azureInfoer.rateCardClient = test.price
+			azureInfoer.reservationClient = test.reservations
 			test.check(azureInfoer.Initialize(context.TODO()))
 		})
 	}
 }
+
+func TestAzureInfoer_Conformance(t *testing.T) {
+	azureInfoer := AzureInfoer{
+		subscriptionsClient: &testStruct{},
+		vmSizesClient:       &testStruct{},
+		rateCardClient:      &test{ListedVmPrices},
+		reservationClient:   &test{},
+		providersClient:     &testStruct{},
+	}
+
+	cloudinfotest.RunConformance(t, &azureInfoer, cloudinfotest.Options{
+		Regions: []string{"westeurope"},
+	})
+}
+
+func TestAzureInfoer_Replay(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	azureInfoer, err := NewAzureInfoer("testdata/auth.json", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	prices, err := azureInfoer.Initialize(context.Background())
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, 1, len(prices))
+	price := prices["westeurope"]["Standard_A4m_v2"]
+	assert.Equal(t, 0.355, price.OnDemandPrice)
+	assert.Equal(t, cloudinfo.CurrencyUSD, price.Currency)
+	assert.Equal(t, cloudinfo.SpotPriceInfo{"westeurope": 0.071}, price.SpotPrice)
+	assert.Equal(t, cloudinfo.OsPrices{cloudinfo.OsLinux: 0.355, cloudinfo.OsWindows: 0.532}, price.OsPrices)
+	assert.NotContains(t, prices["westeurope"], "Standard_A8m_v2", "the sizes with windows prices only should be skipped")
+	if assert.Equal(t, 4, len(price.Commitments)) {
+		assert.Equal(t, cloudinfo.Term3Year, price.Commitments[2].Term)
+		assert.Equal(t, 2628.0, price.Commitments[2].Upfront)
+		assert.InDelta(t, 0.1, price.Commitments[3].EffectiveHourly, 1e-9)
+	}
+
+	storage, err := azureInfoer.GetStorage(context.Background(), "westeurope")
+	assert.Nil(t, err, "the error should be nil")
+	if assert.Equal(t, 3, len(storage), "only the managed disk tiers should be listed") {
+		assert.Equal(t, "Premium_LRS", storage[0].Type)
+		assert.Equal(t, "P10", storage[0].Tier)
+		assert.Equal(t, cloudinfo.DiskTypeSsd, storage[0].Category)
+		assert.Equal(t, 128.0, storage[0].MaxSize)
+		assert.InDelta(t, 0.154, storage[0].PricePerGbMonth, 1e-3)
+		assert.Equal(t, "StandardSSD_LRS", storage[1].Type)
+		assert.Equal(t, "Standard_LRS", storage[2].Type)
+		assert.Equal(t, cloudinfo.DiskTypeHdd, storage[2].Category)
+	}
+
+	network, err := azureInfoer.GetNetworkPrices(context.Background(), "westeurope")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, []cloudinfo.LoadBalancerPrice{{Type: "standard", PricePerHour: 0.025, PricePerGb: 0.005}}, network.LoadBalancers)
+	assert.Equal(t, 0.01, network.InterZonePerGb)
+	if assert.Equal(t, 5, len(network.InternetEgress), "the egress should be priced for the billing zone of the region") {
+		assert.Equal(t, cloudinfo.EgressTier{StartGb: 5, EndGb: 10240, PricePerGb: 0.087}, network.InternetEgress[1])
+		assert.Equal(t, cloudinfo.EgressTier{StartGb: 153600, PricePerGb: 0.05}, network.InternetEgress[4])
+	}
+}
+
+func TestBillingZone(t *testing.T) {
+	assert.Equal(t, "Zone 1", billingZone("westeurope"))
+	assert.Equal(t, "Zone 1", billingZone("eastus2"))
+	assert.Equal(t, "Zone 2", billingZone("japaneast"))
+	assert.Equal(t, "Zone 3", billingZone("brazilsouth"))
+}
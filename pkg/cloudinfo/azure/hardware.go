@@ -0,0 +1,121 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-04-01/compute"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// gpuSpec describes the gpus of a virtual machine series
+type gpuSpec struct {
+	model string
+	mem   float64
+}
+
+// bandwidthSpec describes the expected network bandwidth of a virtual machine series
+type bandwidthSpec struct {
+	// perCpu is the bandwidth (Mbps) of a single vCPU
+	perCpu float64
+	// max is the maximum bandwidth (Mbps) of the series, 0 if there's no cap
+	max float64
+}
+
+var (
+	// vmSizeRe splits the vm size name into family, additive features and version, eg.: Standard_NC6s_v3 -> NC, s, 3
+	vmSizeRe = regexp.MustCompile(`^Standard_([A-Z]+)\d+(?:-\d+)?([a-z]*)(?:_v(\d+))?`)
+
+	// gpuSpecs holds the gpu model and memory (GiB per gpu) of the gpu series keyed by family and version
+	gpuSpecs = map[string]gpuSpec{
+		"NC":   {model: "NVIDIA Tesla K80", mem: 12},
+		"NCv2": {model: "NVIDIA Tesla P100", mem: 16},
+		"NCv3": {model: "NVIDIA Tesla V100", mem: 16},
+		"ND":   {model: "NVIDIA Tesla P40", mem: 24},
+		"NDv2": {model: "NVIDIA Tesla V100", mem: 32},
+		"NV":   {model: "NVIDIA Tesla M60", mem: 8},
+		"NVv2": {model: "NVIDIA Tesla M60", mem: 8},
+	}
+
+	// bandwidthSpecs holds the expected network bandwidth of the series keyed by family and version
+	bandwidthSpecs = map[string]bandwidthSpec{
+		"Av2":  {perCpu: 250},
+		"Dv2":  {perCpu: 750},
+		"DSv2": {perCpu: 750},
+		"Dv3":  {perCpu: 500, max: 30000},
+		"Ev3":  {perCpu: 500, max: 30000},
+		"Fv2":  {perCpu: 437.5, max: 30000},
+		"Lv2":  {perCpu: 400, max: 16000},
+		"M":    {perCpu: 250, max: 30000},
+	}
+)
+
+// newVmInfo transforms the vm size returned by the API into a VmInfo, the hardware details are derived from the name of the size
+// The number of cores reported by the API is the number of vCPUs, the memory is given in MB
+func newVmInfo(v compute.VirtualMachineSize) cloudinfo.VmInfo {
+	vm := cloudinfo.VmInfo{
+		Type:       *v.Name,
+		Cpus:       float64(*v.NumberOfCores),
+		Mem:        float64(*v.MemoryInMB) / 1024,
+		Attributes: cloudinfo.Attributes(fmt.Sprint(*v.NumberOfCores), fmt.Sprint(float64(*v.MemoryInMB)/1024)),
+		Arch:       cloudinfo.ArchX86,
+		Hypervisor: "hyper-v",
+	}
+
+	var family, features, version string
+	if matches := vmSizeRe.FindStringSubmatch(vm.Type); matches != nil {
+		family, features, version = matches[1], matches[2], matches[3]
+	}
+
+	// premium storage capable sizes are marked with an 's' (DS and GS series are the exceptions)
+	vm.PremiumStorage = strings.Contains(features, "s") || family == "DS" || family == "GS"
+
+	key := family
+	if version != "" {
+		key = fmt.Sprintf("%sv%s", family, version)
+	}
+	if spec, ok := gpuSpecs[key]; ok {
+		vm.GpuModel = spec.model
+		vm.GpuMem = spec.mem
+	}
+
+	// the bandwidth of the series without a published value remains unknown
+	if spec, ok := bandwidthSpecs[key]; ok {
+		bandwidth := vm.Cpus * spec.perCpu
+		if spec.max > 0 && bandwidth > spec.max {
+			bandwidth = spec.max
+		}
+		vm.Bandwidth = bandwidth / 1000
+		vm.NtwPerf = fmt.Sprintf("%g Gbit/s", vm.Bandwidth)
+	}
+
+	// every size has a single temporary (resource) disk, it's an ssd except for the basic and first generation A sizes
+	if v.ResourceDiskSizeInMB != nil && *v.ResourceDiskSizeInMB > 0 {
+		vm.LocalDisks = 1
+		vm.LocalDiskSize = float64(*v.ResourceDiskSizeInMB) / 1024
+		vm.LocalDiskType = cloudinfo.DiskTypeSsd
+		if strings.HasPrefix(vm.Type, "Basic_") || (family == "A" && version == "") {
+			vm.LocalDiskType = cloudinfo.DiskTypeHdd
+		}
+	}
+
+	vm.Classify(taxonomyRules)
+	vm.BurstInfo = burstInfo(vm)
+
+	return vm
+}
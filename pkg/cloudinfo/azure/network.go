@@ -0,0 +1,143 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// the meters of the standard load balancer, the rules above the first five are charged separately
+const (
+	lbRulesMeter         = "Standard Included LB Rules and Outbound Rules"
+	lbDataProcessedMeter = "Standard Data Processed"
+)
+
+// the bandwidth meters, priced per billing zone
+const (
+	egressMeter    = "Standard Data Transfer Out"
+	interZoneMeter = "Inter-Availability Zone Data Transfer Out"
+)
+
+// billingZonePrefixes lists the region prefixes of the billing zones other than Zone 1 (North America, Europe)
+var billingZonePrefixes = map[string][]string{
+	"Zone 2": {"eastasia", "southeastasia", "japan", "australia", "korea", "centralindia", "southindia", "westindia"},
+	"Zone 3": {"brazil", "southafrica", "uae"},
+}
+
+// GetNetworkPrices returns the load balancer and the bandwidth prices in the given region
+// The prices are collected from the rate card during initialization
+func (a *AzureInfoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	if a.networkPrices == nil {
+		return cloudinfo.NetworkPrices{}, errors.New("network prices are not yet retrieved")
+	}
+	return a.networkPrices[region], nil
+}
+
+// isNetworkMeter tells whether the meter is one of the collected network meters
+func isNetworkMeter(meter commerce.MeterInfo) bool {
+	switch *meter.MeterCategory {
+	case "Load Balancer":
+		return *meter.MeterName == lbRulesMeter || *meter.MeterName == lbDataProcessedMeter
+	case "Bandwidth":
+		return *meter.MeterName == egressMeter || *meter.MeterName == interZoneMeter
+	}
+	return false
+}
+
+// collectNetworkPrices assembles the network prices of the regions from the network meters
+// The load balancer meters are given per region, the bandwidth meters per billing zone
+func (a *AzureInfoer) collectNetworkPrices(meters []commerce.MeterInfo, regions map[string]string) map[string]cloudinfo.NetworkPrices {
+	network := make(map[string]cloudinfo.NetworkPrices)
+	for region := range regions {
+		network[region] = cloudinfo.NetworkPrices{Currency: currency}
+	}
+
+	zoneMeters := make(map[string][]commerce.MeterInfo)
+	for _, meter := range meters {
+		if *meter.MeterCategory == "Bandwidth" {
+			zoneMeters[*meter.MeterRegion] = append(zoneMeters[*meter.MeterRegion], meter)
+			continue
+		}
+		region, err := a.toRegionID(*meter.MeterRegion, regions)
+		if err != nil {
+			continue
+		}
+		prices := network[region]
+		if prices.LoadBalancers == nil {
+			prices.LoadBalancers = []cloudinfo.LoadBalancerPrice{{Type: "standard"}}
+		}
+		switch *meter.MeterName {
+		case lbRulesMeter:
+			prices.LoadBalancers[0].PricePerHour = meterTiers(meter)[0].PricePerGb
+		case lbDataProcessedMeter:
+			prices.LoadBalancers[0].PricePerGb = meterTiers(meter)[0].PricePerGb
+		}
+		network[region] = prices
+	}
+
+	for region, prices := range network {
+		for _, meter := range zoneMeters[billingZone(region)] {
+			switch *meter.MeterName {
+			case egressMeter:
+				prices.InternetEgress = meterTiers(meter)
+			case interZoneMeter:
+				prices.InterZonePerGb = meterTiers(meter)[0].PricePerGb
+			}
+		}
+		network[region] = prices
+	}
+	return network
+}
+
+// meterTiers returns the rates of the meter as tiers ordered by their start, the rates are keyed by the start of the tiers
+func meterTiers(meter commerce.MeterInfo) []cloudinfo.EgressTier {
+	var tiers []cloudinfo.EgressTier
+	for start, rate := range meter.MeterRates {
+		startGb, err := strconv.ParseFloat(start, 64)
+		if err != nil {
+			continue
+		}
+		tiers = append(tiers, cloudinfo.EgressTier{StartGb: startGb, PricePerGb: *rate})
+	}
+	if len(tiers) == 0 {
+		return []cloudinfo.EgressTier{{}}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].StartGb < tiers[j].StartGb
+	})
+	for i := 0; i+1 < len(tiers); i++ {
+		tiers[i].EndGb = tiers[i+1].StartGb
+	}
+	return tiers
+}
+
+// billingZone returns the bandwidth billing zone of the region
+func billingZone(region string) string {
+	for zone, prefixes := range billingZonePrefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(region, prefix) {
+				return zone
+			}
+		}
+	}
+	return "Zone 1"
+}
@@ -0,0 +1,104 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// retailPricesUrl is the public (unauthenticated) endpoint of the retail prices, the rate card doesn't hold reservations
+const retailPricesUrl = "https://prices.azure.com/api/retail/prices"
+
+// reservationTerms maps the reservation terms of the retail prices to commitment terms
+var reservationTerms = map[string]string{
+	"1 Year":  cloudinfo.Term1Year,
+	"3 Years": cloudinfo.Term3Year,
+}
+
+// RetailPrice is a reservation price of a virtual machine size, the price is given for the whole term
+type RetailPrice struct {
+	CurrencyCode    string  `json:"currencyCode"`
+	RetailPrice     float64 `json:"retailPrice"`
+	ArmRegionName   string  `json:"armRegionName"`
+	ArmSkuName      string  `json:"armSkuName"`
+	ReservationTerm string  `json:"reservationTerm"`
+}
+
+// retailPrices is a page of the retail prices
+type retailPrices struct {
+	Items        []RetailPrice `json:"Items"`
+	NextPageLink string        `json:"NextPageLink"`
+}
+
+// ReservationPriceRetriever collects the reservation prices of the virtual machine sizes
+type ReservationPriceRetriever interface {
+	getReservationPrices(ctx context.Context) ([]RetailPrice, error)
+}
+
+type retailPriceClient struct {
+	httpClient *http.Client
+}
+
+// getReservationPrices pages through the reservation prices of the virtual machines
+func (r *retailPriceClient) getReservationPrices(ctx context.Context) ([]RetailPrice, error) {
+	query := url.Values{}
+	query.Set("currencyCode", currency)
+	query.Set("$filter", "serviceName eq 'Virtual Machines' and priceType eq 'Reservation'")
+	next := fmt.Sprintf("%s?%s", retailPricesUrl, query.Encode())
+
+	var prices []RetailPrice
+	for next != "" {
+		req, err := http.NewRequest(http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := r.httpClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		var page retailPrices
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not get reservation prices, status code: %d", resp.StatusCode)
+		}
+		prices = append(prices, page.Items...)
+		next = page.NextPageLink
+	}
+	return prices, nil
+}
+
+// reservationPrices transforms a reservation price into commitment prices
+// reservations are either paid upfront or monthly, the total price is the same
+func reservationPrices(price RetailPrice) []cloudinfo.CommitmentPrice {
+	term, ok := reservationTerms[price.ReservationTerm]
+	if !ok || price.CurrencyCode != currency {
+		return nil
+	}
+	allUpfront := cloudinfo.NewCommitmentPrice(term, cloudinfo.PaymentAllUpfront, price.RetailPrice, 0)
+	return []cloudinfo.CommitmentPrice{
+		allUpfront,
+		cloudinfo.NewCommitmentPrice(term, cloudinfo.PaymentNoUpfront, 0, allUpfront.EffectiveHourly),
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// managedDiskType describes a managed disk sku
+type managedDiskType struct {
+	name     string
+	category string
+}
+
+// managedDiskTypes maps the meter sub categories of the managed disks to the disk skus
+var managedDiskTypes = map[string]managedDiskType{
+	"Premium SSD Managed Disks":  {name: "Premium_LRS", category: cloudinfo.DiskTypeSsd},
+	"Standard SSD Managed Disks": {name: "StandardSSD_LRS", category: cloudinfo.DiskTypeSsd},
+	"Standard HDD Managed Disks": {name: "Standard_LRS", category: cloudinfo.DiskTypeHdd},
+}
+
+// diskTierRe matches the meter names of the managed disk size tiers, eg.: P10 Disks, E10 LRS Disk
+var diskTierRe = regexp.MustCompile(`^([PES](\d+))( LRS)? Disks?$`)
+
+// diskTierSizes holds the size of the managed disk tiers in GiB by the number of the tier
+var diskTierSizes = map[int]float64{
+	1:  4,
+	2:  8,
+	3:  16,
+	4:  32,
+	6:  64,
+	10: 128,
+	15: 256,
+	20: 512,
+	30: 1024,
+	40: 2048,
+	50: 4096,
+	60: 8192,
+	70: 16384,
+	80: 32767,
+}
+
+// GetStorage returns the managed disk tiers and their prices in the given region
+// Managed disks are billed per size tier, the prices are collected from the rate card during initialization
+func (a *AzureInfoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	if a.storagePrices == nil {
+		return nil, errors.New("storage prices are not yet retrieved")
+	}
+	return a.storagePrices[region], nil
+}
+
+// diskTier returns the size tier of a managed disk meter, meters of other storage products are reported as errors
+func diskTier(meter commerce.MeterInfo) (cloudinfo.StorageInfo, error) {
+	dt, ok := managedDiskTypes[*meter.MeterSubCategory]
+	if !ok {
+		return cloudinfo.StorageInfo{}, fmt.Errorf("not a managed disk meter: %s", *meter.MeterSubCategory)
+	}
+	match := diskTierRe.FindStringSubmatch(*meter.MeterName)
+	if match == nil {
+		return cloudinfo.StorageInfo{}, fmt.Errorf("not a managed disk tier: %s", *meter.MeterName)
+	}
+	number, _ := strconv.Atoi(match[2])
+	size, ok := diskTierSizes[number]
+	if !ok {
+		return cloudinfo.StorageInfo{}, fmt.Errorf("unknown managed disk tier: %s", match[1])
+	}
+
+	var monthlyPrice float64
+	for _, rate := range meter.MeterRates {
+		monthlyPrice += *rate
+	}
+	return cloudinfo.StorageInfo{
+		Type:            dt.name,
+		Tier:            match[1],
+		Category:        dt.category,
+		PricePerGbMonth: monthlyPrice / size,
+		MaxSize:         size,
+		Currency:        currency,
+	}, nil
+}
+
+// sortStorage orders the managed disk tiers by sku and size
+func sortStorage(storage map[string][]cloudinfo.StorageInfo) {
+	for _, tiers := range storage {
+		sort.Slice(tiers, func(i, j int) bool {
+			if tiers[i].Type != tiers[j].Type {
+				return tiers[i].Type < tiers[j].Type
+			}
+			return tiers[i].MaxSize < tiers[j].MaxSize
+		})
+	}
+}
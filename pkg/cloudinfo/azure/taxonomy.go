@@ -0,0 +1,39 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// taxonomyRules classifies the vm sizes by their series, eg.: Standard_D4s_v3 is the size 4 of the 3rd version of the D series
+// (family Dv3); the sizes of the unversioned series have no generation
+var taxonomyRules = []cloudinfo.TaxonomyRule{
+	seriesRule("B", cloudinfo.CategoryBurstable),
+	seriesRule("A|D|DS|DC", cloudinfo.CategoryGeneral),
+	seriesRule("F|FS|H|HB|HC", cloudinfo.CategoryCompute),
+	seriesRule("E|G|GS|M", cloudinfo.CategoryMemory),
+	seriesRule("L|LS", cloudinfo.CategoryStorage),
+	seriesRule("N[A-Z]", cloudinfo.CategoryAccelerated),
+}
+
+// seriesRule creates the rule of the sizes of the given series, the constrained vCPU (eg.: E4-2s_v3) and promo sizes included
+func seriesRule(series, category string) cloudinfo.TaxonomyRule {
+	return cloudinfo.NewTaxonomyRule(
+		fmt.Sprintf(`^(?:Standard|Basic)_(?P<series>%s)(?P<size>[0-9]+)(?:-[0-9]+)?[a-z]*(?:_(?P<version>v(?P<generation>[0-9]+)))?(?:_Promo)?$`, series),
+		"${series}${version}", category)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyRules(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		expected     cloudinfo.VmInfo
+	}{
+		{
+			instanceType: "Standard_D2s_v3",
+			expected:     cloudinfo.VmInfo{Type: "Standard_D2s_v3", Category: cloudinfo.CategoryGeneral, Family: "Dv3", Generation: 3, Size: "2"},
+		},
+		{
+			instanceType: "Standard_DS2_v2_Promo",
+			expected:     cloudinfo.VmInfo{Type: "Standard_DS2_v2_Promo", Category: cloudinfo.CategoryGeneral, Family: "DSv2", Generation: 2, Size: "2"},
+		},
+		{
+			instanceType: "Basic_A0",
+			expected:     cloudinfo.VmInfo{Type: "Basic_A0", Category: cloudinfo.CategoryGeneral, Family: "A", Generation: 0, Size: "0"},
+		},
+		{
+			instanceType: "Standard_B1ms",
+			expected:     cloudinfo.VmInfo{Type: "Standard_B1ms", Category: cloudinfo.CategoryBurstable, Family: "B", Generation: 0, Size: "1"},
+		},
+		{
+			instanceType: "Standard_F8s_v2",
+			expected:     cloudinfo.VmInfo{Type: "Standard_F8s_v2", Category: cloudinfo.CategoryCompute, Family: "Fv2", Generation: 2, Size: "8"},
+		},
+		{
+			instanceType: "Standard_HB60rs",
+			expected:     cloudinfo.VmInfo{Type: "Standard_HB60rs", Category: cloudinfo.CategoryCompute, Family: "HB", Generation: 0, Size: "60"},
+		},
+		{
+			instanceType: "Standard_E4-2s_v3",
+			expected:     cloudinfo.VmInfo{Type: "Standard_E4-2s_v3", Category: cloudinfo.CategoryMemory, Family: "Ev3", Generation: 3, Size: "4"},
+		},
+		{
+			instanceType: "Standard_M128ms",
+			expected:     cloudinfo.VmInfo{Type: "Standard_M128ms", Category: cloudinfo.CategoryMemory, Family: "M", Generation: 0, Size: "128"},
+		},
+		{
+			instanceType: "Standard_L8s_v2",
+			expected:     cloudinfo.VmInfo{Type: "Standard_L8s_v2", Category: cloudinfo.CategoryStorage, Family: "Lv2", Generation: 2, Size: "8"},
+		},
+		{
+			instanceType: "Standard_NC6s_v3",
+			expected:     cloudinfo.VmInfo{Type: "Standard_NC6s_v3", Category: cloudinfo.CategoryAccelerated, Family: "NCv3", Generation: 3, Size: "6"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			assert.True(t, vm.Classify(taxonomyRules), "the instance type should be classified")
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+
+	vm := cloudinfo.VmInfo{Type: "Standard_X2"}
+	assert.False(t, vm.Classify(taxonomyRules), "unknown instance types should not be classified")
+	assert.Equal(t, "", vm.Category)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// bandwidthRe matches the network performance descriptions with a number, eg.: 10 Gigabit, Up to 25 Gigabit, 16.4 Gbps, 500 Mbps
+var bandwidthRe = regexp.MustCompile(`(?i)^\s*(up to\s+)?([0-9]+(?:\.[0-9]+)?)\s*(gigabit|gbps|gbit/s|megabit|mbps|mbit/s)\s*$`)
+
+// ParseBandwidth returns the bandwidth in Gbps described by a network performance string, and whether it's a burst ("up to") value
+func ParseBandwidth(ntwPerf string) (float64, bool, error) {
+	matches := bandwidthRe.FindStringSubmatch(ntwPerf)
+	if matches == nil {
+		return 0, false, fmt.Errorf("could not parse network performance: [%s]", ntwPerf)
+	}
+	bandwidth, err := strconv.ParseFloat(matches[2], 64)
+	if err != nil {
+		return 0, false, err
+	}
+	if strings.HasPrefix(strings.ToLower(matches[3]), "m") {
+		bandwidth /= 1000
+	}
+	return bandwidth, matches[1] != "", nil
+}
+
+// NetworkBounds holds the lower bounds of the network performance categories in Gbps, the bandwidths below the medium
+// bound are in the low category
+type NetworkBounds struct {
+	Medium float64 `json:"medium"`
+	High   float64 `json:"high"`
+	Extra  float64 `json:"extra"`
+}
+
+// validate checks that the bounds are positive and increasing
+func (nb NetworkBounds) validate() error {
+	if nb.Medium <= 0 || nb.High <= nb.Medium || nb.Extra <= nb.High {
+		return fmt.Errorf("the bounds of the network categories should be positive and increasing: %+v", nb)
+	}
+	return nil
+}
+
+// Category returns the network performance category of the bandwidth (Gbps), empty if the bandwidth is unknown
+// The burst ("up to") bandwidths are categorized by their peak
+func (nb NetworkBounds) Category(bandwidth float64) string {
+	switch {
+	case bandwidth <= 0:
+		return ""
+	case bandwidth >= nb.Extra:
+		return NTW_EXTRA
+	case bandwidth >= nb.High:
+		return NTW_HIGH
+	case bandwidth >= nb.Medium:
+		return NTW_MEDIUM
+	default:
+		return NTW_LOW
+	}
+}
+
+// NetworkCategories holds the bounds of the network performance categories, and the bounds of the providers that
+// categorize their bandwidths differently
+type NetworkCategories struct {
+	NetworkBounds
+	// Providers holds the bounds used for the products of a provider instead of the common ones, keyed by the provider
+	Providers map[string]NetworkBounds `json:"providers,omitempty"`
+}
+
+// DefaultNetworkCategories returns the network performance categories used without configuration
+// The named network performance levels of amazon (eg.: Moderate or High, about 0.5 and 1 Gbps) and the oracle shapes
+// above 1 Gbps are categorized one category higher than the bandwidths of the other providers
+func DefaultNetworkCategories() NetworkCategories {
+	return NetworkCategories{
+		NetworkBounds: NetworkBounds{Medium: 2.5, High: 10, Extra: 16},
+		Providers: map[string]NetworkBounds{
+			"amazon": {Medium: 0.5, High: 10, Extra: 16},
+			"oracle": {Medium: 1, High: 4, Extra: 16},
+		},
+	}
+}
+
+// NewNetworkCategories loads the network performance categories from the given json file
+// The default categories are returned without a file, the bounds of the providers missing from the file are kept
+func NewNetworkCategories(path string) (NetworkCategories, error) {
+	if path == "" {
+		return DefaultNetworkCategories(), nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return NetworkCategories{}, fmt.Errorf("could not read the network categories: %s", err)
+	}
+	categories := NetworkCategories{Providers: DefaultNetworkCategories().Providers}
+	if err := json.Unmarshal(data, &categories); err != nil {
+		return NetworkCategories{}, fmt.Errorf("could not parse the network categories: %s", err)
+	}
+	if err := categories.validate(); err != nil {
+		return NetworkCategories{}, err
+	}
+	for provider, bounds := range categories.Providers {
+		if err := bounds.validate(); err != nil {
+			return NetworkCategories{}, fmt.Errorf("%s: %s", provider, err)
+		}
+	}
+	return categories, nil
+}
+
+// For returns the bounds of the network performance categories of the provider
+func (nc NetworkCategories) For(provider string) NetworkBounds {
+	if bounds, ok := nc.Providers[provider]; ok {
+		return bounds
+	}
+	return nc.NetworkBounds
+}
+
+// SelectMinBandwidth returns the product details with at least the given bandwidth (Gbps), burst bandwidths included
+// An empty value selects every product
+func SelectMinBandwidth(details []ProductDetails, minBandwidth string) ([]ProductDetails, error) {
+	if minBandwidth == "" {
+		return details, nil
+	}
+	min, err := strconv.ParseFloat(minBandwidth, 64)
+	if err != nil || min < 0 {
+		return nil, NewInvalidArgumentError("invalid minimum bandwidth: [%s]", minBandwidth)
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if d.Bandwidth >= min {
+			selected = append(selected, d)
+		}
+	}
+	return selected, nil
+}
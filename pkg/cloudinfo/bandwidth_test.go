@@ -0,0 +1,206 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		ntwPerf   string
+		bandwidth float64
+		upTo      bool
+	}{
+		{ntwPerf: "10 Gigabit", bandwidth: 10},
+		{ntwPerf: "Up to 25 Gigabit", bandwidth: 25, upTo: true},
+		{ntwPerf: "16.4 Gbps", bandwidth: 16.4},
+		{ntwPerf: "500 Mbps", bandwidth: 0.5},
+	}
+	for _, test := range tests {
+		t.Run(test.ntwPerf, func(t *testing.T) {
+			bandwidth, upTo, err := ParseBandwidth(test.ntwPerf)
+			assert.Nil(t, err, "the error should be nil")
+			assert.Equal(t, test.bandwidth, bandwidth)
+			assert.Equal(t, test.upTo, upTo)
+		})
+	}
+
+	_, _, err := ParseBandwidth("Moderate")
+	assert.EqualError(t, err, "could not parse network performance: [Moderate]")
+}
+
+func TestNetworkCategories_Category(t *testing.T) {
+	categories := DefaultNetworkCategories()
+
+	assert.Equal(t, "", categories.Category(0), "unknown bandwidths should not be categorized")
+	assert.Equal(t, NTW_LOW, categories.Category(1))
+	assert.Equal(t, NTW_MEDIUM, categories.Category(2.5))
+	assert.Equal(t, NTW_HIGH, categories.Category(10))
+	assert.Equal(t, NTW_EXTRA, categories.Category(25))
+}
+
+func TestDefaultNetworkCategories(t *testing.T) {
+	// the categories of the published bandwidths before they were derived from the bounds
+	tests := []struct {
+		provider   string
+		bandwidths []float64
+		category   string
+	}{
+		{provider: "google", bandwidths: []float64{1, 2}, category: NTW_LOW},
+		{provider: "google", bandwidths: []float64{4, 6, 8}, category: NTW_MEDIUM},
+		{provider: "google", bandwidths: []float64{10, 12, 14}, category: NTW_HIGH},
+		{provider: "google", bandwidths: []float64{16}, category: NTW_EXTRA},
+		{provider: "alibaba", bandwidths: []float64{0.1, 0.5, 1, 1.5, 2}, category: NTW_LOW},
+		{provider: "alibaba", bandwidths: []float64{2.5, 4, 8}, category: NTW_MEDIUM},
+		{provider: "alibaba", bandwidths: []float64{10}, category: NTW_HIGH},
+		{provider: "alibaba", bandwidths: []float64{17, 25}, category: NTW_EXTRA},
+		{provider: "oracle", bandwidths: []float64{0.6}, category: NTW_LOW},
+		{provider: "oracle", bandwidths: []float64{1, 1.2, 2, 2.4}, category: NTW_MEDIUM},
+		{provider: "oracle", bandwidths: []float64{4.1, 4.8, 8.2}, category: NTW_HIGH},
+		{provider: "oracle", bandwidths: []float64{16.4, 24.6}, category: NTW_EXTRA},
+		{provider: "amazon", bandwidths: []float64{0.05, 0.1, 0.3}, category: NTW_LOW},
+		{provider: "amazon", bandwidths: []float64{0.5, 1}, category: NTW_MEDIUM},
+		{provider: "amazon", bandwidths: []float64{10}, category: NTW_HIGH},
+		{provider: "amazon", bandwidths: []float64{20, 25}, category: NTW_EXTRA},
+	}
+	categories := DefaultNetworkCategories()
+	for _, test := range tests {
+		t.Run(test.provider+" "+test.category, func(t *testing.T) {
+			for _, bandwidth := range test.bandwidths {
+				assert.Equal(t, test.category, categories.For(test.provider).Category(bandwidth), "bandwidth: %v", bandwidth)
+			}
+		})
+	}
+}
+
+func TestNewNetworkCategories(t *testing.T) {
+	dir, err := ioutil.TempDir("", "categories")
+	if err != nil {
+		t.Fatalf("failed to create temp dir; [%s]", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	categories, err := NewNetworkCategories("")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, DefaultNetworkCategories(), categories, "the defaults should be used without a file")
+
+	tests := []struct {
+		name    string
+		content string
+		check   func(categories NetworkCategories, err error)
+	}{
+		{
+			name:    "categories are loaded",
+			content: `{"medium": 1, "high": 5, "extra": 25}`,
+			check: func(categories NetworkCategories, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, NetworkCategories{
+					NetworkBounds: NetworkBounds{Medium: 1, High: 5, Extra: 25},
+					Providers:     DefaultNetworkCategories().Providers,
+				}, categories, "the default bounds of the providers should be kept")
+			},
+		},
+		{
+			name:    "provider bounds are loaded",
+			content: `{"medium": 1, "high": 5, "extra": 25, "providers": {"google": {"medium": 2, "high": 8, "extra": 16}}}`,
+			check: func(categories NetworkCategories, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, NetworkBounds{Medium: 2, High: 8, Extra: 16}, categories.For("google"))
+				assert.Equal(t, DefaultNetworkCategories().For("amazon"), categories.For("amazon"))
+				assert.Equal(t, NetworkBounds{Medium: 1, High: 5, Extra: 25}, categories.For("azure"))
+			},
+		},
+		{
+			name:    "the bounds of a provider are not increasing",
+			content: `{"medium": 1, "high": 5, "extra": 25, "providers": {"google": {"medium": 2}}}`,
+			check: func(categories NetworkCategories, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+		{
+			name:    "the bounds are not increasing",
+			content: `{"medium": 10, "high": 5, "extra": 25}`,
+			check: func(categories NetworkCategories, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+		{
+			name:    "invalid file",
+			content: `categories`,
+			check: func(categories NetworkCategories, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, "categories.json")
+			if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+				t.Fatalf("failed to write categories; [%s]", err.Error())
+			}
+			test.check(NewNetworkCategories(path))
+		})
+	}
+}
+
+func TestSelectMinBandwidth(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "m5.large", Bandwidth: 10, BandwidthUpTo: true}},
+		{VmInfo: VmInfo{Type: "c5n.18xlarge", Bandwidth: 100}},
+		{VmInfo: VmInfo{Type: "unknown"}},
+	}
+
+	tests := []struct {
+		name         string
+		minBandwidth string
+		check        func(selected []ProductDetails, err error)
+	}{
+		{
+			name:         "every product is returned without filter",
+			minBandwidth: "",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:         "products with at least the given bandwidth are selected",
+			minBandwidth: "10",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[:2], selected)
+			},
+		},
+		{
+			name:         "invalid value",
+			minBandwidth: "fast",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectMinBandwidth(details, test.minBandwidth))
+		})
+	}
+}
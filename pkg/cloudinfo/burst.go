@@ -0,0 +1,51 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"strconv"
+)
+
+// BurstInfo describes the cpu model of a burstable (shared core or cpu credit based) instance type
+type BurstInfo struct {
+	// BaselineCpu is the sustained cpu performance as a percentage of the vCPUs of the instance type, 0 if not published
+	BaselineCpu float64 `json:"baselineCpuPercent"`
+	// CreditsPerHour is the number of cpu credits (one vCPU at full utilization for one minute) earned per hour, 0 if the
+	// instance type doesn't earn credits or the rate is not published
+	CreditsPerHour float64 `json:"creditsPerHour"`
+	// Unlimited signals whether the instance type can run in unlimited mode, bursting above its earned credits for an
+	// additional charge; the rest of the burstable instance types are throttled to their baseline without credits
+	Unlimited bool `json:"unlimited"`
+}
+
+// SelectBurst returns the burstable or the non burstable product details depending on the given value
+// An empty value selects every product
+func SelectBurst(details []ProductDetails, burst string) ([]ProductDetails, error) {
+	if burst == "" {
+		return details, nil
+	}
+	b, err := strconv.ParseBool(burst)
+	if err != nil {
+		return nil, NewInvalidArgumentError("invalid burst value: [%s]", burst)
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if d.Burst == b {
+			selected = append(selected, d)
+		}
+	}
+	return selected, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVmInfo_IsBurst(t *testing.T) {
+	assert.True(t, VmInfo{Type: "Standard_B1ms", BurstInfo: &BurstInfo{BaselineCpu: 20}}.IsBurst())
+	assert.False(t, VmInfo{Type: "tenancy.large"}.IsBurst(), "the provider decides about burstability, not the name")
+}
+
+func TestSelectBurst(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "t3.micro"}, Burst: true},
+		{VmInfo: VmInfo{Type: "m5.large"}},
+	}
+
+	tests := []struct {
+		name  string
+		burst string
+		check func(selected []ProductDetails, err error)
+	}{
+		{
+			name:  "every product is returned without filter",
+			burst: "",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:  "burstable products are selected",
+			burst: "true",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[:1], selected)
+			},
+		},
+		{
+			name:  "non burstable products are selected",
+			burst: "false",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[1:], selected)
+			},
+		},
+		{
+			name:  "invalid value",
+			burst: "sometimes",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectBurst(details, test.burst))
+		})
+	}
+}
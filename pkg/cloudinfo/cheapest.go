@@ -0,0 +1,153 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// PriceTypeOnDemand ranks the regions by on demand price
+	PriceTypeOnDemand = "on-demand"
+	// PriceTypeSpot ranks the regions by the spot price of their cheapest zone
+	PriceTypeSpot = "spot"
+)
+
+// RegionQuery describes the instance type or the resources the cheapest regions are looked for
+type RegionQuery struct {
+	// InstanceType is the instance type priced in the regions, the resources are used if empty
+	InstanceType string
+	// MinShape holds the minimum resources of the instance types priced in the regions
+	MinShape Shape
+	// PriceType is the price the regions are ranked by: on-demand (default) or spot
+	PriceType string
+	// Geographies lists the geographies of the regions (every geography if empty)
+	Geographies []string
+	// MaxAge is the maximum age of the prices of a region, unbounded if 0; regions with prices of unknown age are
+	// left out if the age is bounded
+	MaxAge time.Duration
+}
+
+// RegionPrice is the lowest price of an instance type matching a query in a region
+type RegionPrice struct {
+	Provider  string `json:"provider"`
+	Region    string `json:"region"`
+	Geography string `json:"geography"`
+	// Zone is the zone of the spot price, empty for on demand prices
+	Zone string `json:"zone,omitempty"`
+	Type string `json:"type"`
+	Shape
+	// Price is the hourly on demand or spot price
+	Price float64 `json:"price"`
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency"`
+	// UpdatedAt is the retrieval time of the oldest prices of the region, zero if unknown
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Age is the age of the prices in seconds, 0 if unknown
+	Age float64 `json:"ageSeconds"`
+}
+
+// CheapestRegions ranks the regions by the lowest price of the instance type or of the instance types providing the
+// minimum resources of the query in the region, the regions without a matching priced product are left out. The
+// products of the regions should be given in the same currency
+func CheapestRegions(regions []RegionProducts, q RegionQuery, now time.Time) ([]RegionPrice, error) {
+	if q.PriceType == "" {
+		q.PriceType = PriceTypeOnDemand
+	}
+	if q.PriceType != PriceTypeOnDemand && q.PriceType != PriceTypeSpot {
+		return nil, NewInvalidArgumentError("unsupported price type: [%s]", q.PriceType)
+	}
+	if q.InstanceType == "" && q.MinShape.Cpus <= 0 && q.MinShape.Mem <= 0 && q.MinShape.Gpus <= 0 {
+		return nil, NewInvalidArgumentError("either an instance type or the minimum resources should be given")
+	}
+	if q.MinShape.Cpus < 0 || q.MinShape.Mem < 0 || q.MinShape.Gpus < 0 || q.MaxAge < 0 {
+		return nil, NewInvalidArgumentError("the minimum resources and the age should not be negative")
+	}
+	for _, g := range q.Geographies {
+		if !Contains(Geographies(), g) {
+			return nil, NewInvalidArgumentError("unsupported geography: [%s]", g)
+		}
+	}
+
+	ranked := make([]RegionPrice, 0)
+	for _, rp := range regions {
+		geography := Geography(rp.Region)
+		if len(q.Geographies) > 0 && !Contains(q.Geographies, geography) {
+			continue
+		}
+		var age float64
+		if !rp.UpdatedAt.IsZero() {
+			age = now.Sub(rp.UpdatedAt).Seconds()
+		}
+		if q.MaxAge > 0 && (rp.UpdatedAt.IsZero() || now.Sub(rp.UpdatedAt) > q.MaxAge) {
+			continue
+		}
+
+		var cheapest *RegionPrice
+		for _, d := range rp.Products {
+			if !q.matches(d) {
+				continue
+			}
+			price, zone := d.OnDemandPrice, ""
+			if q.PriceType == PriceTypeSpot {
+				price, zone = cheapestZone(d.SpotInfo)
+			}
+			if price <= 0 || (cheapest != nil && (price > cheapest.Price || (price == cheapest.Price && d.Type > cheapest.Type))) {
+				continue
+			}
+			cheapest = &RegionPrice{
+				Provider:  rp.Provider,
+				Region:    rp.Region,
+				Geography: geography,
+				Zone:      zone,
+				Type:      d.Type,
+				Shape:     ShapeOf(d),
+				Price:     price,
+				Currency:  d.Currency,
+				UpdatedAt: rp.UpdatedAt,
+				Age:       age,
+			}
+		}
+		if cheapest != nil {
+			ranked = append(ranked, *cheapest)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Price < ranked[j].Price
+	})
+	return ranked, nil
+}
+
+// matches checks whether the product is the instance type or provides the minimum resources of the query
+func (q RegionQuery) matches(d ProductDetails) bool {
+	if q.InstanceType != "" {
+		return d.Type == q.InstanceType
+	}
+	return d.Cpus >= q.MinShape.Cpus && d.Mem >= q.MinShape.Mem && d.Gpus >= q.MinShape.Gpus
+}
+
+// cheapestZone returns the lowest spot price and its zone, 0 if there is no spot price
+func cheapestZone(spotInfo []ZonePrice) (float64, string) {
+	var price float64
+	var zone string
+	for _, zp := range spotInfo {
+		if zp.Price > 0 && (price == 0 || zp.Price < price || (zp.Price == price && zp.Zone < zone)) {
+			price, zone = zp.Price, zp.Zone
+		}
+	}
+	return price, zone
+}
@@ -0,0 +1,126 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheapestRegions(t *testing.T) {
+	now := time.Date(2018, 11, 20, 12, 0, 0, 0, time.UTC)
+	regions := []RegionProducts{
+		{
+			Provider: "amazon",
+			Region:   "eu-west-1",
+			Products: []ProductDetails{
+				{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16, OnDemandPrice: 0.214, Currency: CurrencyUSD},
+					SpotInfo: []ZonePrice{{Zone: "eu-west-1b", Price: 0.07}, {Zone: "eu-west-1a", Price: 0.065}}},
+				{VmInfo: VmInfo{Type: "c5.2xlarge", Cpus: 8, Mem: 16, OnDemandPrice: 0.384, Currency: CurrencyUSD}},
+			},
+			UpdatedAt: now.Add(-10 * time.Minute),
+		},
+		{
+			Provider: "amazon",
+			Region:   "us-east-1",
+			Products: []ProductDetails{
+				{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16, OnDemandPrice: 0.192, Currency: CurrencyUSD},
+					SpotInfo: []ZonePrice{{Zone: "us-east-1c", Price: 0.08}}},
+			},
+			UpdatedAt: now.Add(-2 * time.Hour),
+		},
+		{
+			Provider: "google",
+			Region:   "europe-west1",
+			Products: []ProductDetails{
+				{VmInfo: VmInfo{Type: "n1-standard-4", Cpus: 4, Mem: 15, OnDemandPrice: 0.2092, Currency: CurrencyUSD}},
+				{VmInfo: VmInfo{Type: "n1-highmem-4", Cpus: 4, Mem: 26, OnDemandPrice: 0.2604, Currency: CurrencyUSD}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		query RegionQuery
+		check func(ranked []RegionPrice, err error)
+	}{
+		{
+			name:  "instance type ranked by on demand price",
+			query: RegionQuery{InstanceType: "m5.xlarge"},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, ranked, 2)
+				assert.Equal(t, "us-east-1", ranked[0].Region)
+				assert.Equal(t, GeographyNorthAmerica, ranked[0].Geography)
+				assert.Equal(t, float64(7200), ranked[0].Age)
+				assert.Equal(t, "eu-west-1", ranked[1].Region)
+			},
+		},
+		{
+			name:  "instance type ranked by the spot price of the cheapest zone",
+			query: RegionQuery{InstanceType: "m5.xlarge", PriceType: PriceTypeSpot},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, "eu-west-1", ranked[0].Region)
+				assert.Equal(t, "eu-west-1a", ranked[0].Zone)
+				assert.Equal(t, 0.065, ranked[0].Price)
+			},
+		},
+		{
+			name:  "resources across providers in a geography",
+			query: RegionQuery{MinShape: Shape{Cpus: 4, Mem: 16}, Geographies: []string{GeographyEurope}},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, ranked, 2)
+				assert.Equal(t, "eu-west-1", ranked[0].Region)
+				assert.Equal(t, "m5.xlarge", ranked[0].Type)
+				assert.Equal(t, "europe-west1", ranked[1].Region)
+				assert.Equal(t, "n1-highmem-4", ranked[1].Type)
+			},
+		},
+		{
+			name:  "fresh prices only",
+			query: RegionQuery{InstanceType: "m5.xlarge", MaxAge: time.Hour},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, ranked, 1)
+				assert.Equal(t, "eu-west-1", ranked[0].Region)
+			},
+		},
+		{
+			name:  "unsupported geography",
+			query: RegionQuery{InstanceType: "m5.xlarge", Geographies: []string{"atlantis"}},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, ranked, "the regions should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:  "missing instance type and resources",
+			query: RegionQuery{},
+			check: func(ranked []RegionPrice, err error) {
+				assert.Nil(t, ranked, "the regions should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(CheapestRegions(regions, test.query, now))
+		})
+	}
+}
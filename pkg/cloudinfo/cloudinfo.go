@@ -18,8 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,11 +33,17 @@ import (
 // It's the entry point for the product info retrieval and management subsystem
 // It's also responsible for delegating to the cloud provider specific implementations
 type CachingCloudInfo struct {
-	cloudInfoers    map[string]CloudInfoer
-	renewalInterval time.Duration
-	vmAttrStore     ProductStorer
+	cloudInfoers      map[string]CloudInfoer
+	renewalInterval   time.Duration
+	vmAttrStore       ProductStorer
+	networkCategories NetworkCategories
+	// startedAt is the start of the renewals in unix nanoseconds (0 if not started), accessed atomically
+	startedAt int64
 }
 
+// shortLivedRenewalInterval is the interval of renewing the short lived prices (eg.: spot prices)
+const shortLivedRenewalInterval = 4 * time.Minute
+
 func (v AttrValues) floatValues() []float64 {
 	floatValues := make([]float64, len(v))
 	for i, av := range v {
@@ -53,6 +59,16 @@ type SpotPriceInfo map[string]float64
 type Price struct {
 	OnDemandPrice float64       `json:"onDemandPrice"`
 	SpotPrice     SpotPriceInfo `json:"spotPrice"`
+	// OsPrices holds the on demand prices per operating system / license, including linux; empty if the provider only publishes linux prices
+	OsPrices OsPrices `json:"osPrices,omitempty"`
+	// Commitments holds the linux prices of the capacity commitments (reserved instances, committed use, reservations)
+	Commitments []CommitmentPrice `json:"commitments,omitempty"`
+	// Currency is the ISO 4217 code of the currency the prices are published in by the provider
+	Currency string `json:"currency,omitempty"`
+	// SpotStats holds the statistics of the spot prices per zone over the look-back window of the provider
+	SpotStats map[string]SpotStats `json:"spotStats,omitempty"`
+	// UpdatedAt is the time the prices were retrieved from the provider, set when the prices are cached
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 // VmInfo representation of a virtual machine
@@ -69,6 +85,51 @@ type VmInfo struct {
 	Attributes    map[string]string `json:"attributes"`
 	// CurrentGen signals whether the instance type generation is the current one. Only applies for amazon
 	CurrentGen bool `json:"currentGen"`
+	// OsPrices holds the on demand prices per operating system / license, including linux; OnDemandPrice is the linux price
+	OsPrices OsPrices `json:"osPrices,omitempty"`
+	// Commitments holds the linux prices of the capacity commitments (reserved instances, committed use, reservations)
+	Commitments []CommitmentPrice `json:"commitments,omitempty"`
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency"`
+	// SpotStats holds the statistics of the spot prices per zone over the look-back window, empty if the provider has no spot history
+	SpotStats map[string]SpotStats `json:"spotStats,omitempty"`
+	// Arch is the cpu architecture of the instance type (x86_64 or arm64)
+	Arch string `json:"arch"`
+	// ProcessorFamily is the processor of the instance type as reported by the provider (eg.: Intel Xeon Platinum 8175)
+	ProcessorFamily string `json:"processorFamily"`
+	// GpuModel is the model of the gpus of the instance type
+	GpuModel string `json:"gpuModel"`
+	// GpuMem is the memory of a single gpu in GiB
+	GpuMem float64 `json:"gpuMemPerGpu"`
+	// LocalDisks is the number of the local (instance store) disks
+	LocalDisks int `json:"localDisks"`
+	// LocalDiskSize is the size of a single local disk in GiB
+	LocalDiskSize float64 `json:"localDiskSize"`
+	// LocalDiskType is the type of the local disks (ssd, nvme or hdd)
+	LocalDiskType string `json:"localDiskType"`
+	// PremiumStorage signals whether the instance type supports optimized block storage (EBS optimized, premium storage)
+	PremiumStorage bool `json:"premiumStorage"`
+	// MaxNics is the maximum number of network interfaces of the instance type, 0 if unknown
+	MaxNics int `json:"maxNics"`
+	// Hypervisor is the virtualization technology the instance type runs on
+	Hypervisor string `json:"hypervisor"`
+	// Category is the normalized category of the instance type (general, compute, memory, storage, accelerated or burstable)
+	Category string `json:"category"`
+	// Family is the instance family as named by the provider (eg.: m5d, n1, Dv3, Standard2, g5)
+	Family string `json:"family"`
+	// Generation is the generation of the instance family, 0 if the provider does not version the family
+	Generation int `json:"generation"`
+	// Size is the size of the instance type within its family (eg.: large, 2)
+	Size string `json:"size"`
+	// Bandwidth is the network bandwidth of the instance type in Gbps, 0 if unknown
+	Bandwidth float64 `json:"bandwidth"`
+	// BandwidthUpTo signals that the bandwidth is a burst ("up to") value, the sustained bandwidth is lower
+	BandwidthUpTo bool `json:"bandwidthUpTo"`
+	// CpuCores is the number of physical cores where the provider sells the instance type by cores (eg.: Oracle OCPUs), 0 otherwise
+	// Cpus is always the number of vCPUs
+	CpuCores float64 `json:"cpuCores,omitempty"`
+	// BurstInfo describes the cpu baseline and credits of the burstable instance types, nil if the instance type is not burstable
+	BurstInfo *BurstInfo `json:"burstInfo,omitempty"`
 }
 
 var (
@@ -135,22 +196,23 @@ var (
 	)
 )
 
-// IsBurst returns true if the EC2 instance vCPU is burst type
-// the decision is made based on the instance type
+// IsBurst returns true if the vCPU of the instance type is burstable, the decision is made by the provider
 func (vm VmInfo) IsBurst() bool {
-	return strings.HasPrefix(strings.ToUpper(vm.Type), "T")
+	return vm.BurstInfo != nil
 }
 
 // NewCachingCloudInfo creates a new CachingCloudInfo instance
-func NewCachingCloudInfo(ri time.Duration, cache ProductStorer, infoers map[string]CloudInfoer) (*CachingCloudInfo, error) {
+// The network performance categories of the products are assigned by the given categories
+func NewCachingCloudInfo(ri time.Duration, cache ProductStorer, infoers map[string]CloudInfoer, categories NetworkCategories) (*CachingCloudInfo, error) {
 	if infoers == nil || cache == nil {
 		return nil, errors.New("could not create product infoer")
 	}
 
 	pi := CachingCloudInfo{
-		cloudInfoers:    infoers,
-		vmAttrStore:     cache,
-		renewalInterval: ri,
+		cloudInfoers:      infoers,
+		vmAttrStore:       cache,
+		renewalInterval:   ri,
+		networkCategories: categories,
 	}
 	return &pi, nil
 }
@@ -168,7 +230,7 @@ func (cpi *CachingCloudInfo) GetProviders(ctx context.Context) []Provider {
 		// decorate the provider with service information
 		svcs := make([]Service, 0)
 		for _, s := range services {
-			svcs = append(svcs, NewService(s.ServiceName()))
+			svcs = append(svcs, ServiceOf(s))
 		}
 		provider := NewProvider(name)
 		provider.Services = svcs
@@ -178,6 +240,16 @@ func (cpi *CachingCloudInfo) GetProviders(ctx context.Context) []Provider {
 	return providers
 }
 
+// ProviderNames returns the names of the supported providers in alphabetical order
+func (cpi *CachingCloudInfo) ProviderNames() []string {
+	names := make([]string, 0, len(cpi.cloudInfoers))
+	for name := range cpi.cloudInfoers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // GetProvider returns the supported providers
 func (cpi *CachingCloudInfo) GetProvider(ctx context.Context, provider string) (Provider, error) {
 	for p := range cpi.cloudInfoers {
@@ -185,7 +257,7 @@ func (cpi *CachingCloudInfo) GetProvider(ctx context.Context, provider string) (
 			return NewProvider(provider), nil
 		}
 	}
-	return Provider{}, fmt.Errorf("unsupported provider: [%s]", provider)
+	return Provider{}, NewNotFoundError("unsupported provider: [%s]", provider)
 }
 
 // renewProviderInfo renews provider information for the provider argument. It optionally signals the end of renewal to the
@@ -275,6 +347,30 @@ func (cpi *CachingCloudInfo) renewProviderInfo(ctx context.Context, provider str
 	}
 	log.Info("finished to renew products (vm-s)")
 
+	log.Info("start to renew storage and network prices")
+	// block storage and network are priced independently of the services, the regions of the compute service are renewed
+	regions, err := pi.GetRegions(ctx, "compute")
+	if err != nil {
+		ScrapeFailuresTotalCounter.WithLabelValues(provider, "compute", "N/A").Inc()
+		log.WithError(err).Error("failed to renew storage and network prices")
+		return
+	}
+	for regionId := range regions {
+		c := logger.ToContext(ctx,
+			logger.NewLogCtxBuilder().
+				WithRegion(regionId).
+				Build())
+		if _, err := cpi.renewStorage(c, provider, regionId); err != nil {
+			ScrapeFailuresTotalCounter.WithLabelValues(provider, "compute", regionId).Inc()
+			logger.Extract(c).WithError(err).Error("failed to renew storage")
+		}
+		if _, err := cpi.renewNetworkPrices(c, provider, regionId); err != nil {
+			ScrapeFailuresTotalCounter.WithLabelValues(provider, "compute", regionId).Inc()
+			logger.Extract(c).WithError(err).Error("failed to renew network prices")
+		}
+	}
+	log.Info("finished to renew storage and network prices")
+
 	if _, err := cpi.renewStatus(provider); err != nil {
 		log.Errorf("failed to renew status: %s", err)
 		return
@@ -350,6 +446,7 @@ func (cpi *CachingCloudInfo) renewShortLived(ctx context.Context) {
 				}(ctx, p, regionId)
 			}
 			wg.Wait()
+			cpi.renewShortLivedStatus(p)
 			ScrapeShortLivedCompleteDurationGauge.WithLabelValues(p).Set(time.Since(start).Seconds())
 
 		}(ctxWithFields, provider, infoer)
@@ -360,6 +457,7 @@ func (cpi *CachingCloudInfo) renewShortLived(ctx context.Context) {
 
 // Start starts the information retrieval in a new goroutine
 func (cpi *CachingCloudInfo) Start(ctx context.Context) {
+	atomic.StoreInt64(&cpi.startedAt, time.Now().UnixNano())
 
 	go cpi.renewAll(ctx)
 	ticker := time.NewTicker(cpi.renewalInterval)
@@ -376,7 +474,7 @@ func (cpi *CachingCloudInfo) Start(ctx context.Context) {
 		}
 	}(ctx)
 	go cpi.renewShortLived(ctx)
-	shortTicker := time.NewTicker(4 * time.Minute)
+	shortTicker := time.NewTicker(shortLivedRenewalInterval)
 	for {
 		select {
 		case <-shortTicker.C:
@@ -399,8 +497,10 @@ func (cpi *CachingCloudInfo) Initialize(ctx context.Context, provider string) (m
 		return nil, err
 	}
 
+	now := time.Now()
 	for region, ap := range allPrices {
 		for instType, p := range ap {
+			p.UpdatedAt = now
 			cpi.vmAttrStore.Set(cpi.getPriceKey(provider, region, instType), p, cpi.renewalInterval)
 			OnDemandPriceGauge.WithLabelValues(provider, region, instType).Set(p.OnDemandPrice)
 		}
@@ -450,8 +550,9 @@ func (cpi *CachingCloudInfo) renewAttrValues(ctx context.Context, provider, serv
 	}
 	values, err := cpi.cloudInfoers[provider].GetAttributeValues(ctx, service, attr)
 	if err != nil {
-		return nil, err
+		return nil, NewProviderUnavailableError(provider, err)
 	}
+	values = validAttrValues(values)
 	cpi.vmAttrStore.Set(cpi.getAttrKey(provider, service, attribute), values, cpi.renewalInterval)
 	return values, nil
 }
@@ -462,8 +563,50 @@ func (cpi *CachingCloudInfo) HasShortLivedPriceInfo(provider string) bool {
 }
 
 // GetPrice returns the on demand price and zone averaged computed spot price for a given instance type in a given region
+// The spot price is the mean of the zones having a spot price
 func (cpi *CachingCloudInfo) GetPrice(ctx context.Context, provider string, region string, instanceType string, zones []string) (float64, float64, error) {
-	var p Price
+	if err := cpi.ValidateZones(ctx, provider, region, zones); err != nil {
+		return 0, 0, err
+	}
+	p, err := cpi.getPrice(ctx, provider, region, instanceType)
+	if err != nil {
+		return 0, 0, err
+	}
+	spot, err := AggregateSpotPrices(p.SpotPrice, zones, SpotStrategyMean, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	return p.OnDemandPrice, spot.Price, nil
+}
+
+// GetSpotPrice returns the spot price of an instance type aggregated over the given zones of a region with the strategy
+func (cpi *CachingCloudInfo) GetSpotPrice(ctx context.Context, provider, region, instanceType string, zones []string, strategy string, count int) (SpotAggregate, error) {
+	if err := cpi.ValidateZones(ctx, provider, region, zones); err != nil {
+		return SpotAggregate{}, err
+	}
+	p, err := cpi.getPrice(ctx, provider, region, instanceType)
+	if err != nil {
+		return SpotAggregate{}, err
+	}
+	aggregate, err := AggregateSpotPrices(p.SpotPrice, zones, strategy, count)
+	if err != nil {
+		return SpotAggregate{}, err
+	}
+
+	aggregate.Currency = p.Currency
+	if aggregate.Currency == "" {
+		aggregate.Currency = CurrencyUSD
+	}
+	if !p.UpdatedAt.IsZero() {
+		aggregate.UpdatedAt = p.UpdatedAt
+		aggregate.Age = time.Since(p.UpdatedAt).Seconds()
+	}
+	return aggregate, nil
+}
+
+// getPrice returns the prices of an instance type from the cache, the short lived prices are renewed if not cached
+// An instance type without a price in the region is not found
+func (cpi *CachingCloudInfo) getPrice(ctx context.Context, provider string, region string, instanceType string) (Price, error) {
 	ctx = logger.ToContext(ctx, logger.NewLogCtxBuilder().
 		WithProvider(provider).
 		WithRegion(region).
@@ -471,23 +614,17 @@ func (cpi *CachingCloudInfo) GetPrice(ctx context.Context, provider string, regi
 
 	if cachedVal, ok := cpi.vmAttrStore.Get(cpi.getPriceKey(provider, region, instanceType)); ok {
 		logger.Extract(ctx).Debugf("Getting price info from cache [instance type=%s].", instanceType)
-		p = cachedVal.(Price)
-	} else {
-		allPriceInfo, err := cpi.renewShortLivedInfo(ctx, provider, region)
-		if err != nil {
-			return 0, 0, err
-		}
-		p = allPriceInfo[instanceType]
+		return cachedVal.(Price), nil
 	}
-	var sumPrice float64
-	for _, z := range zones {
-		for zone, price := range p.SpotPrice {
-			if zone == z {
-				sumPrice += price
-			}
-		}
+	allPriceInfo, err := cpi.renewShortLivedInfo(ctx, provider, region)
+	if err != nil {
+		return Price{}, NewProviderUnavailableError(provider, err)
 	}
-	return p.OnDemandPrice, sumPrice / float64(len(zones)), nil
+	price, ok := allPriceInfo[instanceType]
+	if !ok {
+		return Price{}, NewNotFoundError("unknown instance type: [%s]", instanceType)
+	}
+	return price, nil
 }
 
 func (cpi *CachingCloudInfo) getPriceKey(provider string, region string, instanceType string) string {
@@ -500,7 +637,10 @@ func (cpi *CachingCloudInfo) renewShortLivedInfo(ctx context.Context, provider s
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	for instType, p := range prices {
+		p.UpdatedAt = now
+		prices[instType] = p
 		cpi.vmAttrStore.Set(cpi.getPriceKey(provider, region, instType), p, 8*time.Minute)
 	}
 	return prices, nil
@@ -513,7 +653,7 @@ func (cpi *CachingCloudInfo) toProviderAttribute(provider string, attr string) (
 	case Memory:
 		return cpi.cloudInfoers[provider].GetMemoryAttrName(), nil
 	}
-	return "", fmt.Errorf("unsupported attribute: %s", attr)
+	return "", NewInvalidArgumentError("unsupported attribute: %s", attr)
 }
 
 func (cpi *CachingCloudInfo) getVmKey(provider, service, region string) string {
@@ -526,13 +666,24 @@ func (cpi *CachingCloudInfo) renewVms(ctx context.Context, provider, service, re
 		return nil, err
 	}
 
+	vms := make([]VmInfo, 0, len(values))
 	for _, vm := range values {
+		// products not matching the unit model would be compared to the others in the wrong units
+		if err := vm.Validate(); err != nil {
+			logger.Extract(ctx).WithError(err).Warn("invalid product left out")
+			continue
+		}
 		if vm.OnDemandPrice > 0 {
 			OnDemandPriceGauge.WithLabelValues(provider, regionId, vm.Type).Set(vm.OnDemandPrice)
 		}
+		vm.NtwPerfCat = cpi.networkCategories.For(provider).Category(vm.Bandwidth)
+		if vm.Attributes != nil {
+			vm.Attributes[NetworkPerfCategory] = vm.NtwPerfCat
+		}
+		vms = append(vms, vm)
 	}
-	cpi.vmAttrStore.Set(cpi.getVmKey(provider, service, regionId), values, cpi.renewalInterval)
-	return values, nil
+	cpi.vmAttrStore.Set(cpi.getVmKey(provider, service, regionId), vms, cpi.renewalInterval)
+	return vms, nil
 }
 
 // GetZones returns the availability zones in a region
@@ -550,7 +701,7 @@ func (cpi *CachingCloudInfo) GetZones(ctx context.Context, provider string, regi
 	zones, err := cpi.cloudInfoers[provider].GetZones(ctx, region)
 	if err != nil {
 		log.WithError(err).Error("error while retrieving zones.")
-		return nil, err
+		return nil, NewProviderUnavailableError(provider, err)
 	}
 
 	// cache the results / use the cache default expiry
@@ -558,6 +709,23 @@ func (cpi *CachingCloudInfo) GetZones(ctx context.Context, provider string, regi
 	return zones, nil
 }
 
+// ValidateZones checks that the zones belong to the region
+func (cpi *CachingCloudInfo) ValidateZones(ctx context.Context, provider, region string, zones []string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+	regionZones, err := cpi.GetZones(ctx, provider, region)
+	if err != nil {
+		return err
+	}
+	for _, zone := range zones {
+		if !Contains(regionZones, zone) {
+			return NewInvalidArgumentError("unknown zone: [%s]", zone)
+		}
+	}
+	return nil
+}
+
 func (cpi *CachingCloudInfo) getZonesKey(provider string, region string) string {
 	return fmt.Sprintf(ZoneKeyTemplate, provider, region)
 }
@@ -578,7 +746,7 @@ func (cpi *CachingCloudInfo) GetRegions(ctx context.Context, provider, service s
 	regions, err := cpi.cloudInfoers[provider].GetRegions(ctx, service)
 	if err != nil {
 		log.WithError(err).Error("could not retrieve regions.")
-		return nil, err
+		return nil, NewProviderUnavailableError(provider, err)
 	}
 
 	// cache the results / use the cache default expiry
@@ -596,7 +764,7 @@ func (cpi *CachingCloudInfo) GetProductDetails(ctx context.Context, provider, se
 	log.Debug("getting product details")
 	cachedVms, ok := cpi.vmAttrStore.Get(cpi.getVmKey(provider, service, region))
 	if !ok {
-		return nil, fmt.Errorf("vms not yet cached for the key: %s", cpi.getVmKey(provider, service, region))
+		return nil, cpi.notYetAvailable(provider, "vms not yet cached for the key: %s", cpi.getVmKey(provider, service, region))
 	}
 
 	vms := cachedVms.([]VmInfo)
@@ -611,6 +779,18 @@ func (cpi *CachingCloudInfo) GetProductDetails(ctx context.Context, provider, se
 			if pr.OnDemandPrice > 0 {
 				pd.OnDemandPrice = pr.OnDemandPrice
 			}
+			if len(pr.OsPrices) > 0 {
+				pd.OsPrices = pr.OsPrices
+			}
+			if len(pr.Commitments) > 0 {
+				pd.Commitments = pr.Commitments
+			}
+			if len(pr.SpotStats) > 0 {
+				pd.SpotStats = pr.SpotStats
+			}
+			if pr.Currency != "" {
+				pd.Currency = pr.Currency
+			}
 			for zone, price := range pr.SpotPrice {
 				pd.SpotInfo = append(pd.SpotInfo, *newZonePrice(zone, price))
 			}
@@ -618,6 +798,11 @@ func (cpi *CachingCloudInfo) GetProductDetails(ctx context.Context, provider, se
 			log.Debugf("price info not yet cached for key: %s", cpi.getPriceKey(provider, region, vm.Type))
 		}
 
+		if pd.Currency == "" {
+			// the providers that don't tell otherwise publish their prices in USD
+			pd.Currency = CurrencyUSD
+		}
+
 		if pd.OnDemandPrice != 0 {
 			details = append(details, *pd)
 		}
@@ -626,6 +811,80 @@ func (cpi *CachingCloudInfo) GetProductDetails(ctx context.Context, provider, se
 	return details, nil
 }
 
+// RegionProducts holds the product details of a region of a provider
+type RegionProducts struct {
+	Provider string
+	Region   string
+	Products []ProductDetails
+	// UpdatedAt is the retrieval time of the oldest prices of the region, zero if unknown
+	UpdatedAt time.Time
+}
+
+// GetAllProductDetails retrieves the product details of every region of the given providers (every provider if empty)
+// The regions whose products are not yet cached are left out
+func (cpi *CachingCloudInfo) GetAllProductDetails(ctx context.Context, service string, providers []string) ([]RegionProducts, error) {
+	log := logger.Extract(ctx)
+	if len(providers) == 0 {
+		for provider := range cpi.cloudInfoers {
+			providers = append(providers, provider)
+		}
+	}
+	sort.Strings(providers)
+
+	var all []RegionProducts
+	for _, provider := range providers {
+		if _, ok := cpi.cloudInfoers[provider]; !ok {
+			return nil, NewNotFoundError("unsupported provider: [%s]", provider)
+		}
+		regions, err := cpi.GetRegions(ctx, provider, service)
+		if err != nil {
+			return nil, err
+		}
+		regionIds := make([]string, 0, len(regions))
+		for regionId := range regions {
+			regionIds = append(regionIds, regionId)
+		}
+		sort.Strings(regionIds)
+
+		for _, regionId := range regionIds {
+			details, err := cpi.GetProductDetails(ctx, provider, service, regionId)
+			if err != nil {
+				log.WithError(err).Debugf("leaving out the products of %s in %s", provider, regionId)
+				continue
+			}
+			all = append(all, RegionProducts{Provider: provider, Region: regionId, Products: details,
+				UpdatedAt: cpi.PricesUpdatedAt(provider, regionId, details)})
+		}
+	}
+	if len(all) == 0 {
+		// the products can be retried once the first of the providers is renewed
+		err := NotYetAvailableError{msg: fmt.Sprintf("products not yet cached for the providers: %v", providers),
+			RetryAfter: defaultRetryAfter}
+		for i, provider := range providers {
+			if retryAfter := cpi.retryAfter(provider); i == 0 || retryAfter < err.RetryAfter {
+				err.RetryAfter = retryAfter
+			}
+		}
+		return nil, err
+	}
+	return all, nil
+}
+
+// PricesUpdatedAt returns the retrieval time of the oldest cached prices of the products, zero if unknown
+func (cpi *CachingCloudInfo) PricesUpdatedAt(provider, region string, details []ProductDetails) time.Time {
+	var oldest time.Time
+	for _, d := range details {
+		cachedVal, ok := cpi.vmAttrStore.Get(cpi.getPriceKey(provider, region, d.Type))
+		if !ok {
+			continue
+		}
+		if updatedAt := cachedVal.(Price).UpdatedAt; !updatedAt.IsZero() && (oldest.IsZero() || updatedAt.Before(oldest)) {
+			oldest = updatedAt
+		}
+	}
+	return oldest
+}
+
 // Contains is a helper function to check if a slice contains a string
 func Contains(slice []string, s string) bool {
 	for _, e := range slice {
@@ -641,7 +900,7 @@ func (cpi *CachingCloudInfo) GetStatus(provider string) (string, error) {
 
 	cachedStatus, ok := cpi.vmAttrStore.Get(cpi.getStatusKey(provider))
 	if !ok {
-		return "", fmt.Errorf("status not yet cached for the key: %s", cpi.getStatusKey(provider))
+		return "", cpi.notYetAvailable(provider, "status not yet cached for the key: %s", cpi.getStatusKey(provider))
 	}
 	status := cachedStatus.(string)
 
@@ -652,6 +911,87 @@ func (cpi *CachingCloudInfo) getStatusKey(provider string) string {
 	return fmt.Sprintf(StatusKeyTemplate, provider)
 }
 
+func (cpi *CachingCloudInfo) renewShortLivedStatus(provider string) {
+	cpi.vmAttrStore.Set(cpi.getShortLivedStatusKey(provider), strconv.Itoa(int(time.Now().UnixNano()/1e6)), cpi.renewalInterval)
+}
+
+func (cpi *CachingCloudInfo) getShortLivedStatusKey(provider string) string {
+	return fmt.Sprintf(ShortLivedStatusKeyTemplate, provider)
+}
+
+// LastModified returns the time the cached information of the provider last changed: the completion of the last scrape,
+// or of the last renewal of the short lived prices if later
+func (cpi *CachingCloudInfo) LastModified(provider string) (time.Time, error) {
+	status, err := cpi.GetStatus(provider)
+	if err != nil {
+		return time.Time{}, err
+	}
+	lastModified, err := parseStatus(status)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cachedStatus, ok := cpi.vmAttrStore.Get(cpi.getShortLivedStatusKey(provider)); ok {
+		if renewedAt, err := parseStatus(cachedStatus.(string)); err == nil && renewedAt.After(lastModified) {
+			lastModified = renewedAt
+		}
+	}
+	return lastModified, nil
+}
+
+// parseStatus parses a status given in unix milliseconds
+func parseStatus(status string) (time.Time, error) {
+	ms, err := strconv.ParseInt(status, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid status: [%s]", status)
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), nil
+}
+
+// NextRenewal returns the time of the next scheduled renewal of the cached information of the provider (the short lived
+// prices included), zero if the renewals are not started
+func (cpi *CachingCloudInfo) NextRenewal(provider string, now time.Time) time.Time {
+	startedAt := atomic.LoadInt64(&cpi.startedAt)
+	if startedAt == 0 {
+		return time.Time{}
+	}
+	start := time.Unix(0, startedAt)
+
+	next := nextTick(start, cpi.renewalInterval, now)
+	if infoer, ok := cpi.cloudInfoers[provider]; ok && infoer.HasShortLivedPriceInfo() {
+		if short := nextTick(start, shortLivedRenewalInterval, now); short.Before(next) {
+			next = short
+		}
+	}
+	return next
+}
+
+// notYetAvailable creates a NotYetAvailableError for the missing information of the provider with the time it can be
+// cached at as the retry hint
+func (cpi *CachingCloudInfo) notYetAvailable(provider string, format string, args ...interface{}) error {
+	return NotYetAvailableError{msg: fmt.Sprintf(format, args...), RetryAfter: cpi.retryAfter(provider)}
+}
+
+// retryAfter returns the time until the missing information of the provider can be cached: the next renewal once the
+// provider is scraped, the default hint while its first scrape is in progress
+func (cpi *CachingCloudInfo) retryAfter(provider string) time.Duration {
+	if _, ok := cpi.vmAttrStore.Get(cpi.getStatusKey(provider)); !ok {
+		return defaultRetryAfter
+	}
+	now := time.Now()
+	if next := cpi.NextRenewal(provider, now); next.After(now) {
+		return next.Sub(now)
+	}
+	return defaultRetryAfter
+}
+
+// nextTick returns the first tick after now of a ticker started at start
+func nextTick(start time.Time, interval time.Duration, now time.Time) time.Time {
+	if interval <= 0 || now.Before(start) {
+		return start
+	}
+	return start.Add((now.Sub(start)/interval + 1) * interval)
+}
+
 // GetInfoer returns the provider specific infoer implementation. This method is the discriminator for cloud providers
 func (cpi *CachingCloudInfo) GetInfoer(provider string) (CloudInfoer, error) {
 
@@ -659,7 +999,7 @@ func (cpi *CachingCloudInfo) GetInfoer(provider string) (CloudInfoer, error) {
 		return infoer, nil
 	}
 
-	return nil, fmt.Errorf("could not find infoer for: [ %s ]", provider)
+	return nil, NewNotFoundError("could not find infoer for: [ %s ]", provider)
 }
 
 func (cpi *CachingCloudInfo) getImagesKey(provider, service, region string) string {
@@ -682,7 +1022,7 @@ func (cpi *CachingCloudInfo) GetServiceImages(ctx context.Context, provider, ser
 
 	cachedImages, ok := cpi.vmAttrStore.Get(cpi.getImagesKey(provider, service, region))
 	if !ok {
-		return nil, fmt.Errorf("images not yet cached for the key: %s", cpi.getImagesKey(provider, service, region))
+		return nil, cpi.notYetAvailable(provider, "images not yet cached for the key: %s", cpi.getImagesKey(provider, service, region))
 	}
 
 	return cachedImages.([]ImageDescriber), nil
@@ -709,19 +1049,72 @@ func (cpi *CachingCloudInfo) GetVersions(ctx context.Context, provider, service,
 
 	cachedVersions, ok := cpi.vmAttrStore.Get(cpi.getVersionsKey(provider, service, region))
 	if !ok {
-		return nil, fmt.Errorf("versions not yet cached for the key: %s", cpi.getVersionsKey(provider, service, region))
+		return nil, cpi.notYetAvailable(provider, "versions not yet cached for the key: %s", cpi.getVersionsKey(provider, service, region))
 	}
 
 	return cachedVersions.([]string), nil
 }
 
+func (cpi *CachingCloudInfo) getStorageKey(provider, region string) string {
+	return fmt.Sprintf(StorageKeyTemplate, provider, region)
+}
+
+func (cpi *CachingCloudInfo) renewStorage(ctx context.Context, provider, region string) ([]StorageInfo, error) {
+	values, err := cpi.cloudInfoers[provider].GetStorage(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	cpi.vmAttrStore.Set(cpi.getStorageKey(provider, region), values, cpi.renewalInterval)
+	return values, nil
+}
+
+// GetStorage retrieves the block storage volume types and their prices for the given provider and region
+func (cpi *CachingCloudInfo) GetStorage(ctx context.Context, provider, region string) ([]StorageInfo, error) {
+	log := logger.Extract(ctx)
+	log.Debug("getting storage")
+
+	cachedStorage, ok := cpi.vmAttrStore.Get(cpi.getStorageKey(provider, region))
+	if !ok {
+		return nil, cpi.notYetAvailable(provider, "storage not yet cached for the key: %s", cpi.getStorageKey(provider, region))
+	}
+
+	return cachedStorage.([]StorageInfo), nil
+}
+
+func (cpi *CachingCloudInfo) getNetworkKey(provider, region string) string {
+	return fmt.Sprintf(NetworkKeyTemplate, provider, region)
+}
+
+func (cpi *CachingCloudInfo) renewNetworkPrices(ctx context.Context, provider, region string) (NetworkPrices, error) {
+	values, err := cpi.cloudInfoers[provider].GetNetworkPrices(ctx, region)
+	if err != nil {
+		return NetworkPrices{}, err
+	}
+	cpi.vmAttrStore.Set(cpi.getNetworkKey(provider, region), values, cpi.renewalInterval)
+	return values, nil
+}
+
+// GetNetworkPrices retrieves the load balancer and data transfer prices for the given provider and region
+func (cpi *CachingCloudInfo) GetNetworkPrices(ctx context.Context, provider, region string) (NetworkPrices, error) {
+	log := logger.Extract(ctx)
+	log.Debug("getting network prices")
+
+	cachedPrices, ok := cpi.vmAttrStore.Get(cpi.getNetworkKey(provider, region))
+	if !ok {
+		return NetworkPrices{}, cpi.notYetAvailable(provider, "network prices not yet cached for the key: %s", cpi.getNetworkKey(provider, region))
+	}
+
+	return cachedPrices.(NetworkPrices), nil
+}
+
 // Attributes create a map with the specified parameters
-func Attributes(cpu, memory, ntwPerfCat string) map[string]string {
+// The network performance category is assigned from the bandwidth when the products are cached
+func Attributes(cpu, memory string) map[string]string {
 	var attributes = make(map[string]string)
 
 	attributes[Cpu] = cpu
 	attributes[Memory] = memory
-	attributes["NetworkPerfCategory"] = ntwPerfCat
+	attributes[NetworkPerfCategory] = ""
 
 	return attributes
 }
@@ -31,23 +31,11 @@ type DummyCloudInfoer struct {
 	AttrValues AttrValues
 	Vms        []VmInfo
 	TcId       string
-	//dummyNetworkMapper NetworkPerfMapper
 	// implement the interface
 	CloudInfoer
 	ProductStorer
 }
 
-func newDummyNetworkMapper() dummyNetworkMapper {
-	return dummyNetworkMapper{}
-}
-
-type dummyNetworkMapper struct {
-}
-
-func (nm *dummyNetworkMapper) MapNetworkPerf(ntwPerf string) (string, error) {
-	return "high", nil
-}
-
 const (
 	GetRegionsError         = "could not get regions"
 	GetCurrentPricesError   = "could not get current prices"
@@ -57,6 +45,8 @@ const (
 	GetZonesError           = "could not get zones"
 	ProductDetailsOK        = "successfully get product details"
 	GetProductDetail        = "returns a product detail"
+	GetStorageError         = "could not get storage"
+	GetNetworkPricesError   = "could not get network prices"
 )
 
 func (dpi *DummyCloudInfoer) Initialize(ctx context.Context) (map[string]map[string]Price, error) {
@@ -133,6 +123,32 @@ func (dpi *DummyCloudInfoer) GetCurrentPrices(ctx context.Context, region string
 
 }
 
+func (dpi *DummyCloudInfoer) GetStorage(ctx context.Context, region string) ([]StorageInfo, error) {
+	switch dpi.TcId {
+	case GetStorageError:
+		return nil, errors.New(GetStorageError)
+	default:
+		return []StorageInfo{
+			{Type: "standard", Category: DiskTypeHdd, PricePerGbMonth: 0.05, Currency: CurrencyUSD},
+			{Type: "ssd", Category: DiskTypeSsd, PricePerGbMonth: 0.1, PricePerIopsMonth: 0.065, Currency: CurrencyUSD},
+		}, nil
+	}
+}
+
+func (dpi *DummyCloudInfoer) GetNetworkPrices(ctx context.Context, region string) (NetworkPrices, error) {
+	switch dpi.TcId {
+	case GetNetworkPricesError:
+		return NetworkPrices{}, errors.New(GetNetworkPricesError)
+	default:
+		return NetworkPrices{
+			LoadBalancers:  []LoadBalancerPrice{{Type: "dummy", PricePerHour: 0.025, PricePerGb: 0.008}},
+			InterZonePerGb: 0.01,
+			InternetEgress: []EgressTier{{StartGb: 0, EndGb: 10240, PricePerGb: 0.09}, {StartGb: 10240, PricePerGb: 0.085}},
+			Currency:       CurrencyUSD,
+		}, nil
+	}
+}
+
 func (dpi *DummyCloudInfoer) GetMemoryAttrName() string {
 	return "memory"
 }
@@ -209,15 +225,6 @@ func (dpi *DummyCloudInfoer) Get(k string) (interface{}, bool) {
 func (dpi *DummyCloudInfoer) Set(k string, x interface{}, d time.Duration) {
 }
 
-func (dpi *DummyCloudInfoer) GetNetworkPerformanceMapper() (NetworkPerfMapper, error) {
-	nm := newDummyNetworkMapper()
-	return &nm, nil
-}
-
-func (dpi *DummyCloudInfoer) MapNetworkPerf(vm VmInfo) (string, error) {
-	return "high", nil
-}
-
 func TestNewCachingCloudInfo(t *testing.T) {
 	tests := []struct {
 		Name        string
@@ -246,7 +253,7 @@ func TestNewCachingCloudInfo(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
-			test.checker(NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer))
+			test.checker(NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories()))
 		})
 	}
 
@@ -280,6 +287,47 @@ func TestCachingCloudInfo_renewVms(t *testing.T) {
 
 			},
 		},
+		{
+			name:      "the network performance categories are assigned by bandwidth",
+			attrValue: AttrValue{Value: float64(2), StrValue: Cpu},
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{
+					Vms: []VmInfo{
+						{Type: "small", Cpus: 2, Mem: 8, Bandwidth: 1, Attributes: Attributes("2", "8")},
+						{Type: "large", Cpus: 64, Mem: 256, Bandwidth: 25, BandwidthUpTo: true, Attributes: Attributes("64", "256")},
+						{Type: "unknown", Cpus: 2, Mem: 8, Attributes: Attributes("2", "8")},
+					},
+				},
+			},
+			Cache: cache.New(5*time.Minute, 10*time.Minute),
+			checker: func(cache *cache.Cache, vms []VmInfo, err error) {
+				assert.Nil(t, err, "should not get error on vm renewal")
+				categories := make(map[string]string)
+				for _, vm := range vms {
+					categories[vm.Type] = vm.NtwPerfCat
+					assert.Equal(t, vm.NtwPerfCat, vm.Attributes[NetworkPerfCategory])
+				}
+				assert.Equal(t, map[string]string{"small": NTW_LOW, "large": NTW_EXTRA, "unknown": ""}, categories)
+			},
+		},
+		{
+			name:      "products not matching the unit model are left out",
+			attrValue: AttrValue{Value: float64(2), StrValue: Cpu},
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{
+					Vms: []VmInfo{
+						{Type: "valid", Cpus: 2, Mem: 8},
+						{Type: "memory-in-mib", Cpus: 2, Mem: 8192},
+						{Type: "no-cpu", Mem: 8},
+					},
+				},
+			},
+			Cache: cache.New(5*time.Minute, 10*time.Minute),
+			checker: func(cache *cache.Cache, vms []VmInfo, err error) {
+				assert.Nil(t, err, "should not get error on vm renewal")
+				assert.Equal(t, []VmInfo{{Type: "valid", Cpus: 2, Mem: 8}}, vms)
+			},
+		},
 		{
 			name:      "could not retrieve virtual machines",
 			attrValue: AttrValue{Value: float64(2), StrValue: Cpu},
@@ -300,13 +348,96 @@ func TestCachingCloudInfo_renewVms(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, test.Cache, test.CloudInfoer)
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, test.Cache, test.CloudInfoer, DefaultNetworkCategories())
 			values, err := cloudInfo.renewVms(context.Background(), "dummy", "dummyService", "dummyRegion")
 			test.checker(test.Cache, values, err)
 		})
 	}
 }
 
+func TestCachingCloudInfo_renewStorage(t *testing.T) {
+	tests := []struct {
+		name        string
+		CloudInfoer map[string]CloudInfoer
+		checker     func(info *CachingCloudInfo, storage []StorageInfo, err error)
+	}{
+		{
+			name: "storage successfully renewed",
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{},
+			},
+			checker: func(info *CachingCloudInfo, storage []StorageInfo, err error) {
+				assert.Nil(t, err, "should not get error on storage renewal")
+				assert.Equal(t, 2, len(storage))
+				cached, err := info.GetStorage(context.Background(), "dummy", "dummyRegion")
+				assert.Nil(t, err, "the storage should be cached")
+				assert.Equal(t, storage, cached)
+			},
+		},
+		{
+			name: "could not retrieve storage",
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{TcId: GetStorageError},
+			},
+			checker: func(info *CachingCloudInfo, storage []StorageInfo, err error) {
+				assert.EqualError(t, err, GetStorageError)
+				assert.Nil(t, storage, "no storage expected")
+				_, err = info.GetStorage(context.Background(), "dummy", "dummyRegion")
+				assert.IsType(t, NotYetAvailableError{}, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
+			storage, err := cloudInfo.renewStorage(context.Background(), "dummy", "dummyRegion")
+			test.checker(cloudInfo, storage, err)
+		})
+	}
+}
+
+func TestCachingCloudInfo_renewNetworkPrices(t *testing.T) {
+	tests := []struct {
+		name        string
+		CloudInfoer map[string]CloudInfoer
+		checker     func(info *CachingCloudInfo, prices NetworkPrices, err error)
+	}{
+		{
+			name: "network prices successfully renewed",
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{},
+			},
+			checker: func(info *CachingCloudInfo, prices NetworkPrices, err error) {
+				assert.Nil(t, err, "should not get error on network price renewal")
+				assert.Equal(t, 2, len(prices.InternetEgress))
+				cached, err := info.GetNetworkPrices(context.Background(), "dummy", "dummyRegion")
+				assert.Nil(t, err, "the network prices should be cached")
+				assert.Equal(t, prices, cached)
+			},
+		},
+		{
+			name: "could not retrieve network prices",
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{TcId: GetNetworkPricesError},
+			},
+			checker: func(info *CachingCloudInfo, prices NetworkPrices, err error) {
+				assert.EqualError(t, err, GetNetworkPricesError)
+				_, err = info.GetNetworkPrices(context.Background(), "dummy", "dummyRegion")
+				assert.IsType(t, NotYetAvailableError{}, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
+			prices, err := cloudInfo.renewNetworkPrices(context.Background(), "dummy", "dummyRegion")
+			test.checker(cloudInfo, prices, err)
+		})
+	}
+}
+
 func TestCachingCloudInfo_GetAttrValues(t *testing.T) {
 	dummyAttrValues := AttrValues{
 		AttrValue{Value: 15},
@@ -349,6 +480,16 @@ func TestCachingCloudInfo_GetAttrValues(t *testing.T) {
 				assert.Nil(t, value, "the retrieved values should be nil")
 			},
 		},
+		{
+			name: "the attribute values are described in the unit of the attribute",
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{AttrValues: AttrValues{{Value: 3.75, StrValue: "3.75 GiB"}, {Value: 0, StrValue: "NA"}}}},
+			Attribute: Memory,
+			checker: func(value []float64, err error) {
+				assert.Nil(t, err, "the returned error must be nil")
+				assert.Equal(t, []float64{3.75}, value, "non positive values should be left out")
+			},
+		},
 		{
 			name: "could not retrieve attribute values",
 			CloudInfoer: map[string]CloudInfoer{
@@ -363,7 +504,7 @@ func TestCachingCloudInfo_GetAttrValues(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			test.checker(cloudInfo.GetAttrValues(context.Background(), "dummy", "dummyService", test.Attribute))
 		})
 	}
@@ -402,7 +543,7 @@ func TestCachingCloudInfo_GetZones(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			values, err := cloudInfo.GetZones(context.Background(), "dummy", "dummyRegion")
 			test.checker(cloudInfo, values, err)
 		})
@@ -438,7 +579,7 @@ func TestCachingCloudInfo_Initialize(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			cloudInfo, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			test.checker(cloudInfo.Initialize(context.Background(), "dummy"))
 		})
 	}
@@ -473,7 +614,7 @@ func TestCachingCloudInfo_renewShortLivedInfo(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			test.checker(info.renewShortLivedInfo(context.Background(), "dummy", "dummyRegion"))
 		})
 	}
@@ -499,20 +640,20 @@ func TestCachingCloudInfo_GetPrice(t *testing.T) {
 			},
 		},
 		{
-			name:  "return on demand price and average spot price with 4 zones",
-			zones: []string{"dummyZone1", "dummyZone2", "dummyZone3", "dummyZone4"},
+			name:  "return on demand price and average spot price of the priced zones with 2 zones",
+			zones: []string{"dummyZone1", "dummyZone2"},
 			CloudInfoer: map[string]CloudInfoer{
 				"dummy": &DummyCloudInfoer{},
 			},
 			checker: func(ondemand float64, avg float64, err error) {
 				assert.Equal(t, float64(0.11), ondemand)
-				assert.Equal(t, float64(0.01325), avg)
+				assert.Equal(t, float64(0.053), avg, "zones without a spot price should not skew the average")
 				assert.Nil(t, err, "the error should be nil")
 			},
 		},
 		{
 			name:  "return on demand price and average spot price without expected zone",
-			zones: []string{"dummyZone2", "dummyZone3", "dummyZone4"},
+			zones: []string{"dummyZone2"},
 			CloudInfoer: map[string]CloudInfoer{
 				"dummy": &DummyCloudInfoer{},
 			},
@@ -522,6 +663,19 @@ func TestCachingCloudInfo_GetPrice(t *testing.T) {
 				assert.Nil(t, err, "the error should be nil")
 			},
 		},
+		{
+			name:  "zone outside of the region",
+			zones: []string{"dummyZone1", "dummyZone3"},
+			CloudInfoer: map[string]CloudInfoer{
+				"dummy": &DummyCloudInfoer{},
+			},
+			checker: func(i float64, f float64, err error) {
+				assert.Equal(t, float64(0), i)
+				assert.Equal(t, float64(0), f)
+				assert.EqualError(t, err, "unknown zone: [dummyZone3]")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
 		{
 			name:  "could not retrieve current prices",
 			zones: []string{"dummyZone1"},
@@ -537,13 +691,36 @@ func TestCachingCloudInfo_GetPrice(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			values, value, err := info.GetPrice(context.Background(), "dummy", "dummyRegion", "c3.large", test.zones)
 			test.checker(values, value, err)
 		})
 	}
 }
 
+func TestCachingCloudInfo_GetSpotPrice(t *testing.T) {
+	info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute),
+		map[string]CloudInfoer{"dummy": &DummyCloudInfoer{}}, DefaultNetworkCategories())
+
+	aggregate, err := info.GetSpotPrice(context.Background(), "dummy", "dummyRegion", "c3.large", []string{"dummyZone1", "dummyZone2"}, SpotStrategyMin, 0)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, 0.053, aggregate.Price)
+	assert.Equal(t, []ZonePrice{{Zone: "dummyZone1", Price: 0.053}}, aggregate.Zones)
+	assert.Equal(t, []string{"dummyZone2"}, aggregate.MissingZones)
+	assert.Equal(t, CurrencyUSD, aggregate.Currency)
+	assert.False(t, aggregate.UpdatedAt.IsZero(), "the time of the price retrieval should be known")
+
+	_, err = info.GetSpotPrice(context.Background(), "dummy", "dummyRegion", "c3.large", nil, "mode", 0)
+	assert.IsType(t, InvalidArgumentError{}, err)
+
+	_, err = info.GetSpotPrice(context.Background(), "dummy", "dummyRegion", "c3.large", []string{"dummyZone3"}, SpotStrategyMin, 0)
+	assert.IsType(t, InvalidArgumentError{}, err, "zones outside of the region should be rejected")
+
+	_, err = info.GetSpotPrice(context.Background(), "dummy", "dummyRegion", "m5.large", nil, SpotStrategyMin, 0)
+	assert.EqualError(t, err, "unknown instance type: [m5.large]")
+	assert.IsType(t, NotFoundError{}, err)
+}
+
 func TestCachingCloudInfo_GetRegions(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -574,12 +751,41 @@ func TestCachingCloudInfo_GetRegions(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer)
+			info, _ := NewCachingCloudInfo(10*time.Second, cache.New(5*time.Minute, 10*time.Minute), test.CloudInfoer, DefaultNetworkCategories())
 			test.checker(info.GetRegions(context.Background(), "dummy", "compute"))
 		})
 	}
 }
 
+func TestCachingCloudInfo_GetAllProductDetails(t *testing.T) {
+	store := cache.New(5*time.Minute, 10*time.Minute)
+	info, _ := NewCachingCloudInfo(10*time.Second, store,
+		map[string]CloudInfoer{"dummy": &DummyCloudInfoer{}, "other": &DummyCloudInfoer{}}, DefaultNetworkCategories())
+	store.Set(info.getRegionsKey("dummy", "compute"), map[string]string{"region-1": "Region 1", "region-2": "Region 2"}, 0)
+	store.Set(info.getRegionsKey("other", "compute"), map[string]string{"region-3": "Region 3"}, 0)
+	store.Set(info.getVmKey("dummy", "compute", "region-1"), []VmInfo{{Type: "type-1", Cpus: 1, Mem: 2, OnDemandPrice: 0.023}}, 0)
+	store.Set(info.getVmKey("other", "compute", "region-3"), []VmInfo{{Type: "type-3", Cpus: 2, Mem: 4, OnDemandPrice: 0.046}}, 0)
+	updatedAt := time.Date(2018, 11, 20, 10, 4, 12, 0, time.UTC)
+	store.Set(info.getPriceKey("dummy", "region-1", "type-1"), Price{OnDemandPrice: 0.023, UpdatedAt: updatedAt}, 0)
+
+	all, err := info.GetAllProductDetails(context.Background(), "compute", nil)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Len(t, all, 2, "the regions not yet cached should be left out")
+	assert.Equal(t, "dummy", all[0].Provider)
+	assert.Equal(t, "region-1", all[0].Region)
+	assert.Equal(t, "type-1", all[0].Products[0].Type)
+	assert.Equal(t, updatedAt, all[0].UpdatedAt)
+	assert.True(t, all[1].UpdatedAt.IsZero(), "the age of the prices should be unknown without cached prices")
+	assert.Equal(t, "other", all[1].Provider)
+
+	all, err = info.GetAllProductDetails(context.Background(), "compute", []string{"other"})
+	assert.Nil(t, err, "the error should be nil")
+	assert.Len(t, all, 1)
+
+	_, err = info.GetAllProductDetails(context.Background(), "compute", []string{"unknown"})
+	assert.IsType(t, NotFoundError{}, err)
+}
+
 func TestCachingCloudInfo_GetProductDetails(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -612,6 +818,7 @@ func TestCachingCloudInfo_GetProductDetails(t *testing.T) {
 					assert.Equal(t, float64(1), info.Cpus)
 					assert.Equal(t, 0.023, info.OnDemandPrice)
 					assert.Equal(t, float64(2), info.Mem)
+					assert.Equal(t, CurrencyUSD, info.Currency, "prices are in USD unless the provider tells otherwise")
 				}
 			},
 		},
@@ -629,8 +836,70 @@ func TestCachingCloudInfo_GetProductDetails(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			info, _ := NewCachingCloudInfo(10*time.Second, test.cache, test.CloudInfoer)
+			info, _ := NewCachingCloudInfo(10*time.Second, test.cache, test.CloudInfoer, DefaultNetworkCategories())
 			test.checker(info.GetProductDetails(context.Background(), "dummy", "dummyService", "dummyRegion"))
 		})
 	}
 }
+
+func TestCachingCloudInfo_LastModified(t *testing.T) {
+	store := cache.New(5*time.Minute, 10*time.Minute)
+	info, _ := NewCachingCloudInfo(10*time.Second, store, map[string]CloudInfoer{"dummy": &DummyCloudInfoer{}}, DefaultNetworkCategories())
+
+	_, err := info.LastModified("dummy")
+	assert.IsType(t, NotYetAvailableError{}, err, "the information is not modified before the first scrape")
+
+	store.Set(info.getStatusKey("dummy"), "1542708252000", 0)
+	lastModified, err := info.LastModified("dummy")
+	assert.Nil(t, err, "the error should be nil")
+	assert.True(t, time.Date(2018, 11, 20, 10, 4, 12, 0, time.UTC).Equal(lastModified))
+
+	store.Set(info.getShortLivedStatusKey("dummy"), "1542708492000", 0)
+	lastModified, err = info.LastModified("dummy")
+	assert.Nil(t, err, "the error should be nil")
+	assert.True(t, time.Date(2018, 11, 20, 10, 8, 12, 0, time.UTC).Equal(lastModified), "the short lived prices renewed later")
+
+	store.Set(info.getStatusKey("dummy"), "1542708552000", 0)
+	lastModified, err = info.LastModified("dummy")
+	assert.Nil(t, err, "the error should be nil")
+	assert.True(t, time.Date(2018, 11, 20, 10, 9, 12, 0, time.UTC).Equal(lastModified), "the scrape completed later")
+}
+
+// longLivedCloudInfoer is a DummyCloudInfoer without short lived prices
+type longLivedCloudInfoer struct {
+	DummyCloudInfoer
+}
+
+func (*longLivedCloudInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+func TestCachingCloudInfo_NextRenewal(t *testing.T) {
+	info, _ := NewCachingCloudInfo(time.Hour, cache.New(5*time.Minute, 10*time.Minute),
+		map[string]CloudInfoer{"dummy": &DummyCloudInfoer{}, "long": &longLivedCloudInfoer{}}, DefaultNetworkCategories())
+	start := time.Date(2018, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	assert.True(t, info.NextRenewal("dummy", start).IsZero(), "no renewal is scheduled before the start")
+
+	info.startedAt = start.UnixNano()
+	assert.True(t, start.Add(4*time.Minute).Equal(info.NextRenewal("dummy", start)))
+	assert.True(t, start.Add(12*time.Minute).Equal(info.NextRenewal("dummy", start.Add(10*time.Minute))), "the short lived prices are renewed first")
+	assert.True(t, start.Add(time.Hour).Equal(info.NextRenewal("long", start.Add(10*time.Minute))))
+	assert.True(t, start.Add(2*time.Hour).Equal(info.NextRenewal("long", start.Add(time.Hour))), "the renewal at now is already started")
+}
+
+func TestCachingCloudInfo_retryAfter(t *testing.T) {
+	store := cache.New(5*time.Minute, 10*time.Minute)
+	info, _ := NewCachingCloudInfo(time.Hour, store,
+		map[string]CloudInfoer{"long": &longLivedCloudInfoer{}}, DefaultNetworkCategories())
+	info.startedAt = time.Now().Add(-10 * time.Minute).UnixNano()
+
+	assert.Equal(t, defaultRetryAfter, info.retryAfter("long"), "the first scrape is in progress")
+
+	store.Set(info.getStatusKey("long"), "1542708252000", 0)
+	assert.InDelta(t, (50 * time.Minute).Seconds(), info.retryAfter("long").Seconds(), 1, "the next renewal should be waited for")
+
+	_, err := info.GetProductDetails(context.Background(), "long", "compute", "region-1")
+	assert.IsType(t, NotYetAvailableError{}, err)
+	assert.InDelta(t, (50 * time.Minute).Seconds(), err.(NotYetAvailableError).RetryAfter.Seconds(), 1)
+}
@@ -0,0 +1,273 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudinfotest provides a conformance suite every cloudinfo.CloudInfoer implementation is expected to pass
+// and a CloudInfoer double with seeded cached information for the tests of the APIs
+package cloudinfotest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+// unknownService is a service name no provider is expected to support
+const unknownService = "conformance-unknown-service"
+
+// Options customizes the conformance checks run against a CloudInfoer
+type Options struct {
+	// Services restricts the checks to the given services; every service of the provider is checked if empty
+	Services []string
+
+	// Regions restricts the region level checks to the given regions; every region of a service is checked if empty
+	Regions []string
+}
+
+// RunConformance checks the invariants that must hold for any CloudInfoer implementation:
+// - the provider has services, and every service is resolved by GetService (unknown services are not found)
+// - the control plane fees of the managed kubernetes services have a known model, a non negative price and a currency
+// - every service has regions
+// - every region has zones
+// - products have a type, positive cpu and memory, non negative bandwidth, and are available in the zones of their region only
+// - products are given in the units of the unit model (vCPUs, GiB, Gbps, hourly prices)
+// - classified products have a known category, burstable products a baseline percentage
+// - prices (both long and short lived) are keyed by instance types known in the region, spot prices by zones of the region
+// - storage types have a type, a known category, non negative prices and a currency
+// - network prices are non negative, the load balancers have a type and the egress tiers are ordered by volume
+func RunConformance(t *testing.T, infoer cloudinfo.CloudInfoer, opts Options) {
+	ctx := context.Background()
+
+	services := checkServices(t, infoer, opts)
+
+	// instance types and zones per region, collected to check the prices against
+	knownTypes := make(map[string]map[string]bool)
+	knownZones := make(map[string][]string)
+
+	for _, service := range services {
+		t.Run("service "+service, func(t *testing.T) {
+			regions, err := infoer.GetRegions(ctx, service)
+			if !assert.Nil(t, err, "the error should be nil") {
+				return
+			}
+			assert.NotEmpty(t, regions, "the service should have regions")
+			for id, name := range regions {
+				assert.NotEmpty(t, id, "the region id should not be empty")
+				assert.NotEmpty(t, name, "the name of the region [%s] should not be empty", id)
+			}
+
+			for _, region := range selectRegions(t, regions, opts.Regions) {
+				t.Run("region "+region, func(t *testing.T) {
+					zones := checkZones(t, infoer, region)
+					knownZones[region] = zones
+
+					if knownTypes[region] == nil {
+						knownTypes[region] = make(map[string]bool)
+					}
+					for _, vm := range checkProducts(t, infoer, service, region, zones) {
+						knownTypes[region][vm.Type] = true
+					}
+				})
+			}
+		})
+	}
+
+	t.Run("prices", func(t *testing.T) {
+		allPrices, err := infoer.Initialize(ctx)
+		if !assert.Nil(t, err, "the error should be nil") {
+			return
+		}
+		for region, prices := range allPrices {
+			if _, ok := knownTypes[region]; !ok {
+				// the products of the region are not checked
+				continue
+			}
+			checkPrices(t, region, prices, knownTypes[region], knownZones[region])
+		}
+	})
+
+	// the storage and network prices may be collected with the prices of the products, so they are checked after initialization
+	t.Run("storage", func(t *testing.T) {
+		for region := range knownTypes {
+			checkStorage(t, infoer, region)
+		}
+	})
+	t.Run("network", func(t *testing.T) {
+		for region := range knownTypes {
+			checkNetworkPrices(t, infoer, region)
+		}
+	})
+
+	if infoer.HasShortLivedPriceInfo() {
+		t.Run("current prices", func(t *testing.T) {
+			for region := range knownTypes {
+				prices, err := infoer.GetCurrentPrices(ctx, region)
+				if !assert.Nil(t, err, "the error should be nil") {
+					continue
+				}
+				checkPrices(t, region, prices, knownTypes[region], knownZones[region])
+			}
+		})
+	}
+}
+
+// checkServices checks the services of the provider and returns the ones the rest of the checks are run for
+func checkServices(t *testing.T, infoer cloudinfo.CloudInfoer, opts Options) []string {
+	var names []string
+
+	t.Run("services", func(t *testing.T) {
+		services, err := infoer.GetServices()
+		if !assert.Nil(t, err, "the error should be nil") {
+			return
+		}
+		assert.NotEmpty(t, services, "the provider should have services")
+
+		for _, svc := range services {
+			sd, err := infoer.GetService(context.Background(), svc.ServiceName())
+			if assert.Nil(t, err, "the service [%s] should be found", svc.ServiceName()) {
+				assert.Equal(t, svc.ServiceName(), sd.ServiceName())
+			}
+			if fee := svc.ControlPlane(); fee != nil {
+				checkControlPlaneFee(t, svc.ServiceName(), *fee)
+			}
+			names = append(names, svc.ServiceName())
+		}
+
+		_, err = infoer.GetService(context.Background(), unknownService)
+		assert.IsType(t, cloudinfo.NotFoundError{}, err, "unknown services should not be found")
+	})
+
+	if len(opts.Services) == 0 {
+		return names
+	}
+	for _, svc := range opts.Services {
+		assert.Contains(t, names, svc, "the service [%s] should be supported by the provider", svc)
+	}
+	return opts.Services
+}
+
+// checkControlPlaneFee checks the control plane fee of a managed kubernetes service
+func checkControlPlaneFee(t *testing.T, service string, fee cloudinfo.ControlPlaneFee) {
+	models := []string{cloudinfo.ControlPlaneFree, cloudinfo.ControlPlaneHourly, cloudinfo.ControlPlaneFreeTier}
+	assert.Contains(t, models, fee.Model, "the control plane model of [%s] should be known", service)
+	assert.True(t, fee.PricePerHour >= 0, "the control plane price of [%s] should not be negative", service)
+	if fee.Model == cloudinfo.ControlPlaneFree {
+		assert.Equal(t, 0.0, fee.PricePerHour, "the free control plane of [%s] should not be charged", service)
+	}
+	assert.NotEmpty(t, fee.Currency, "the control plane currency of [%s] should not be empty", service)
+}
+
+// selectRegions returns the regions to be checked out of the regions of a service
+func selectRegions(t *testing.T, regions map[string]string, selected []string) []string {
+	if len(selected) == 0 {
+		var ids []string
+		for id := range regions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	for _, id := range selected {
+		assert.Contains(t, regions, id, "the region [%s] should be supported by the service", id)
+	}
+	return selected
+}
+
+// checkZones checks the zones of the region and returns them
+func checkZones(t *testing.T, infoer cloudinfo.CloudInfoer, region string) []string {
+	zones, err := infoer.GetZones(context.Background(), region)
+	if !assert.Nil(t, err, "the error should be nil") {
+		return nil
+	}
+	assert.NotEmpty(t, zones, "the region should have zones")
+	for _, zone := range zones {
+		assert.NotEmpty(t, zone, "the zone id should not be empty")
+	}
+	return zones
+}
+
+// checkProducts checks the products of the service in the region and returns them
+func checkProducts(t *testing.T, infoer cloudinfo.CloudInfoer, service, region string, zones []string) []cloudinfo.VmInfo {
+	vms, err := infoer.GetProducts(context.Background(), service, region)
+	if !assert.Nil(t, err, "the error should be nil") {
+		return nil
+	}
+	for _, vm := range vms {
+		assert.NotEmpty(t, vm.Type, "the instance type should not be empty")
+		assert.True(t, vm.Cpus > 0, "the cpu of [%s] should be positive", vm.Type)
+		assert.True(t, vm.Mem > 0, "the memory of [%s] should be positive", vm.Type)
+		assert.True(t, vm.Bandwidth >= 0, "the bandwidth of [%s] should not be negative", vm.Type)
+		assert.Nil(t, vm.Validate(), "[%s] should match the unit model", vm.Type)
+		if vm.Category != "" {
+			assert.Contains(t, cloudinfo.Categories(), vm.Category, "the category of [%s] should be known", vm.Type)
+		}
+		if vm.BurstInfo != nil {
+			assert.True(t, vm.BurstInfo.BaselineCpu >= 0 && vm.BurstInfo.BaselineCpu <= 100,
+				"the baseline of [%s] should be a percentage", vm.Type)
+			assert.True(t, vm.BurstInfo.CreditsPerHour >= 0, "the cpu credits of [%s] should not be negative", vm.Type)
+		}
+		for _, zone := range vm.Zones {
+			assert.Contains(t, zones, zone, "[%s] should be available in the zones of the region only", vm.Type)
+		}
+	}
+	return vms
+}
+
+// checkPrices checks that the prices of a region are keyed by known instance types and zones
+func checkPrices(t *testing.T, region string, prices map[string]cloudinfo.Price, types map[string]bool, zones []string) {
+	for instanceType, price := range prices {
+		assert.True(t, types[instanceType], "the priced instance type [%s] should be known in region [%s]", instanceType, region)
+		for zone, spotPrice := range price.SpotPrice {
+			assert.Contains(t, zones, zone, "the spot price of [%s] should be keyed by a zone of region [%s]", instanceType, region)
+			assert.True(t, spotPrice >= 0, "the spot price of [%s] should not be negative", instanceType)
+		}
+	}
+}
+
+// checkStorage checks the storage types and prices of a region
+func checkStorage(t *testing.T, infoer cloudinfo.CloudInfoer, region string) {
+	storage, err := infoer.GetStorage(context.Background(), region)
+	if !assert.Nil(t, err, "the error should be nil") {
+		return
+	}
+	for _, s := range storage {
+		assert.NotEmpty(t, s.Type, "the storage type should not be empty")
+		assert.Contains(t, []string{cloudinfo.DiskTypeSsd, cloudinfo.DiskTypeHdd}, s.Category, "the category of [%s] should be known", s.Type)
+		assert.True(t, s.PricePerGbMonth >= 0, "the price of [%s] should not be negative", s.Type)
+		assert.True(t, s.PricePerIopsMonth >= 0, "the iops price of [%s] should not be negative", s.Type)
+		assert.NotEmpty(t, s.Currency, "the currency of [%s] should not be empty", s.Type)
+	}
+}
+
+// checkNetworkPrices checks the network prices of a region
+func checkNetworkPrices(t *testing.T, infoer cloudinfo.CloudInfoer, region string) {
+	prices, err := infoer.GetNetworkPrices(context.Background(), region)
+	if !assert.Nil(t, err, "the error should be nil") {
+		return
+	}
+	assert.NotEmpty(t, prices.Currency, "the currency of the network prices should not be empty")
+	assert.True(t, prices.InterZonePerGb >= 0, "the inter zone price should not be negative")
+	for _, lb := range prices.LoadBalancers {
+		assert.NotEmpty(t, lb.Type, "the load balancer type should not be empty")
+		assert.True(t, lb.PricePerHour >= 0 && lb.PricePerGb >= 0 && lb.PricePerCapacityUnitHour >= 0,
+			"the prices of the [%s] load balancer should not be negative", lb.Type)
+	}
+	for i, tier := range prices.InternetEgress {
+		assert.True(t, tier.PricePerGb >= 0, "the egress price should not be negative")
+		assert.True(t, tier.EndGb == 0 || tier.EndGb > tier.StartGb, "the egress tier should end after its start")
+		if i > 0 {
+			assert.True(t, tier.StartGb > prices.InternetEgress[i-1].StartGb, "the egress tiers should be ordered by volume")
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+const (
+	// Provider is the provider with cached products in Region
+	Provider = "dummy"
+
+	// BrokenProvider is the provider whose services can't be retrieved
+	BrokenProvider = "broken"
+
+	// Region is the region of Provider with cached products
+	Region = "region-1"
+
+	// ScrapingRegion is the region of Provider without cached products
+	ScrapingRegion = "region-2"
+)
+
+// Infoer is a CloudInfoer serving the compute service in two regions
+// the struct is to be extended according to the needs of test cases
+type Infoer struct {
+	ServicesErr error
+}
+
+func (ti *Infoer) Initialize(ctx context.Context) (map[string]map[string]cloudinfo.Price, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetAttributeValues(ctx context.Context, service, attribute string) (cloudinfo.AttrValues, error) {
+	return cloudinfo.AttrValues{{StrValue: "2", Value: 2}, {StrValue: "8", Value: 8}}, nil
+}
+
+func (ti *Infoer) GetProducts(ctx context.Context, service, regionId string) ([]cloudinfo.VmInfo, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetZones(ctx context.Context, region string) ([]string, error) {
+	return []string{region + "a", region + "b"}, nil
+}
+
+func (ti *Infoer) GetRegions(ctx context.Context, service string) (map[string]string, error) {
+	return map[string]string{Region: "Region 1", ScrapingRegion: "Region 2"}, nil
+}
+
+func (ti *Infoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+func (ti *Infoer) GetCurrentPrices(ctx context.Context, region string) (map[string]cloudinfo.Price, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetMemoryAttrName() string {
+	return cloudinfo.Memory
+}
+
+func (ti *Infoer) GetCpuAttrName() string {
+	return cloudinfo.Cpu
+}
+
+func (ti *Infoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
+	if ti.ServicesErr != nil {
+		return nil, ti.ServicesErr
+	}
+	return []cloudinfo.ServiceDescriber{cloudinfo.NewService("compute")}, nil
+}
+
+func (ti *Infoer) GetService(ctx context.Context, service string) (cloudinfo.ServiceDescriber, error) {
+	if service != "compute" {
+		return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
+	}
+	return cloudinfo.NewService(service), nil
+}
+
+func (ti *Infoer) HasImages() bool {
+	return false
+}
+
+func (ti *Infoer) GetServiceImages(region, service string) ([]cloudinfo.ImageDescriber, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetVersions(ctx context.Context, service, region string) ([]string, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetServiceProducts(region, service string) ([]cloudinfo.ProductDetails, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetServiceAttributes(region, service, attribute string) (cloudinfo.AttrValues, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	return nil, nil
+}
+
+func (ti *Infoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	return cloudinfo.NetworkPrices{}, nil
+}
+
+// NewCachingCloudInfo creates a CachingCloudInfo of Provider and BrokenProvider with two products of Provider cached
+// in Region. The scrape of Provider is not completed: its status is to be set in the returned store if needed
+func NewCachingCloudInfo() (*cloudinfo.CachingCloudInfo, *cache.Cache) {
+	store := cache.New(cache.NoExpiration, time.Hour)
+	store.Set(fmt.Sprintf(cloudinfo.VmKeyTemplate, Provider, "compute", Region), []cloudinfo.VmInfo{
+		{Type: "small", Cpus: 2, Mem: 4, OnDemandPrice: 0.1},
+		{Type: "large", Cpus: 8, Mem: 32, OnDemandPrice: 0.4},
+	}, 0)
+	store.Set(fmt.Sprintf(cloudinfo.PriceKeyTemplate, Provider, Region, "large"), cloudinfo.Price{
+		OnDemandPrice: 0.4,
+		SpotPrice:     cloudinfo.SpotPriceInfo{Region + "a": 0.12},
+	}, 0)
+
+	cpi, err := cloudinfo.NewCachingCloudInfo(time.Hour, store, map[string]cloudinfo.CloudInfoer{
+		Provider:       &Infoer{},
+		BrokenProvider: &Infoer{ServicesErr: errors.New("could not retrieve services")},
+	}, cloudinfo.DefaultNetworkCategories())
+	if err != nil {
+		panic(err)
+	}
+	return cpi, store
+}
@@ -0,0 +1,122 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+const (
+	// Term1Year is a one year long commitment
+	Term1Year = "1yr"
+
+	// Term3Year is a three years long commitment
+	Term3Year = "3yr"
+
+	// PaymentNoUpfront means the commitment is paid hourly / monthly
+	PaymentNoUpfront = "no-upfront"
+
+	// PaymentPartialUpfront means part of the commitment is paid upfront, the rest hourly / monthly
+	PaymentPartialUpfront = "partial-upfront"
+
+	// PaymentAllUpfront means the whole commitment is paid upfront
+	PaymentAllUpfront = "all-upfront"
+
+	// PricingModelOnDemand prices the products with the on demand price
+	PricingModelOnDemand = "on-demand"
+
+	// PricingModelCommitment1Year prices the products with the effective hourly price of a one year commitment
+	PricingModelCommitment1Year = "commitment-1yr"
+
+	// PricingModelCommitment3Year prices the products with the effective hourly price of a three years commitment
+	PricingModelCommitment3Year = "commitment-3yr"
+)
+
+// termHours holds the number of hours of the commitment terms
+var termHours = map[string]float64{
+	Term1Year: 365 * 24,
+	Term3Year: 3 * 365 * 24,
+}
+
+// CommitmentPrice is the price of an instance type when capacity is committed for a term:
+// reserved instances on amazon, committed use discounts on google, reservations on azure
+type CommitmentPrice struct {
+	// Term is the length of the commitment (1yr or 3yr)
+	Term string `json:"term"`
+	// PaymentOption tells how the commitment is paid (no-upfront, partial-upfront or all-upfront)
+	PaymentOption string `json:"paymentOption"`
+	// Upfront is the price paid upfront for the whole term
+	Upfront float64 `json:"upfront"`
+	// Hourly is the recurring hourly price
+	Hourly float64 `json:"hourly"`
+	// EffectiveHourly is the hourly price with the upfront price spread over the term
+	EffectiveHourly float64 `json:"effectiveHourly"`
+}
+
+// NewCommitmentPrice creates a new commitment price, the effective hourly price is derived from the upfront and hourly prices
+func NewCommitmentPrice(term, paymentOption string, upfront, hourly float64) CommitmentPrice {
+	effective := hourly
+	if hours, ok := termHours[term]; ok {
+		effective += upfront / hours
+	}
+	return CommitmentPrice{
+		Term:            term,
+		PaymentOption:   paymentOption,
+		Upfront:         upfront,
+		Hourly:          hourly,
+		EffectiveHourly: effective,
+	}
+}
+
+// PricingModels returns the supported pricing models
+func PricingModels() []string {
+	return []string{PricingModelOnDemand, PricingModelCommitment1Year, PricingModelCommitment3Year}
+}
+
+// PaymentOptions returns the supported payment options of the commitments
+func PaymentOptions() []string {
+	return []string{PaymentNoUpfront, PaymentPartialUpfront, PaymentAllUpfront}
+}
+
+// SelectPricingModel returns the product details priced according to the given pricing model
+// For commitments the on demand price is replaced by the effective hourly price of the commitment with the given term and
+// payment option (no upfront by default); products without such commitment are left out
+func SelectPricingModel(details []ProductDetails, pricingModel, paymentOption string) ([]ProductDetails, error) {
+	if pricingModel == "" || pricingModel == PricingModelOnDemand {
+		return details, nil
+	}
+	if !Contains(PricingModels(), pricingModel) {
+		return nil, NewInvalidArgumentError("unsupported pricing model: [%s]", pricingModel)
+	}
+	if paymentOption == "" {
+		paymentOption = PaymentNoUpfront
+	}
+	if !Contains(PaymentOptions(), paymentOption) {
+		return nil, NewInvalidArgumentError("unsupported payment option: [%s]", paymentOption)
+	}
+
+	term := Term1Year
+	if pricingModel == PricingModelCommitment3Year {
+		term = Term3Year
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		for _, c := range d.Commitments {
+			if c.Term == term && c.PaymentOption == paymentOption {
+				d.OnDemandPrice = c.EffectiveHourly
+				selected = append(selected, d)
+				break
+			}
+		}
+	}
+	return selected, nil
+}
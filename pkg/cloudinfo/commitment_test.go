@@ -0,0 +1,116 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCommitmentPrice(t *testing.T) {
+	c := NewCommitmentPrice(Term3Year, PaymentPartialUpfront, 2628, 0.05)
+
+	assert.Equal(t, Term3Year, c.Term)
+	assert.Equal(t, PaymentPartialUpfront, c.PaymentOption)
+	assert.InDelta(t, 0.15, c.EffectiveHourly, 1e-9, "the upfront price should be spread over the term")
+}
+
+func TestSelectPricingModel(t *testing.T) {
+	details := []ProductDetails{
+		{
+			VmInfo: VmInfo{
+				Type:          "m5.large",
+				OnDemandPrice: 0.1,
+				Commitments: []CommitmentPrice{
+					NewCommitmentPrice(Term1Year, PaymentNoUpfront, 0, 0.07),
+					NewCommitmentPrice(Term1Year, PaymentAllUpfront, 525.6, 0),
+				},
+			},
+		},
+		{
+			VmInfo: VmInfo{
+				Type:          "t2.nano",
+				OnDemandPrice: 0.005,
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		pricingModel  string
+		paymentOption string
+		check         func(details []ProductDetails, err error)
+	}{
+		{
+			name:         "on demand prices are returned by default",
+			pricingModel: "",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:         "products are priced with the no upfront commitment by default",
+			pricingModel: PricingModelCommitment1Year,
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(selected)) {
+					assert.Equal(t, "m5.large", selected[0].Type)
+					assert.Equal(t, 0.07, selected[0].OnDemandPrice)
+				}
+			},
+		},
+		{
+			name:          "products are priced with the selected payment option",
+			pricingModel:  PricingModelCommitment1Year,
+			paymentOption: PaymentAllUpfront,
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(selected)) {
+					assert.InDelta(t, 0.06, selected[0].OnDemandPrice, 1e-9)
+				}
+			},
+		},
+		{
+			name:         "no products with the commitment",
+			pricingModel: PricingModelCommitment3Year,
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Empty(t, selected)
+			},
+		},
+		{
+			name:         "unsupported pricing model",
+			pricingModel: "lease",
+			check: func(selected []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:          "unsupported payment option",
+			pricingModel:  PricingModelCommitment1Year,
+			paymentOption: "monthly",
+			check: func(selected []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectPricingModel(details, test.pricingModel, test.paymentOption))
+		})
+	}
+}
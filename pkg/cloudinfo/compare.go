@@ -0,0 +1,130 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultMatches is the number of the closest matches listed per region if not told otherwise
+const DefaultMatches = 1
+
+// Shape describes the resources the instance types are compared by
+type Shape struct {
+	// Cpus is the number of vCPUs
+	Cpus float64 `json:"cpus"`
+	// Mem is the memory in GiB
+	Mem float64 `json:"mem"`
+	// Gpus is the number of gpus
+	Gpus float64 `json:"gpus"`
+}
+
+// ShapeOf returns the shape of the product
+func ShapeOf(d ProductDetails) Shape {
+	return Shape{Cpus: d.Cpus, Mem: d.Mem, Gpus: d.Gpus}
+}
+
+// Similarity tells how close the shape is to the reference between 0 and 1: the mean of the ratios of the smaller and
+// the larger cpu, memory and gpu values, a resource missing from both shapes counts as a full match
+func (s Shape) Similarity(reference Shape) float64 {
+	ratio := func(a, b float64) float64 {
+		if a == b {
+			return 1
+		}
+		return math.Min(a, b) / math.Max(a, b)
+	}
+	return (ratio(s.Cpus, reference.Cpus) + ratio(s.Mem, reference.Mem) + ratio(s.Gpus, reference.Gpus)) / 3
+}
+
+// InstanceMatch is an instance type of a provider region matching a reference
+type InstanceMatch struct {
+	Provider string `json:"provider"`
+	Region   string `json:"region"`
+	Type     string `json:"type"`
+	Shape
+	// OnDemandPrice is the hourly on demand price of the instance type
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency"`
+	// Similarity tells how close the instance type is to the reference between 0 and 1 (see Shape.Similarity)
+	Similarity float64 `json:"similarity"`
+	// PriceDelta is the difference of the on demand price from the reference price
+	PriceDelta float64 `json:"priceDelta"`
+	// PriceDeltaPercent is the difference of the on demand price from the reference price in percent of the reference
+	PriceDeltaPercent float64 `json:"priceDeltaPercent"`
+}
+
+// CompareProducts returns the count closest matches of the reference shape in every region, ordered by provider, region
+// and rank; the more similar and then the cheaper instance types rank higher. The price deltas are relative to the
+// reference price, or to the cheapest listed match if the reference price is not known (0). The products of
+// the regions should be given in the same currency
+func CompareProducts(reference Shape, referencePrice float64, regions []RegionProducts, count int) ([]InstanceMatch, error) {
+	if reference.Cpus < 0 || reference.Mem < 0 || reference.Gpus < 0 || (reference.Cpus == 0 && reference.Mem == 0) {
+		return nil, NewInvalidArgumentError("the cpu and memory of the reference should not be negative, and at least one of them should be positive")
+	}
+	if count < 0 {
+		return nil, NewInvalidArgumentError("the number of the matches should not be negative: [%d]", count)
+	}
+	if count == 0 {
+		count = DefaultMatches
+	}
+
+	matches := make([]InstanceMatch, 0)
+	for _, rp := range regions {
+		regionMatches := make([]InstanceMatch, 0, len(rp.Products))
+		for _, d := range rp.Products {
+			shape := ShapeOf(d)
+			regionMatches = append(regionMatches, InstanceMatch{
+				Provider:      rp.Provider,
+				Region:        rp.Region,
+				Type:          d.Type,
+				Shape:         shape,
+				OnDemandPrice: d.OnDemandPrice,
+				Currency:      d.Currency,
+				Similarity:    shape.Similarity(reference),
+			})
+		}
+		sort.Slice(regionMatches, func(i, j int) bool {
+			mi, mj := regionMatches[i], regionMatches[j]
+			if mi.Similarity != mj.Similarity {
+				return mi.Similarity > mj.Similarity
+			}
+			if mi.OnDemandPrice != mj.OnDemandPrice {
+				return mi.OnDemandPrice < mj.OnDemandPrice
+			}
+			return mi.Type < mj.Type
+		})
+		if len(regionMatches) > count {
+			regionMatches = regionMatches[:count]
+		}
+		matches = append(matches, regionMatches...)
+	}
+
+	if referencePrice <= 0 {
+		for _, m := range matches {
+			if referencePrice <= 0 || m.OnDemandPrice < referencePrice {
+				referencePrice = m.OnDemandPrice
+			}
+		}
+	}
+	if referencePrice > 0 {
+		for i := range matches {
+			matches[i].PriceDelta = matches[i].OnDemandPrice - referencePrice
+			matches[i].PriceDeltaPercent = matches[i].PriceDelta / referencePrice * 100
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShape_Similarity(t *testing.T) {
+	assert.Equal(t, float64(1), Shape{Cpus: 4, Mem: 16}.Similarity(Shape{Cpus: 4, Mem: 16}))
+	assert.InDelta(t, (0.5+1+1)/3, Shape{Cpus: 2, Mem: 16}.Similarity(Shape{Cpus: 4, Mem: 16}), 1e-9)
+	assert.InDelta(t, (1+1+0)/3.0, Shape{Cpus: 4, Mem: 16}.Similarity(Shape{Cpus: 4, Mem: 16, Gpus: 1}), 1e-9)
+}
+
+func TestCompareProducts(t *testing.T) {
+	regions := []RegionProducts{
+		{
+			Provider: "amazon",
+			Region:   "eu-west-1",
+			Products: []ProductDetails{
+				{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16, OnDemandPrice: 0.214, Currency: CurrencyUSD}},
+				{VmInfo: VmInfo{Type: "m5a.xlarge", Cpus: 4, Mem: 16, OnDemandPrice: 0.192, Currency: CurrencyUSD}},
+				{VmInfo: VmInfo{Type: "c5.xlarge", Cpus: 4, Mem: 8, OnDemandPrice: 0.192, Currency: CurrencyUSD}},
+			},
+		},
+		{
+			Provider: "google",
+			Region:   "europe-west1",
+			Products: []ProductDetails{
+				{VmInfo: VmInfo{Type: "n1-standard-4", Cpus: 4, Mem: 15, OnDemandPrice: 0.2092, Currency: CurrencyUSD}},
+				{VmInfo: VmInfo{Type: "n1-highmem-4", Cpus: 4, Mem: 26, OnDemandPrice: 0.2604, Currency: CurrencyUSD}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		reference      Shape
+		referencePrice float64
+		count          int
+		check          func(matches []InstanceMatch, err error)
+	}{
+		{
+			name:      "the closest match of every region relative to the cheapest match",
+			reference: Shape{Cpus: 4, Mem: 16},
+			check: func(matches []InstanceMatch, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, matches, 2)
+				assert.Equal(t, "m5a.xlarge", matches[0].Type, "the cheaper of the equally similar types ranks higher")
+				assert.Equal(t, float64(1), matches[0].Similarity)
+				assert.Equal(t, float64(0), matches[0].PriceDelta)
+				assert.Equal(t, "n1-standard-4", matches[1].Type)
+				assert.Equal(t, "google", matches[1].Provider)
+				assert.InDelta(t, 0.0172, matches[1].PriceDelta, 1e-9)
+				assert.InDelta(t, 8.958333, matches[1].PriceDeltaPercent, 1e-6)
+			},
+		},
+		{
+			name:           "several matches relative to the reference price",
+			reference:      Shape{Cpus: 4, Mem: 16},
+			referencePrice: 0.214,
+			count:          2,
+			check: func(matches []InstanceMatch, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, matches, 4)
+				assert.Equal(t, "m5.xlarge", matches[1].Type)
+				assert.Equal(t, float64(0), matches[1].PriceDelta)
+				assert.Equal(t, "n1-highmem-4", matches[3].Type)
+			},
+		},
+		{
+			name:      "invalid reference",
+			reference: Shape{Gpus: 1},
+			check: func(matches []InstanceMatch, err error) {
+				assert.Nil(t, matches, "the matches should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(CompareProducts(test.reference, test.referencePrice, regions, test.count))
+		})
+	}
+}
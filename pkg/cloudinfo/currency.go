@@ -0,0 +1,201 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// CurrencyUSD is the currency the providers publish their prices in, unless told otherwise
+const CurrencyUSD = "USD"
+
+// ExchangeRater provides the exchange rates the prices are converted with
+type ExchangeRater interface {
+	// ExchangeRate returns the price of one unit of the from currency in the to currency
+	ExchangeRate(ctx context.Context, from, to string) (float64, error)
+}
+
+// StaticExchangeRates holds fixed exchange rates relative to a base currency
+type StaticExchangeRates struct {
+	// Base is the currency the rates are given for, USD if empty
+	Base string `json:"base"`
+	// Rates holds the price of one unit of the base currency in other currencies, keyed by ISO 4217 currency codes
+	Rates map[string]float64 `json:"rates"`
+}
+
+// NewStaticExchangeRates loads the exchange rates from the given json file
+// Without a file only the prices in USD can be served
+func NewStaticExchangeRates(path string) (*StaticExchangeRates, error) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD}
+	if path == "" {
+		return rates, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the exchange rates: %s", err)
+	}
+	if err := json.Unmarshal(data, rates); err != nil {
+		return nil, fmt.Errorf("could not parse the exchange rates: %s", err)
+	}
+	normalized := make(map[string]float64, len(rates.Rates))
+	for currency, rate := range rates.Rates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate for %s: %v", currency, rate)
+		}
+		normalized[strings.ToUpper(currency)] = rate
+	}
+	rates.Rates = normalized
+	return rates, nil
+}
+
+// ExchangeRate returns the price of one unit of the from currency in the to currency
+func (r *StaticExchangeRates) ExchangeRate(ctx context.Context, from, to string) (float64, error) {
+	fromRate, err := r.rate(from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := r.rate(to)
+	if err != nil {
+		return 0, err
+	}
+	return toRate / fromRate, nil
+}
+
+// rate returns the price of one unit of the base currency in the given currency
+func (r *StaticExchangeRates) rate(currency string) (float64, error) {
+	currency = strings.ToUpper(currency)
+	if currency == strings.ToUpper(r.Base) {
+		return 1, nil
+	}
+	rate, ok := r.Rates[currency]
+	if !ok {
+		return 0, NewInvalidArgumentError("unsupported currency: [%s]", currency)
+	}
+	return rate, nil
+}
+
+// ConvertCurrency returns the product details with every price converted to the given currency
+// The prices are copied as the details share them with the cache; an empty currency leaves the prices in the source currency
+func ConvertCurrency(ctx context.Context, details []ProductDetails, currency string, rater ExchangeRater) ([]ProductDetails, error) {
+	if currency == "" {
+		return details, nil
+	}
+
+	c := newConverter(ctx, currency, rater)
+	converted := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		rate, err := c.rate(d.Currency)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, d.convert(c.currency, rate))
+	}
+	return converted, nil
+}
+
+// converter converts prices to a target currency, every exchange rate is looked up once
+type converter struct {
+	ctx      context.Context
+	currency string
+	rater    ExchangeRater
+	rates    map[string]float64
+}
+
+func newConverter(ctx context.Context, currency string, rater ExchangeRater) *converter {
+	return &converter{
+		ctx:      ctx,
+		currency: strings.ToUpper(currency),
+		rater:    rater,
+		rates:    make(map[string]float64),
+	}
+}
+
+// rate returns the exchange rate from the source currency (USD if empty) to the target currency
+func (c *converter) rate(source string) (float64, error) {
+	source = strings.ToUpper(source)
+	if source == "" {
+		source = CurrencyUSD
+	}
+	if source == c.currency {
+		return 1, nil
+	}
+	if rate, ok := c.rates[source]; ok {
+		return rate, nil
+	}
+
+	rate, err := c.rater.ExchangeRate(c.ctx, source, c.currency)
+	if err != nil {
+		return 0, err
+	}
+	c.rates[source] = rate
+	return rate, nil
+}
+
+// convert returns a copy of the product details with the prices multiplied by the exchange rate
+func (d ProductDetails) convert(currency string, rate float64) ProductDetails {
+	d.Currency = currency
+	if rate == 1 {
+		return d
+	}
+	d.OnDemandPrice *= rate
+
+	if d.SpotPrice != nil {
+		spotPrice := make(SpotPriceInfo, len(d.SpotPrice))
+		for zone, price := range d.SpotPrice {
+			spotPrice[zone] = price * rate
+		}
+		d.SpotPrice = spotPrice
+	}
+	if d.SpotInfo != nil {
+		spotInfo := make([]ZonePrice, len(d.SpotInfo))
+		for i, zp := range d.SpotInfo {
+			spotInfo[i] = ZonePrice{Zone: zp.Zone, Price: zp.Price * rate}
+		}
+		d.SpotInfo = spotInfo
+	}
+	if d.SpotStats != nil {
+		spotStats := make(map[string]SpotStats, len(d.SpotStats))
+		for zone, stats := range d.SpotStats {
+			spotStats[zone] = stats.convert(rate)
+		}
+		d.SpotStats = spotStats
+	}
+	if d.OsPrices != nil {
+		osPrices := make(OsPrices, len(d.OsPrices))
+		for os, price := range d.OsPrices {
+			osPrices[os] = price * rate
+		}
+		d.OsPrices = osPrices
+	}
+	if d.Commitments != nil {
+		commitments := make([]CommitmentPrice, len(d.Commitments))
+		for i, c := range d.Commitments {
+			commitments[i] = CommitmentPrice{
+				Term:            c.Term,
+				PaymentOption:   c.PaymentOption,
+				Upfront:         c.Upfront * rate,
+				Hourly:          c.Hourly * rate,
+				EffectiveHourly: c.EffectiveHourly * rate,
+			}
+		}
+		d.Commitments = commitments
+	}
+	return d
+}
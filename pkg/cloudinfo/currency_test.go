@@ -0,0 +1,149 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStaticExchangeRates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rates")
+	if err != nil {
+		t.Fatalf("failed to create temp dir; [%s]", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name    string
+		content string
+		check   func(rates *StaticExchangeRates, err error)
+	}{
+		{
+			name:    "rates are loaded with upper case currency codes",
+			content: `{"base": "USD", "rates": {"eur": 0.8, "GBP": 0.75}}`,
+			check: func(rates *StaticExchangeRates, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, map[string]float64{"EUR": 0.8, "GBP": 0.75}, rates.Rates)
+			},
+		},
+		{
+			name:    "invalid rate",
+			content: `{"base": "USD", "rates": {"EUR": 0}}`,
+			check: func(rates *StaticExchangeRates, err error) {
+				assert.EqualError(t, err, "invalid exchange rate for EUR: 0")
+			},
+		},
+		{
+			name:    "invalid file",
+			content: `rates`,
+			check: func(rates *StaticExchangeRates, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(dir, "rates.json")
+			if err := ioutil.WriteFile(path, []byte(test.content), 0644); err != nil {
+				t.Fatalf("failed to write rates; [%s]", err.Error())
+			}
+			test.check(NewStaticExchangeRates(path))
+		})
+	}
+}
+
+func TestStaticExchangeRates_ExchangeRate(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.8, "GBP": 0.64}}
+
+	rate, err := rates.ExchangeRate(context.Background(), "USD", "eur")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, 0.8, rate)
+
+	rate, err = rates.ExchangeRate(context.Background(), "EUR", "GBP")
+	assert.Nil(t, err, "the error should be nil")
+	assert.InDelta(t, 0.8, rate, 1e-9, "cross rates should be derived from the base currency")
+
+	_, err = rates.ExchangeRate(context.Background(), "USD", "HUF")
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
+
+func TestConvertCurrency(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	details := []ProductDetails{
+		{
+			VmInfo: VmInfo{
+				Type:          "m5.large",
+				OnDemandPrice: 0.1,
+				OsPrices:      OsPrices{OsLinux: 0.1, OsWindows: 0.2},
+				Commitments:   []CommitmentPrice{NewCommitmentPrice(Term1Year, PaymentPartialUpfront, 876, 0.02)},
+				Currency:      CurrencyUSD,
+				SpotStats:     map[string]SpotStats{"eu-west-1a": {Min: 0.02, Max: 0.06, Mean: 0.04, P50: 0.04, P90: 0.06, Volatility: 0.3, Samples: 3}},
+			},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.04}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		currency string
+		check    func(converted []ProductDetails, err error)
+	}{
+		{
+			name:     "prices are kept in the source currency by default",
+			currency: "",
+			check: func(converted []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, converted)
+			},
+		},
+		{
+			name:     "every price is converted",
+			currency: "eur",
+			check: func(converted []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(converted)) {
+					assert.Equal(t, "EUR", converted[0].Currency)
+					assert.Equal(t, 0.05, converted[0].OnDemandPrice)
+					assert.Equal(t, OsPrices{OsLinux: 0.05, OsWindows: 0.1}, converted[0].OsPrices)
+					assert.Equal(t, []ZonePrice{{Zone: "eu-west-1a", Price: 0.02}}, converted[0].SpotInfo)
+					assert.Equal(t, 438.0, converted[0].Commitments[0].Upfront)
+					assert.InDelta(t, 0.06, converted[0].Commitments[0].EffectiveHourly, 1e-9)
+					assert.Equal(t, SpotStats{Min: 0.01, Max: 0.03, Mean: 0.02, P50: 0.02, P90: 0.03, Volatility: 0.3, Samples: 3},
+						converted[0].SpotStats["eu-west-1a"], "the volatility is relative to the mean")
+				}
+				assert.Equal(t, 0.1, details[0].OnDemandPrice, "the source prices should be left untouched")
+				assert.Equal(t, 0.2, details[0].OsPrices[OsWindows], "the source prices should be left untouched")
+			},
+		},
+		{
+			name:     "unsupported currency",
+			currency: "HUF",
+			check: func(converted []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(ConvertCurrency(context.Background(), details, test.currency, rates))
+		})
+	}
+}
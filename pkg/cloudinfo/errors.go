@@ -0,0 +1,130 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ErrCodeNotFound is the stable code of NotFoundError
+	ErrCodeNotFound = "not_found"
+
+	// ErrCodeNotYetAvailable is the stable code of NotYetAvailableError
+	ErrCodeNotYetAvailable = "not_yet_available"
+
+	// ErrCodeProviderUnavailable is the stable code of ProviderUnavailableError
+	ErrCodeProviderUnavailable = "provider_unavailable"
+
+	// ErrCodeInvalidArgument is the stable code of InvalidArgumentError
+	ErrCodeInvalidArgument = "invalid_argument"
+
+	// ErrCodeInternal is the code used for errors that are not typed
+	ErrCodeInternal = "internal"
+
+	// defaultRetryAfter is the retry hint given when cached information is missing and the time it can be cached at is
+	// unknown (eg.: the first scrape of the provider is in progress)
+	defaultRetryAfter = time.Minute
+)
+
+// ErrNoProviders signals that the application is started without providers, a misconfiguration of the server
+var ErrNoProviders = errors.New("no providers are configured")
+
+// NotFoundError signals that the requested resource (provider, service, attribute etc ...) is unknown
+type NotFoundError struct {
+	msg string
+}
+
+// NewNotFoundError creates a new NotFoundError with the formatted message
+func NewNotFoundError(format string, args ...interface{}) error {
+	return NotFoundError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (e NotFoundError) Error() string {
+	return e.msg
+}
+
+// NotYetAvailableError signals that the requested information is not yet scraped / cached
+// RetryAfter holds a hint for the client about when to retry the request
+type NotYetAvailableError struct {
+	msg        string
+	RetryAfter time.Duration
+}
+
+// NewNotYetAvailableError creates a new NotYetAvailableError with the default retry hint
+func NewNotYetAvailableError(format string, args ...interface{}) error {
+	return NotYetAvailableError{
+		msg:        fmt.Sprintf(format, args...),
+		RetryAfter: defaultRetryAfter,
+	}
+}
+
+func (e NotYetAvailableError) Error() string {
+	return e.msg
+}
+
+// ProviderUnavailableError signals that the cloud provider (or its API) couldn't serve the request
+// The message of the original error is kept unchanged
+type ProviderUnavailableError struct {
+	Provider string
+	Cause    error
+}
+
+// NewProviderUnavailableError wraps the error returned by the given provider; nil errors are left untouched
+func NewProviderUnavailableError(provider string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(ProviderUnavailableError); ok {
+		return err
+	}
+	return ProviderUnavailableError{Provider: provider, Cause: err}
+}
+
+func (e ProviderUnavailableError) Error() string {
+	return e.Cause.Error()
+}
+
+// InvalidArgumentError signals that the arguments of a request are not valid
+type InvalidArgumentError struct {
+	msg string
+}
+
+// NewInvalidArgumentError creates a new InvalidArgumentError with the formatted message
+func NewInvalidArgumentError(format string, args ...interface{}) error {
+	return InvalidArgumentError{msg: fmt.Sprintf(format, args...)}
+}
+
+func (e InvalidArgumentError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the stable code corresponding to the type of the error
+func ErrorCode(err error) string {
+	switch err.(type) {
+	case NotFoundError:
+		return ErrCodeNotFound
+	case NotYetAvailableError:
+		return ErrCodeNotYetAvailable
+	case ProviderUnavailableError:
+		return ErrCodeProviderUnavailable
+	case InvalidArgumentError:
+		return ErrCodeInvalidArgument
+	default:
+		return ErrCodeInternal
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		checker func(code string, err error)
+	}{
+		{
+			name: "not found error",
+			err:  NewNotFoundError("unsupported provider: [%s]", "dummy"),
+			checker: func(code string, err error) {
+				assert.Equal(t, ErrCodeNotFound, code)
+				assert.EqualError(t, err, "unsupported provider: [dummy]")
+			},
+		},
+		{
+			name: "not yet available error carries a retry hint",
+			err:  NewNotYetAvailableError("vms not yet cached"),
+			checker: func(code string, err error) {
+				assert.Equal(t, ErrCodeNotYetAvailable, code)
+				assert.Equal(t, defaultRetryAfter, err.(NotYetAvailableError).RetryAfter)
+			},
+		},
+		{
+			name: "provider unavailable error keeps the original message",
+			err:  NewProviderUnavailableError("dummy", errors.New(GetRegionsError)),
+			checker: func(code string, err error) {
+				assert.Equal(t, ErrCodeProviderUnavailable, code)
+				assert.EqualError(t, err, GetRegionsError)
+			},
+		},
+		{
+			name: "invalid argument error",
+			err:  NewInvalidArgumentError("unsupported attribute: %s", "disk"),
+			checker: func(code string, err error) {
+				assert.Equal(t, ErrCodeInvalidArgument, code)
+			},
+		},
+		{
+			name: "untyped errors are internal",
+			err:  errors.New("boom"),
+			checker: func(code string, err error) {
+				assert.Equal(t, ErrCodeInternal, code)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.checker(ErrorCode(test.err), test.err)
+		})
+	}
+}
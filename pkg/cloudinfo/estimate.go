@@ -0,0 +1,365 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// HoursPerMonth is the number of hours the monthly prices are spread over, the average month of the providers
+	HoursPerMonth = 730
+
+	// the categories of the cost line items
+	lineItemCompute      = "compute"
+	lineItemStorage      = "storage"
+	lineItemNetwork      = "network"
+	lineItemControlPlane = "control-plane"
+)
+
+// ClusterDescription describes the resources of a cluster its cost is estimated for
+type ClusterDescription struct {
+	// NodePools are the node pools of the cluster
+	NodePools []NodePoolDescription `json:"nodePools"`
+	// Disks are the block storage volumes of the cluster
+	Disks []DiskDescription `json:"disks"`
+	// LoadBalancers are the load balancers of the cluster
+	LoadBalancers []LoadBalancerDescription `json:"loadBalancers"`
+	// InterZoneGbPerMonth is the monthly volume in GB transferred between the zones of the region
+	InterZoneGbPerMonth float64 `json:"interZoneGbPerMonth"`
+	// EgressGbPerMonth is the monthly volume in GB transferred to the internet
+	EgressGbPerMonth float64 `json:"egressGbPerMonth"`
+	// ControlPlane signals whether the managed control plane fee of the service is charged for the cluster
+	ControlPlane bool `json:"controlPlane"`
+	// FreeTier signals that the cluster is among the free clusters of a free-tier control plane
+	FreeTier bool `json:"freeTier"`
+}
+
+// NodePoolDescription describes a node pool of a cluster
+type NodePoolDescription struct {
+	Name string `json:"name"`
+	// Type is the instance type of the nodes
+	Type string `json:"type"`
+	// Count is the number of the nodes
+	Count int `json:"count"`
+	// SpotRatio is the share of the nodes running on spot instances between 0 and 1, the spot nodes are rounded down
+	SpotRatio float64 `json:"spotRatio"`
+	// Zones are the zones the spot nodes run in, the spot price is the mean of the zones (every zone if empty)
+	Zones []string `json:"zones"`
+}
+
+// DiskDescription describes block storage volumes of a cluster
+type DiskDescription struct {
+	Name string `json:"name"`
+	// Type is the volume type (see StorageInfo)
+	Type string `json:"type"`
+	// SizeGb is the size of a volume in GiB
+	SizeGb float64 `json:"sizeGb"`
+	// Iops is the number of the provisioned IOPS of a volume, only charged if the volume type charges IOPS separately
+	Iops float64 `json:"iops"`
+	// Count is the number of the volumes (1 if 0)
+	Count int `json:"count"`
+}
+
+// LoadBalancerDescription describes load balancers of a cluster
+type LoadBalancerDescription struct {
+	// Type is the load balancer type (see LoadBalancerPrice)
+	Type string `json:"type"`
+	// Count is the number of the load balancers (1 if 0)
+	Count int `json:"count"`
+	// GbPerMonth is the monthly volume in GB processed by a load balancer
+	GbPerMonth float64 `json:"gbPerMonth"`
+}
+
+// ClusterPrices holds the prices the cost of a cluster is estimated with, given in the same currency
+type ClusterPrices struct {
+	Products     []ProductDetails
+	Storage      []StorageInfo
+	Network      NetworkPrices
+	ControlPlane *ControlPlaneFee
+	Currency     string
+}
+
+// CostEstimate is the estimated cost of a cluster
+type CostEstimate struct {
+	// NodePools holds the cost of the node pools
+	NodePools []NodePoolCost `json:"nodePools"`
+	// LineItems breaks down the cost of the cluster
+	LineItems []LineItem `json:"lineItems"`
+	// HourlyCost is the total hourly cost of the cluster
+	HourlyCost float64 `json:"hourlyCost"`
+	// MonthlyCost is the total monthly cost of the cluster
+	MonthlyCost float64 `json:"monthlyCost"`
+	// HourlySpotSavings is the hourly cost saved by the spot nodes compared to on demand nodes
+	HourlySpotSavings float64 `json:"hourlySpotSavings"`
+	// MonthlySpotSavings is the monthly cost saved by the spot nodes compared to on demand nodes
+	MonthlySpotSavings float64 `json:"monthlySpotSavings"`
+	// HoursPerMonth is the number of hours the monthly costs are given for
+	HoursPerMonth float64 `json:"hoursPerMonth"`
+	// Currency is the ISO 4217 code of the currency the costs are given in
+	Currency string `json:"currency"`
+}
+
+// NodePoolCost is the cost of a node pool
+type NodePoolCost struct {
+	Name          string  `json:"name"`
+	Type          string  `json:"type"`
+	OnDemandNodes int     `json:"onDemandNodes"`
+	SpotNodes     int     `json:"spotNodes"`
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	SpotPrice     float64 `json:"spotPrice"`
+	HourlyCost    float64 `json:"hourlyCost"`
+	MonthlyCost   float64 `json:"monthlyCost"`
+	// HourlySpotSavings is the hourly cost saved by the spot nodes of the pool
+	HourlySpotSavings float64 `json:"hourlySpotSavings"`
+}
+
+// LineItem is an item of the cost of a cluster
+type LineItem struct {
+	// Category is the category of the item: compute, storage, network or control-plane
+	Category string `json:"category"`
+	// Description describes the item
+	Description string `json:"description"`
+	// Quantity is the billed quantity of the item in its unit
+	Quantity float64 `json:"quantity"`
+	// Unit is the unit of the quantity (eg.: node, GiB, GB)
+	Unit        string  `json:"unit"`
+	HourlyCost  float64 `json:"hourlyCost"`
+	MonthlyCost float64 `json:"monthlyCost"`
+}
+
+// newHourlyItem creates a line item from its hourly cost
+func newHourlyItem(category, unit string, quantity, hourlyCost float64, format string, args ...interface{}) LineItem {
+	return LineItem{Category: category, Description: fmt.Sprintf(format, args...), Quantity: quantity, Unit: unit,
+		HourlyCost: hourlyCost, MonthlyCost: hourlyCost * HoursPerMonth}
+}
+
+// newMonthlyItem creates a line item from its monthly cost
+func newMonthlyItem(category, unit string, quantity, monthlyCost float64, format string, args ...interface{}) LineItem {
+	return LineItem{Category: category, Description: fmt.Sprintf(format, args...), Quantity: quantity, Unit: unit,
+		HourlyCost: monthlyCost / HoursPerMonth, MonthlyCost: monthlyCost}
+}
+
+// EstimateCost estimates the hourly and the monthly cost of a cluster broken down per node pool and per line item
+func EstimateCost(cluster ClusterDescription, prices ClusterPrices) (CostEstimate, error) {
+	estimate := CostEstimate{NodePools: make([]NodePoolCost, 0), LineItems: make([]LineItem, 0),
+		HoursPerMonth: HoursPerMonth, Currency: prices.Currency}
+
+	for _, pool := range cluster.NodePools {
+		poolCost, items, err := estimateNodePool(pool, prices.Products)
+		if err != nil {
+			return CostEstimate{}, err
+		}
+		estimate.NodePools = append(estimate.NodePools, poolCost)
+		estimate.LineItems = append(estimate.LineItems, items...)
+		estimate.HourlySpotSavings += poolCost.HourlySpotSavings
+	}
+	for _, disk := range cluster.Disks {
+		items, err := estimateDisk(disk, prices.Storage)
+		if err != nil {
+			return CostEstimate{}, err
+		}
+		estimate.LineItems = append(estimate.LineItems, items...)
+	}
+	items, err := estimateNetwork(cluster, prices.Network)
+	if err != nil {
+		return CostEstimate{}, err
+	}
+	estimate.LineItems = append(estimate.LineItems, items...)
+	if cluster.ControlPlane {
+		item, err := estimateControlPlane(cluster.FreeTier, prices.ControlPlane)
+		if err != nil {
+			return CostEstimate{}, err
+		}
+		estimate.LineItems = append(estimate.LineItems, item)
+	}
+
+	for _, item := range estimate.LineItems {
+		estimate.HourlyCost += item.HourlyCost
+	}
+	estimate.MonthlyCost = estimate.HourlyCost * HoursPerMonth
+	estimate.MonthlySpotSavings = estimate.HourlySpotSavings * HoursPerMonth
+	return estimate, nil
+}
+
+// estimateNodePool returns the cost of the node pool with its on demand and spot line items
+func estimateNodePool(pool NodePoolDescription, products []ProductDetails) (NodePoolCost, []LineItem, error) {
+	if pool.Count <= 0 {
+		return NodePoolCost{}, nil, NewInvalidArgumentError("the node count of the node pool [%s] should be positive: [%d]", pool.Name, pool.Count)
+	}
+	if pool.SpotRatio < 0 || pool.SpotRatio > 1 {
+		return NodePoolCost{}, nil, NewInvalidArgumentError("the spot ratio of the node pool [%s] should be between 0 and 1: [%v]", pool.Name, pool.SpotRatio)
+	}
+	var product *ProductDetails
+	for i := range products {
+		if products[i].Type == pool.Type {
+			product = &products[i]
+			break
+		}
+	}
+	if product == nil {
+		return NodePoolCost{}, nil, NewInvalidArgumentError("unknown instance type of the node pool [%s]: [%s]", pool.Name, pool.Type)
+	}
+
+	spotNodes := int(math.Floor(float64(pool.Count) * pool.SpotRatio))
+	cost := NodePoolCost{
+		Name:          pool.Name,
+		Type:          pool.Type,
+		OnDemandNodes: pool.Count - spotNodes,
+		SpotNodes:     spotNodes,
+		OnDemandPrice: product.OnDemandPrice,
+	}
+	var items []LineItem
+	if cost.OnDemandNodes > 0 {
+		items = append(items, newHourlyItem(lineItemCompute, "node", float64(cost.OnDemandNodes),
+			product.OnDemandPrice*float64(cost.OnDemandNodes), "node pool %s: on demand %s nodes", pool.Name, pool.Type))
+	}
+	if spotNodes > 0 {
+		spotPrices := make(SpotPriceInfo)
+		for _, zp := range product.SpotInfo {
+			spotPrices[zp.Zone] = zp.Price
+		}
+		aggregate, err := AggregateSpotPrices(spotPrices, pool.Zones, SpotStrategyMean, 0)
+		if err != nil {
+			return NodePoolCost{}, nil, err
+		}
+		if aggregate.Price <= 0 {
+			return NodePoolCost{}, nil, NewInvalidArgumentError("no spot price of the node pool [%s] in the zones: [%s]",
+				pool.Name, strings.Join(pool.Zones, ","))
+		}
+		cost.SpotPrice = aggregate.Price
+		cost.HourlySpotSavings = (product.OnDemandPrice - aggregate.Price) * float64(spotNodes)
+		items = append(items, newHourlyItem(lineItemCompute, "node", float64(spotNodes),
+			aggregate.Price*float64(spotNodes), "node pool %s: spot %s nodes", pool.Name, pool.Type))
+	}
+	for _, item := range items {
+		cost.HourlyCost += item.HourlyCost
+	}
+	cost.MonthlyCost = cost.HourlyCost * HoursPerMonth
+	return cost, items, nil
+}
+
+// estimateDisk returns the capacity and the IOPS line items of the volumes
+// Volume types billed per size tier are charged for the smallest tier the volumes fit in
+func estimateDisk(disk DiskDescription, storage []StorageInfo) ([]LineItem, error) {
+	if disk.SizeGb <= 0 || disk.Iops < 0 || disk.Count < 0 {
+		return nil, NewInvalidArgumentError("the size of the disk [%s] should be positive, the IOPS and the count should not be negative", disk.Name)
+	}
+	count := disk.Count
+	if count == 0 {
+		count = 1
+	}
+
+	var candidates []StorageInfo
+	for _, s := range storage {
+		if s.Type == disk.Type && (s.MaxSize == 0 || disk.SizeGb <= s.MaxSize) && disk.SizeGb >= s.MinSize {
+			candidates = append(candidates, s)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, NewInvalidArgumentError("unknown volume type or size of the disk [%s]: [%s] %v GiB", disk.Name, disk.Type, disk.SizeGb)
+	}
+	// the smallest tier fitting the volumes
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].MaxSize < candidates[j].MaxSize
+	})
+	volume := candidates[0]
+	size := disk.SizeGb
+	if volume.Tier != "" {
+		size = volume.MaxSize
+	}
+
+	items := []LineItem{
+		newMonthlyItem(lineItemStorage, "GiB", size*float64(count), volume.PricePerGbMonth*size*float64(count),
+			"disk %s: %d %s volumes", disk.Name, count, strings.TrimSpace(volume.Type+" "+volume.Tier)),
+	}
+	if volume.PricePerIopsMonth > 0 && disk.Iops > 0 {
+		items = append(items, newMonthlyItem(lineItemStorage, "IOPS", disk.Iops*float64(count),
+			volume.PricePerIopsMonth*disk.Iops*float64(count), "disk %s: provisioned IOPS", disk.Name))
+	}
+	return items, nil
+}
+
+// estimateNetwork returns the load balancer and the data transfer line items of the cluster
+func estimateNetwork(cluster ClusterDescription, network NetworkPrices) ([]LineItem, error) {
+	var items []LineItem
+	for _, lb := range cluster.LoadBalancers {
+		count := lb.Count
+		if count == 0 {
+			count = 1
+		}
+		if count < 0 || lb.GbPerMonth < 0 {
+			return nil, NewInvalidArgumentError("the count and the processed volume of the load balancer [%s] should not be negative", lb.Type)
+		}
+		var price *LoadBalancerPrice
+		for i := range network.LoadBalancers {
+			if network.LoadBalancers[i].Type == lb.Type {
+				price = &network.LoadBalancers[i]
+				break
+			}
+		}
+		if price == nil {
+			return nil, NewInvalidArgumentError("unknown load balancer type: [%s]", lb.Type)
+		}
+		items = append(items, newHourlyItem(lineItemNetwork, "load balancer", float64(count),
+			price.PricePerHour*float64(count), "%s load balancers", lb.Type))
+		if price.PricePerGb > 0 && lb.GbPerMonth > 0 {
+			items = append(items, newMonthlyItem(lineItemNetwork, "GB", lb.GbPerMonth*float64(count),
+				price.PricePerGb*lb.GbPerMonth*float64(count), "%s load balancers: processed data", lb.Type))
+		}
+	}
+	if cluster.InterZoneGbPerMonth < 0 || cluster.EgressGbPerMonth < 0 {
+		return nil, NewInvalidArgumentError("the data transfer volumes should not be negative")
+	}
+	if cluster.InterZoneGbPerMonth > 0 {
+		items = append(items, newMonthlyItem(lineItemNetwork, "GB", cluster.InterZoneGbPerMonth,
+			network.InterZonePerGb*cluster.InterZoneGbPerMonth, "data transfer between zones"))
+	}
+	if cluster.EgressGbPerMonth > 0 {
+		items = append(items, newMonthlyItem(lineItemNetwork, "GB", cluster.EgressGbPerMonth,
+			egressCost(network.InternetEgress, cluster.EgressGbPerMonth), "data transfer to the internet"))
+	}
+	return items, nil
+}
+
+// egressCost returns the cost of the monthly volume transferred to the internet, charged by the tiers of the volume
+func egressCost(tiers []EgressTier, gb float64) float64 {
+	var cost float64
+	for _, tier := range tiers {
+		end := gb
+		if tier.EndGb > 0 && tier.EndGb < end {
+			end = tier.EndGb
+		}
+		if end > tier.StartGb {
+			cost += (end - tier.StartGb) * tier.PricePerGb
+		}
+	}
+	return cost
+}
+
+// estimateControlPlane returns the control plane line item of the cluster
+func estimateControlPlane(freeTier bool, fee *ControlPlaneFee) (LineItem, error) {
+	if fee == nil {
+		return LineItem{}, NewInvalidArgumentError("the service has no managed control plane")
+	}
+	price := fee.PricePerHour
+	if fee.Model == ControlPlaneFree || (fee.Model == ControlPlaneFreeTier && freeTier) {
+		price = 0
+	}
+	return newHourlyItem(lineItemControlPlane, "cluster", 1, price, "%s control plane", fee.Model), nil
+}
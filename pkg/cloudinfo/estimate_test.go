@@ -0,0 +1,153 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCost(t *testing.T) {
+	prices := ClusterPrices{
+		Products: []ProductDetails{
+			{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16, OnDemandPrice: 0.2},
+				SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.06}, {Zone: "eu-west-1b", Price: 0.08}}},
+		},
+		Storage: []StorageInfo{
+			{Type: "gp2", Category: "ssd", PricePerGbMonth: 0.11, MinSize: 1, MaxSize: 16384},
+			{Type: "io1", Category: "ssd", PricePerGbMonth: 0.138, PricePerIopsMonth: 0.072, MinSize: 4, MaxSize: 16384},
+			{Type: "Premium_LRS", Tier: "P10", PricePerGbMonth: 0.15, MaxSize: 128},
+			{Type: "Premium_LRS", Tier: "P6", PricePerGbMonth: 0.17, MaxSize: 64},
+		},
+		Network: NetworkPrices{
+			LoadBalancers:  []LoadBalancerPrice{{Type: "classic", PricePerHour: 0.028, PricePerGb: 0.008}},
+			InterZonePerGb: 0.01,
+			InternetEgress: []EgressTier{{StartGb: 0, EndGb: 1, PricePerGb: 0}, {StartGb: 1, EndGb: 10240, PricePerGb: 0.09}, {StartGb: 10240, PricePerGb: 0.085}},
+		},
+		ControlPlane: &ControlPlaneFee{Model: ControlPlaneHourly, PricePerHour: 0.2},
+		Currency:     CurrencyUSD,
+	}
+
+	tests := []struct {
+		name    string
+		cluster ClusterDescription
+		prices  ClusterPrices
+		check   func(estimate CostEstimate, err error)
+	}{
+		{
+			name: "node pools with spot savings",
+			cluster: ClusterDescription{
+				NodePools: []NodePoolDescription{{Name: "pool1", Type: "m5.xlarge", Count: 5, SpotRatio: 0.5}},
+			},
+			check: func(estimate CostEstimate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, estimate.LineItems, 2)
+				pool := estimate.NodePools[0]
+				assert.Equal(t, 3, pool.OnDemandNodes)
+				assert.Equal(t, 2, pool.SpotNodes, "the spot nodes are rounded down")
+				assert.InDelta(t, 0.07, pool.SpotPrice, 1e-9)
+				assert.InDelta(t, 0.74, pool.HourlyCost, 1e-9)
+				assert.InDelta(t, 0.26, pool.HourlySpotSavings, 1e-9)
+				assert.InDelta(t, 0.74, estimate.HourlyCost, 1e-9)
+				assert.InDelta(t, 0.74*HoursPerMonth, estimate.MonthlyCost, 1e-9)
+				assert.InDelta(t, 0.26*HoursPerMonth, estimate.MonthlySpotSavings, 1e-9)
+				assert.Equal(t, CurrencyUSD, estimate.Currency)
+			},
+		},
+		{
+			name: "disks, network and control plane",
+			cluster: ClusterDescription{
+				Disks: []DiskDescription{
+					{Name: "data", Type: "io1", SizeGb: 100, Iops: 1000, Count: 2},
+					{Name: "premium", Type: "Premium_LRS", SizeGb: 100},
+				},
+				LoadBalancers:       []LoadBalancerDescription{{Type: "classic", GbPerMonth: 100}},
+				InterZoneGbPerMonth: 500,
+				EgressGbPerMonth:    101,
+				ControlPlane:        true,
+			},
+			check: func(estimate CostEstimate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				monthly := map[string]float64{}
+				for _, item := range estimate.LineItems {
+					monthly[item.Description] = item.MonthlyCost
+				}
+				assert.InDelta(t, 27.6, monthly["disk data: 2 io1 volumes"], 1e-9)
+				assert.InDelta(t, 144, monthly["disk data: provisioned IOPS"], 1e-9)
+				assert.InDelta(t, 19.2, monthly["disk premium: 1 Premium_LRS P10 volumes"], 1e-9, "charged for the smallest tier the disk fits in")
+				assert.InDelta(t, 0.028*HoursPerMonth, monthly["classic load balancers"], 1e-9)
+				assert.InDelta(t, 0.8, monthly["classic load balancers: processed data"], 1e-9)
+				assert.InDelta(t, 5, monthly["data transfer between zones"], 1e-9)
+				assert.InDelta(t, 9, monthly["data transfer to the internet"], 1e-9, "the first GB is free")
+				assert.InDelta(t, 0.2*HoursPerMonth, monthly["hourly control plane"], 1e-9)
+			},
+		},
+		{
+			name:    "free tier control plane",
+			cluster: ClusterDescription{ControlPlane: true, FreeTier: true},
+			prices:  ClusterPrices{ControlPlane: &ControlPlaneFee{Model: ControlPlaneFreeTier, PricePerHour: 0.1, FreeClusters: 1}},
+			check: func(estimate CostEstimate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, float64(0), estimate.HourlyCost)
+			},
+		},
+		{
+			name:    "unknown instance type",
+			cluster: ClusterDescription{NodePools: []NodePoolDescription{{Name: "pool1", Type: "m6.xlarge", Count: 1}}},
+			check: func(estimate CostEstimate, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:    "no spot price in the zones",
+			cluster: ClusterDescription{NodePools: []NodePoolDescription{{Name: "pool1", Type: "m5.xlarge", Count: 2, SpotRatio: 1, Zones: []string{"eu-west-1c"}}}},
+			check: func(estimate CostEstimate, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:    "disk above the maximum size",
+			cluster: ClusterDescription{Disks: []DiskDescription{{Name: "big", Type: "Premium_LRS", SizeGb: 256}}},
+			check: func(estimate CostEstimate, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := prices
+			if test.prices.ControlPlane != nil {
+				p = test.prices
+			}
+			test.check(EstimateCost(test.cluster, p))
+		})
+	}
+}
+
+func TestConvertControlPlaneCurrency(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	fee := &ControlPlaneFee{Model: ControlPlaneHourly, PricePerHour: 0.2, Currency: CurrencyUSD}
+
+	converted, err := ConvertControlPlaneCurrency(context.Background(), fee, "EUR", rates)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, &ControlPlaneFee{Model: ControlPlaneHourly, PricePerHour: 0.1, Currency: "EUR"}, converted)
+	assert.Equal(t, 0.2, fee.PricePerHour, "the source fee should be left untouched")
+
+	converted, err = ConvertControlPlaneCurrency(context.Background(), nil, "EUR", rates)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Nil(t, converted, "the fee should be nil")
+}
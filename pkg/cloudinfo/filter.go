@@ -0,0 +1,228 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"strconv"
+)
+
+// ResourceFilter holds the minimum and maximum resources of the selected products as Kubernetes style quantities
+// Empty values leave the resource unbounded
+type ResourceFilter struct {
+	MinCpu string
+	MaxCpu string
+	MinMem string
+	MaxMem string
+	MinGpu string
+	MaxGpu string
+}
+
+// resourceRange is a parsed range of a resource, bounds that are not set are not checked
+type resourceRange struct {
+	min    float64
+	max    float64
+	hasMin bool
+	hasMax bool
+	value  func(ProductDetails) float64
+}
+
+// contains checks whether the resource of the product is within the range
+func (rr resourceRange) contains(d ProductDetails) bool {
+	v := rr.value(d)
+	return (!rr.hasMin || v >= rr.min) && (!rr.hasMax || v <= rr.max)
+}
+
+// newResourceRange parses the bounds of a resource, a zero maximum selects the products without the resource
+func newResourceRange(attribute, min, max string, value func(ProductDetails) float64) (resourceRange, error) {
+	rr := resourceRange{value: value, hasMin: min != "", hasMax: max != ""}
+	var err error
+	if rr.hasMin {
+		if rr.min, err = ParseResource(attribute, min); err != nil || rr.min < 0 {
+			return rr, NewInvalidArgumentError("invalid minimum %s: [%s]", attribute, min)
+		}
+	}
+	if rr.hasMax {
+		if rr.max, err = ParseResource(attribute, max); err != nil || rr.max < 0 {
+			return rr, NewInvalidArgumentError("invalid maximum %s: [%s]", attribute, max)
+		}
+	}
+	if rr.hasMin && rr.hasMax && rr.min > rr.max {
+		return rr, NewInvalidArgumentError("the minimum %s is above the maximum: [%s] > [%s]", attribute, min, max)
+	}
+	return rr, nil
+}
+
+// SelectResources returns the product details with cpus, memory and gpus within the bounds of the filter
+// Quantities without a suffix are given in the unit of the resource (see ParseResource)
+func SelectResources(details []ProductDetails, filter ResourceFilter) ([]ProductDetails, error) {
+	if filter == (ResourceFilter{}) {
+		return details, nil
+	}
+	cpu, err := newResourceRange(Cpu, filter.MinCpu, filter.MaxCpu, func(d ProductDetails) float64 { return d.Cpus })
+	if err != nil {
+		return nil, err
+	}
+	mem, err := newResourceRange(Memory, filter.MinMem, filter.MaxMem, func(d ProductDetails) float64 { return d.Mem })
+	if err != nil {
+		return nil, err
+	}
+	gpu, err := newResourceRange("gpu", filter.MinGpu, filter.MaxGpu, func(d ProductDetails) float64 { return d.Gpus })
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if cpu.contains(d) && mem.contains(d) && gpu.contains(d) {
+			selected = append(selected, d)
+		}
+	}
+	return selected, nil
+}
+
+// SelectNetworkCategory returns the product details in the given network performance category
+// An empty value selects every product
+func SelectNetworkCategory(details []ProductDetails, category string) ([]ProductDetails, error) {
+	if category == "" {
+		return details, nil
+	}
+	if !Contains([]string{NTW_LOW, NTW_MEDIUM, NTW_HIGH, NTW_EXTRA}, category) {
+		return nil, NewInvalidArgumentError("unsupported network category: [%s]", category)
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if d.NtwPerfCat == category {
+			selected = append(selected, d)
+		}
+	}
+	return selected, nil
+}
+
+// SelectCurrentGen returns the current or the previous generation product details depending on the given value
+// An empty value selects every product
+func SelectCurrentGen(details []ProductDetails, currentGen string) ([]ProductDetails, error) {
+	if currentGen == "" {
+		return details, nil
+	}
+	cg, err := strconv.ParseBool(currentGen)
+	if err != nil {
+		return nil, NewInvalidArgumentError("invalid currentGen value: [%s]", currentGen)
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if d.CurrentGen == cg {
+			selected = append(selected, d)
+		}
+	}
+	return selected, nil
+}
+
+// SelectZone returns the product details available in the given zone with their spot prices and statistics narrowed
+// to the zone. The zone availability is told by the zones of the products (see offeredIn) and by the spot prices:
+// products with spot prices in other zones only are left out, products without any spot price are kept as their
+// provider doesn't publish prices per zone
+// An empty value selects every product
+func SelectZone(details []ProductDetails, zone string) []ProductDetails {
+	if zone == "" {
+		return details
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if !offeredIn(d, []string{zone}) {
+			continue
+		}
+		if len(d.SpotInfo) == 0 {
+			selected = append(selected, d)
+			continue
+		}
+		var spotInfo []ZonePrice
+		for _, zp := range d.SpotInfo {
+			if zp.Zone == zone {
+				spotInfo = append(spotInfo, zp)
+			}
+		}
+		if len(spotInfo) == 0 {
+			continue
+		}
+		d.SpotInfo = spotInfo
+		if stats, ok := d.SpotStats[zone]; ok {
+			d.SpotStats = map[string]SpotStats{zone: stats}
+		} else {
+			d.SpotStats = nil
+		}
+		selected = append(selected, d)
+	}
+	return selected
+}
+
+// offeredIn checks whether the product is offered in any of the given zones
+// Products without zones are offered in every zone, their providers don't list the zones of the instance types
+func offeredIn(d ProductDetails, zones []string) bool {
+	if len(d.Zones) == 0 || len(zones) == 0 {
+		return true
+	}
+	for _, zone := range zones {
+		if Contains(d.Zones, zone) {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectMaxPrice returns the product details with an on demand price and a spot price (in any of their zones) not above
+// the given ceilings; products without spot price are left out when the spot price is bounded
+// Empty values leave the prices unbounded
+func SelectMaxPrice(details []ProductDetails, maxPrice, maxSpotPrice string) ([]ProductDetails, error) {
+	if maxPrice == "" && maxSpotPrice == "" {
+		return details, nil
+	}
+	var max, maxSpot float64
+	var err error
+	if maxPrice != "" {
+		if max, err = strconv.ParseFloat(maxPrice, 64); err != nil || max <= 0 {
+			return nil, NewInvalidArgumentError("invalid maximum price: [%s]", maxPrice)
+		}
+	}
+	if maxSpotPrice != "" {
+		if maxSpot, err = strconv.ParseFloat(maxSpotPrice, 64); err != nil || maxSpot <= 0 {
+			return nil, NewInvalidArgumentError("invalid maximum spot price: [%s]", maxSpotPrice)
+		}
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if max > 0 && d.OnDemandPrice > max {
+			continue
+		}
+		if maxSpot > 0 && !hasSpotPriceBelow(d.SpotInfo, maxSpot) {
+			continue
+		}
+		selected = append(selected, d)
+	}
+	return selected, nil
+}
+
+// hasSpotPriceBelow checks whether any of the zones has a spot price not above the given price
+func hasSpotPriceBelow(spotInfo []ZonePrice, price float64) bool {
+	for _, zp := range spotInfo {
+		if zp.Price > 0 && zp.Price <= price {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,223 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectResources(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "t3.micro", Cpus: 2, Mem: 1}},
+		{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16}},
+		{VmInfo: VmInfo{Type: "p3.2xlarge", Cpus: 8, Mem: 61, Gpus: 1}},
+	}
+
+	tests := []struct {
+		name   string
+		filter ResourceFilter
+		check  func(selected []ProductDetails, err error)
+	}{
+		{
+			name:   "every product is returned without filter",
+			filter: ResourceFilter{},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:   "cpu and memory ranges",
+			filter: ResourceFilter{MinCpu: "2500m", MaxMem: "16Gi"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[1:2], selected)
+			},
+		},
+		{
+			name:   "gpu range",
+			filter: ResourceFilter{MinGpu: "1"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[2:], selected)
+			},
+		},
+		{
+			name:   "zero maximum gpu",
+			filter: ResourceFilter{MaxGpu: "0"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[:2], selected)
+			},
+		},
+		{
+			name:   "zero minimum and maximum gpu",
+			filter: ResourceFilter{MinGpu: "0", MaxGpu: "0", MinCpu: "4"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[1:2], selected)
+			},
+		},
+		{
+			name:   "negative maximum",
+			filter: ResourceFilter{MaxGpu: "-1"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:   "invalid quantity",
+			filter: ResourceFilter{MinMem: "lots"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:   "minimum above the maximum",
+			filter: ResourceFilter{MinCpu: "8", MaxCpu: "4"},
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectResources(details, test.filter))
+		})
+	}
+}
+
+func TestSelectNetworkCategory(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "t3.micro", NtwPerfCat: NTW_LOW}},
+		{VmInfo: VmInfo{Type: "c5n.18xlarge", NtwPerfCat: NTW_EXTRA}},
+	}
+
+	selected, err := SelectNetworkCategory(details, NTW_EXTRA)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, details[1:], selected)
+
+	_, err = SelectNetworkCategory(details, "fast")
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
+
+func TestSelectCurrentGen(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "m5.large", CurrentGen: true}},
+		{VmInfo: VmInfo{Type: "m1.large"}},
+	}
+
+	selected, err := SelectCurrentGen(details, "false")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, details[1:], selected)
+
+	_, err = SelectCurrentGen(details, "latest")
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
+
+func TestSelectZone(t *testing.T) {
+	details := []ProductDetails{
+		{
+			VmInfo:   VmInfo{Type: "m5.large", SpotStats: map[string]SpotStats{"eu-west-1a": {Min: 0.03}, "eu-west-1b": {Min: 0.04}}},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.035}, {Zone: "eu-west-1b", Price: 0.045}},
+		},
+		{
+			VmInfo:   VmInfo{Type: "x1.32xlarge"},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1b", Price: 4.1}},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-1"},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-2", Zones: []string{"eu-west-1a", "eu-west-1c"}},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-4", Zones: []string{"eu-west-1c"}},
+		},
+	}
+
+	selected := SelectZone(details, "eu-west-1a")
+
+	assert.Equal(t, []ProductDetails{
+		{
+			VmInfo:   VmInfo{Type: "m5.large", SpotStats: map[string]SpotStats{"eu-west-1a": {Min: 0.03}}},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.035}},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-1"},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-2", Zones: []string{"eu-west-1a", "eu-west-1c"}},
+		},
+	}, selected)
+	assert.Len(t, details[0].SpotInfo, 2, "the original details should be left intact")
+}
+
+func TestSelectMaxPrice(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "m5.large", OnDemandPrice: 0.096}, SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.035}}},
+		{VmInfo: VmInfo{Type: "m5.xlarge", OnDemandPrice: 0.192}, SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.07}}},
+		{VmInfo: VmInfo{Type: "n1-standard-1", OnDemandPrice: 0.0475}},
+	}
+
+	tests := []struct {
+		name         string
+		maxPrice     string
+		maxSpotPrice string
+		check        func(selected []ProductDetails, err error)
+	}{
+		{
+			name: "every product is returned without ceilings",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:     "on demand price ceiling",
+			maxPrice: "0.1",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []ProductDetails{details[0], details[2]}, selected)
+			},
+		},
+		{
+			name:         "spot price ceiling leaves out the products without spot price",
+			maxSpotPrice: "0.08",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details[:2], selected)
+			},
+		},
+		{
+			name:     "invalid ceiling",
+			maxPrice: "-1",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectMaxPrice(details, test.maxPrice, test.maxSpotPrice))
+		})
+	}
+}
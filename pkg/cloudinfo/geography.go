@@ -0,0 +1,67 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// GeographyEurope groups the regions in Europe
+	GeographyEurope = "europe"
+	// GeographyNorthAmerica groups the regions in North America
+	GeographyNorthAmerica = "north-america"
+	// GeographySouthAmerica groups the regions in South America
+	GeographySouthAmerica = "south-america"
+	// GeographyAsiaPacific groups the regions in Asia and Oceania
+	GeographyAsiaPacific = "asia-pacific"
+	// GeographyMiddleEast groups the regions in the Middle East
+	GeographyMiddleEast = "middle-east"
+	// GeographyAfrica groups the regions in Africa
+	GeographyAfrica = "africa"
+)
+
+// Geographies returns the supported geographies
+func Geographies() []string {
+	return []string{GeographyEurope, GeographyNorthAmerica, GeographySouthAmerica, GeographyAsiaPacific,
+		GeographyMiddleEast, GeographyAfrica}
+}
+
+// geographyRules map the region ids of the providers to geographies, the first matching rule applies
+// The ids are prefixed by an area code (eu-west-1, europe-west1, ap-tokyo-1, cn-hangzhou) or, for Azure, named after
+// a country or an area (westeurope, uksouth, japaneast, eastus)
+var geographyRules = []struct {
+	pattern   *regexp.Regexp
+	geography string
+}{
+	{regexp.MustCompile(`^(me|uae)|^israel|^qatar`), GeographyMiddleEast},
+	{regexp.MustCompile(`^(af|southafrica)`), GeographyAfrica},
+	{regexp.MustCompile(`^(sa|southamerica|brazil)`), GeographySouthAmerica},
+	{regexp.MustCompile(`^(eu|uk|france|germany|norway|switzerland|sweden|poland|italy)|europe`), GeographyEurope},
+	{regexp.MustCompile(`^(ap|cn|japan|korea|australia|india|singapore)|asia|india`), GeographyAsiaPacific},
+	{regexp.MustCompile(`^(us|ca|canada|northamerica)|us\d*$`), GeographyNorthAmerica},
+}
+
+// Geography returns the geography of a region given by its id, empty if the region is not recognized
+func Geography(regionId string) string {
+	id := strings.ToLower(regionId)
+	for _, rule := range geographyRules {
+		if rule.pattern.MatchString(id) {
+			return rule.geography
+		}
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeography(t *testing.T) {
+	tests := map[string]string{
+		"eu-west-1":               GeographyEurope,
+		"europe-west1":            GeographyEurope,
+		"westeurope":              GeographyEurope,
+		"uksouth":                 GeographyEurope,
+		"us-east-1":               GeographyNorthAmerica,
+		"eastus2":                 GeographyNorthAmerica,
+		"northamerica-northeast1": GeographyNorthAmerica,
+		"canadacentral":           GeographyNorthAmerica,
+		"sa-east-1":               GeographySouthAmerica,
+		"brazilsouth":             GeographySouthAmerica,
+		"ap-tokyo-1":              GeographyAsiaPacific,
+		"cn-hangzhou":             GeographyAsiaPacific,
+		"southeastasia":           GeographyAsiaPacific,
+		"australiaeast":           GeographyAsiaPacific,
+		"me-east-1":               GeographyMiddleEast,
+		"southafricanorth":        GeographyAfrica,
+		"moon-base-1":             "",
+	}
+	for region, geography := range tests {
+		assert.Equal(t, geography, Geography(region), region)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"google.golang.org/api/compute/v1"
+)
+
+// sharedCoreBaselines holds the sustained vCPU share of the shared core machine types as a percentage of their vCPUs
+var sharedCoreBaselines = map[string]float64{
+	"f1-micro":  20,
+	"g1-small":  50,
+	"e2-micro":  12.5,
+	"e2-small":  25,
+	"e2-medium": 50,
+}
+
+// burstInfo returns the burst details of the shared core machine types, nil for the rest of the machine types
+// The shared core machine types burst opportunistically; they don't earn credits and can't run in unlimited mode
+func burstInfo(mt *compute.MachineType) *cloudinfo.BurstInfo {
+	if !mt.IsSharedCpu {
+		return nil
+	}
+	return &cloudinfo.BurstInfo{BaselineCpu: sharedCoreBaselines[mt.Name]}
+}
@@ -16,8 +16,10 @@ package google
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"regexp"
@@ -26,11 +28,13 @@ import (
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 
 	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	billing "google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
-	"google.golang.org/api/googleapi/transport"
+	apitransport "google.golang.org/api/googleapi/transport"
 )
 
 // SpotPriceGauge collects metrics for the prometheus
@@ -63,6 +67,12 @@ var regionNames = map[string]string{
 	"us-west2":                "US West (Los Angeles)",
 }
 
+// commitmentTerms maps the usage types of the committed use discount skus to commitment terms
+var commitmentTerms = map[string]string{
+	"Commit1Yr": cloudinfo.Term1Year,
+	"Commit3Yr": cloudinfo.Term3Year,
+}
+
 // GceInfoer encapsulates the data and operations needed to access external resources
 type GceInfoer struct {
 	cbSvc              *billing.APIService
@@ -71,22 +81,41 @@ type GceInfoer struct {
 	projectId          string
 	cpuRegex           *regexp.Regexp
 	resourceGroupRegex *regexp.Regexp
+	storagePrices      map[string][]cloudinfo.StorageInfo
+	networkPrices      map[string]cloudinfo.NetworkPrices
 }
 
 // NewGceInfoer creates a new instance of the infoer
-func NewGceInfoer(appCredentials, apiKey string) (*GceInfoer, error) {
+// The Google APIs are accessed through the given transport if it's not nil (see the recorder package)
+func NewGceInfoer(appCredentials, apiKey string, transport http.RoundTripper) (*GceInfoer, error) {
 	if appCredentials == "" {
 		return nil, fmt.Errorf("environment variable GOOGLE_APPLICATION_CREDENTIALS is not set")
 	}
 	os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", appCredentials)
 
-	defaultCredential, err := google.FindDefaultCredentials(context.Background(), compute.ComputeScope, billing.CloudPlatformScope)
-	if err != nil {
-		return nil, err
-	}
-	client, err := google.DefaultClient(context.Background(), compute.ComputeScope, billing.CloudPlatformScope)
-	if err != nil {
-		return nil, err
+	var (
+		client    *http.Client
+		projectId string
+		err       error
+	)
+	if recorder.IsReplaying(transport) {
+		// requests are not authorized as they are served from fixtures, only the project of the credentials is needed
+		if projectId, err = credentialsProject(appCredentials); err != nil {
+			return nil, err
+		}
+		client = &http.Client{Transport: transport}
+	} else {
+		defaultCredential, err := google.FindDefaultCredentials(context.Background(), compute.ComputeScope, billing.CloudPlatformScope)
+		if err != nil {
+			return nil, err
+		}
+		projectId = defaultCredential.ProjectID
+
+		if transport != nil {
+			client = &http.Client{Transport: &oauth2.Transport{Source: defaultCredential.TokenSource, Base: transport}}
+		} else if client, err = google.DefaultClient(context.Background(), compute.ComputeScope, billing.CloudPlatformScope); err != nil {
+			return nil, err
+		}
 	}
 	computeSvc, err := compute.New(client)
 	if err != nil {
@@ -94,7 +123,7 @@ func NewGceInfoer(appCredentials, apiKey string) (*GceInfoer, error) {
 	}
 
 	billingSvc, err := billing.New(&http.Client{
-		Transport: &transport.APIKey{Key: apiKey},
+		Transport: &apitransport.APIKey{Key: apiKey, Transport: transport},
 	})
 	if err != nil {
 		return nil, err
@@ -111,12 +140,30 @@ func NewGceInfoer(appCredentials, apiKey string) (*GceInfoer, error) {
 		cbSvc:              billingSvc,
 		computeSvc:         computeSvc,
 		containerSvc:       containerSvc,
-		projectId:          defaultCredential.ProjectID,
+		projectId:          projectId,
 		cpuRegex:           cpuReg,
 		resourceGroupRegex: rgReg,
 	}, nil
 }
 
+// credentialsProject reads the project of the credentials file, the credentials themselves are not loaded
+func credentialsProject(appCredentials string) (string, error) {
+	contents, err := ioutil.ReadFile(appCredentials)
+	if err != nil {
+		return "", err
+	}
+	var credentials struct {
+		ProjectId string `json:"project_id"`
+	}
+	if err := json.Unmarshal(contents, &credentials); err != nil {
+		return "", err
+	}
+	if credentials.ProjectId == "" {
+		return "", fmt.Errorf("the project of the credentials is not set: [%s]", appCredentials)
+	}
+	return credentials.ProjectId, nil
+}
+
 // Initialize downloads and parses the SKU list of the Compute Engine service
 func (g *GceInfoer) Initialize(ctx context.Context) (map[string]map[string]cloudinfo.Price, error) {
 	log := logger.Extract(ctx)
@@ -148,6 +195,9 @@ func (g *GceInfoer) Initialize(ctx context.Context) (map[string]map[string]cloud
 	if err != nil {
 		return nil, err
 	}
+	// the persistent disks and the network are priced with the compute engine skus, they are kept until they are renewed
+	g.storagePrices = storagePrices(pricePerRegion)
+	g.networkPrices = networkPrices(pricePerRegion)
 	for r := range regions {
 		zones, err := g.GetZones(ctx, r)
 		if err != nil {
@@ -162,6 +212,8 @@ func (g *GceInfoer) Initialize(ctx context.Context) (map[string]map[string]cloud
 							allPrices[region] = make(map[string]cloudinfo.Price)
 						}
 						prices := allPrices[region][mt.Name]
+						// the skus are listed with their prices in USD by default
+						prices.Currency = cloudinfo.CurrencyUSD
 
 						if mt.Name == "f1-micro" || mt.Name == "g1-small" {
 							prices.OnDemandPrice = price[mt.Name]["OnDemand"]
@@ -179,6 +231,10 @@ func (g *GceInfoer) Initialize(ctx context.Context) (map[string]map[string]cloud
 							}
 						}
 						prices.SpotPrice = spotPrice
+						if mt.Name != "f1-micro" && mt.Name != "g1-small" {
+							// shared core machine types are not eligible for committed use discounts
+							prices.Commitments = commitmentPrices(price, mt)
+						}
 
 						allPrices[region][mt.Name] = prices
 					}
@@ -216,6 +272,67 @@ func (g *GceInfoer) getPrice(parent string) (map[string]map[string]map[string]fl
 					}
 				}
 			}
+			if _, ok := commitmentTerms[sku.Category.UsageType]; ok {
+				// the committed use discounts are given for the vCPUs and memory of the predefined machine types
+				var device string
+				switch {
+				case strings.HasPrefix(sku.Description, "Commitment v1: Cpu in"):
+					device = cloudinfo.Cpu
+				case strings.HasPrefix(sku.Description, "Commitment v1: Ram in"):
+					device = cloudinfo.Memory
+				default:
+					continue
+				}
+				priceInUsd, err := g.priceInUsd(sku.PricingInfo)
+				if err != nil {
+					return err
+				}
+				for _, region := range sku.ServiceRegions {
+					if price[region] == nil {
+						price[region] = make(map[string]map[string]float64)
+					}
+					price[region][device] = g.priceFromSku(price, region, device, sku.Category.UsageType, priceInUsd)
+				}
+			}
+			if dt, ok := diskTypes[sku.Category.ResourceGroup]; ok && sku.Category.UsageType == "OnDemand" &&
+				strings.Contains(sku.Description, "PD Capacity") && !strings.HasPrefix(sku.Description, "Regional") {
+				// zonal persistent disk capacity, priced per GiB-month
+				priceInUsd, err := g.priceInUsd(sku.PricingInfo)
+				if err != nil {
+					return err
+				}
+				for _, region := range sku.ServiceRegions {
+					if price[region] == nil {
+						price[region] = make(map[string]map[string]float64)
+					}
+					price[region][dt.name] = g.priceFromSku(price, region, dt.name, sku.Category.UsageType, priceInUsd)
+				}
+			}
+			if device, ok := networkDevice(sku); ok {
+				if device == internetEgress {
+					tiers, err := egressTierPrices(sku.PricingInfo)
+					if err != nil {
+						return err
+					}
+					for _, region := range sku.ServiceRegions {
+						if price[region] == nil {
+							price[region] = make(map[string]map[string]float64)
+						}
+						price[region][device] = tiers
+					}
+					continue
+				}
+				priceInUsd, err := g.priceInUsd(sku.PricingInfo)
+				if err != nil {
+					return err
+				}
+				for _, region := range sku.ServiceRegions {
+					if price[region] == nil {
+						price[region] = make(map[string]map[string]float64)
+					}
+					price[region][device] = g.priceFromSku(price, region, device, sku.Category.UsageType, priceInUsd)
+				}
+			}
 			if sku.Category.ResourceGroup == "N1Standard" {
 				if !strings.Contains(sku.Description, "Upgrade Premium") {
 					priceInUsd, err := g.priceInUsd(sku.PricingInfo)
@@ -267,6 +384,22 @@ func (g *GceInfoer) priceFromSku(price map[string]map[string]map[string]float64,
 	return pr
 }
 
+// commitmentPrices calculates the committed use prices of the machine type from the vCPU and memory prices of the region
+// committed use discounts are billed monthly, there is no upfront payment
+func commitmentPrices(price map[string]map[string]float64, mt *compute.MachineType) []cloudinfo.CommitmentPrice {
+	var commitments []cloudinfo.CommitmentPrice
+	for _, usageType := range []string{"Commit1Yr", "Commit3Yr"} {
+		cpuPrice, cpuOk := price[cloudinfo.Cpu][usageType]
+		memPrice, memOk := price[cloudinfo.Memory][usageType]
+		if !cpuOk || !memOk {
+			continue
+		}
+		hourly := cpuPrice*float64(mt.GuestCpus) + memPrice*float64(mt.MemoryMb)/1024
+		commitments = append(commitments, cloudinfo.NewCommitmentPrice(commitmentTerms[usageType], cloudinfo.PaymentNoUpfront, 0, hourly))
+	}
+	return commitments
+}
+
 // GetAttributeValues gets the AttributeValues for the given attribute name
 // Queries the Google Cloud Compute API's machine type list endpoint
 func (g *GceInfoer) GetAttributeValues(ctx context.Context, service, attribute string) (cloudinfo.AttrValues, error) {
@@ -288,7 +421,7 @@ func (g *GceInfoer) GetAttributeValues(ctx context.Context, service, attribute s
 				case cloudinfo.Memory:
 					valueSet[cloudinfo.AttrValue{
 						Value:    float64(mt.MemoryMb) / 1024,
-						StrValue: fmt.Sprintf("%v", mt.MemoryMb),
+						StrValue: fmt.Sprintf("%v", float64(mt.MemoryMb)/1024),
 					}] = ""
 				}
 			}
@@ -332,19 +465,23 @@ func (g *GceInfoer) GetProducts(ctx context.Context, service, regionId string) (
 					// each vCPU has a 2 Gbps egress cap for peak performance
 					ntwPerf = uint(mt.GuestCpus * 2)
 				}
-				ntwMapper := newGceNetworkMapper()
-				ntwPerfCat, err := ntwMapper.MapNetworkPerf(fmt.Sprint(ntwPerf, " Gbit/s"))
-				if err != nil {
-					log.WithError(err).Debug("could not get network performance category")
-				}
+				// the bandwidth is the egress cap of the machine type
 				vmsMap[mt.Name] = cloudinfo.VmInfo{
-					Type:       mt.Name,
-					Cpus:       float64(mt.GuestCpus),
-					Mem:        float64(mt.MemoryMb) / 1024,
-					NtwPerf:    fmt.Sprintf("%d Gbit/s", ntwPerf),
-					NtwPerfCat: ntwPerfCat,
-					Zones:      zones,
-					Attributes: cloudinfo.Attributes(fmt.Sprint(mt.GuestCpus), fmt.Sprint(float64(mt.MemoryMb)/1024), ntwPerfCat),
+					Type:          mt.Name,
+					Cpus:          float64(mt.GuestCpus),
+					Mem:           float64(mt.MemoryMb) / 1024,
+					NtwPerf:       fmt.Sprintf("%d Gbit/s", ntwPerf),
+					Bandwidth:     float64(ntwPerf),
+					BandwidthUpTo: true,
+					Zones:         zones,
+					Attributes:    cloudinfo.Attributes(fmt.Sprint(mt.GuestCpus), fmt.Sprint(float64(mt.MemoryMb)/1024)),
+					// the architecture, the hypervisor and the disk support of the machine types are not published by
+					// the compute API: every machine type is x86_64 on kvm and can attach SSD persistent disks
+					Arch:           cloudinfo.ArchX86,
+					PremiumStorage: true,
+					MaxNics:        maxNics(mt),
+					Hypervisor:     "kvm",
+					BurstInfo:      burstInfo(mt),
 				}
 			}
 		}
@@ -355,12 +492,28 @@ func (g *GceInfoer) GetProducts(ctx context.Context, service, regionId string) (
 	}
 	var vms []cloudinfo.VmInfo
 	for _, vm := range vmsMap {
+		vm.Classify(taxonomyRules)
 		vms = append(vms, vm)
 	}
 	log.Debugf("found vms: %#v", vms)
 	return vms, nil
 }
 
+// maxNics returns the maximum number of network interfaces of a machine type:
+// 1 for shared core machine types, one per vCPU otherwise, with a minimum of 2 and a maximum of 8
+func maxNics(mt *compute.MachineType) int {
+	switch {
+	case mt.IsSharedCpu:
+		return 1
+	case mt.GuestCpus < 2:
+		return 2
+	case mt.GuestCpus > 8:
+		return 8
+	default:
+		return int(mt.GuestCpus)
+	}
+}
+
 // GetRegions returns a map with available regions transforms the api representation into a "plain" map
 func (g *GceInfoer) GetRegions(ctx context.Context, service string) (map[string]string, error) {
 	log := logger.Extract(ctx)
@@ -423,11 +576,19 @@ func (g *GceInfoer) GetCpuAttrName() string {
 	return cloudinfo.Cpu
 }
 
+// gkeControlPlane is the fee of the gke control plane, the clusters above the free tier are charged per hour
+var gkeControlPlane = cloudinfo.ControlPlaneFee{
+	Model:        cloudinfo.ControlPlaneFreeTier,
+	PricePerHour: 0.1,
+	FreeClusters: 1,
+	Currency:     cloudinfo.CurrencyUSD,
+}
+
 // GetServices returns the available services on the  provider
 func (g *GceInfoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
 	services := []cloudinfo.ServiceDescriber{
 		cloudinfo.NewService("compute"),
-		cloudinfo.NewService("gke")}
+		cloudinfo.NewKubernetesService("gke", gkeControlPlane)}
 	return services, nil
 }
 
@@ -443,7 +604,7 @@ func (g *GceInfoer) GetService(ctx context.Context, service string) (cloudinfo.S
 			return sd, nil
 		}
 	}
-	return nil, fmt.Errorf("the service [%s] is not supported", service)
+	return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
 
 }
 
@@ -0,0 +1,215 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+	billing "google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/compute/v1"
+)
+
+func TestGceInfoer_getPrice(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	billingSvc, err := billing.New(&http.Client{Transport: replayer})
+	if err != nil {
+		t.Fatalf("failed to create billing service; [%s]", err.Error())
+	}
+
+	tests := []struct {
+		name    string
+		service string
+		check   func(price map[string]map[string]map[string]float64, err error)
+	}{
+		{
+			name:    "parse the recorded compute engine skus",
+			service: "services/6F81-5844-456A",
+			check: func(price map[string]map[string]map[string]float64, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.InDelta(t, 0.036489, price["europe-west3"][cloudinfo.Cpu]["OnDemand"], 1e-9)
+				assert.InDelta(t, 0.0077, price["europe-west3"][cloudinfo.Cpu]["Preemptible"], 1e-9)
+				assert.InDelta(t, 0.004892, price["europe-west3"][cloudinfo.Memory]["OnDemand"], 1e-9)
+				assert.InDelta(t, 0.001032, price["europe-west3"][cloudinfo.Memory]["Preemptible"], 1e-9)
+				assert.InDelta(t, 0.022987, price["europe-west3"][cloudinfo.Cpu]["Commit1Yr"], 1e-9)
+				assert.InDelta(t, 0.002201, price["europe-west3"][cloudinfo.Memory]["Commit3Yr"], 1e-9)
+				assert.InDelta(t, 0.048, price["europe-west3"]["pd-standard"]["OnDemand"], 1e-9, "regional disks should be left out")
+				assert.InDelta(t, 0.204, price["europe-west3"]["pd-ssd"]["OnDemand"], 1e-9)
+				assert.InDelta(t, 0.025, price["europe-west3"][lbForwardingRule]["OnDemand"], 1e-9)
+				assert.InDelta(t, 0.01, price["europe-west3"][interZoneEgress]["OnDemand"], 1e-9)
+				assert.Equal(t, 3, len(price["europe-west3"][internetEgress]))
+				assert.InDelta(t, 0.11, price["europe-west3"][internetEgress]["1024"], 1e-9, "the egress to China should be left out")
+			},
+		},
+		{
+			name:    "no skus recorded for the service",
+			service: "services/unknown",
+			check: func(price map[string]map[string]map[string]float64, err error) {
+				assert.Nil(t, price, "the price should be nil")
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gceInfoer := GceInfoer{cbSvc: billingSvc}
+			test.check(gceInfoer.getPrice(test.service))
+		})
+	}
+}
+
+func TestStoragePrices(t *testing.T) {
+	storage := storagePrices(map[string]map[string]map[string]float64{
+		"europe-west3": {
+			cloudinfo.Cpu: {"OnDemand": 0.036489},
+			"pd-standard": {"OnDemand": 0.048},
+			"pd-ssd":      {"OnDemand": 0.204},
+		},
+		"us-east4": {
+			cloudinfo.Cpu: {"OnDemand": 0.037},
+		},
+	})
+
+	if assert.Equal(t, 2, len(storage["europe-west3"])) {
+		assert.Equal(t, cloudinfo.StorageInfo{
+			Type:            "pd-ssd",
+			Category:        cloudinfo.DiskTypeSsd,
+			PricePerGbMonth: 0.204,
+			MinSize:         minDiskSize,
+			MaxSize:         maxDiskSize,
+			Currency:        cloudinfo.CurrencyUSD,
+		}, storage["europe-west3"][0])
+		assert.Equal(t, "pd-standard", storage["europe-west3"][1].Type)
+	}
+	assert.Empty(t, storage["us-east4"], "no disks are priced in the region")
+}
+
+func TestNetworkPrices(t *testing.T) {
+	network := networkPrices(map[string]map[string]map[string]float64{
+		"europe-west3": {
+			cloudinfo.Cpu:    {"OnDemand": 0.036489},
+			lbForwardingRule: {"OnDemand": 0.025},
+			lbDataProcessing: {"OnDemand": 0.008},
+			interZoneEgress:  {"OnDemand": 0.01},
+			internetEgress:   {"0": 0.12, "1024": 0.11, "10240": 0.08},
+		},
+	})
+
+	assert.Equal(t, cloudinfo.NetworkPrices{
+		LoadBalancers: []cloudinfo.LoadBalancerPrice{
+			{Type: "forwarding-rule", PricePerHour: 0.025, PricePerGb: 0.008},
+		},
+		InterZonePerGb: 0.01,
+		InternetEgress: []cloudinfo.EgressTier{
+			{StartGb: 0, EndGb: 1024, PricePerGb: 0.12},
+			{StartGb: 1024, EndGb: 10240, PricePerGb: 0.11},
+			{StartGb: 10240, PricePerGb: 0.08},
+		},
+		Currency: cloudinfo.CurrencyUSD,
+	}, network["europe-west3"])
+}
+
+func TestBurstInfo(t *testing.T) {
+	assert.Equal(t, &cloudinfo.BurstInfo{BaselineCpu: 20}, burstInfo(&compute.MachineType{Name: "f1-micro", IsSharedCpu: true}))
+	assert.Equal(t, &cloudinfo.BurstInfo{BaselineCpu: 50}, burstInfo(&compute.MachineType{Name: "e2-medium", IsSharedCpu: true}))
+	assert.Nil(t, burstInfo(&compute.MachineType{Name: "n1-standard-1"}), "only the shared core machine types are burstable")
+}
+
+func TestCommitmentPrices(t *testing.T) {
+	tests := []struct {
+		name  string
+		price map[string]map[string]float64
+		check func(commitments []cloudinfo.CommitmentPrice)
+	}{
+		{
+			name: "commitment prices are calculated from the vCPU and memory prices",
+			price: map[string]map[string]float64{
+				cloudinfo.Cpu:    {"OnDemand": 0.04, "Commit1Yr": 0.02, "Commit3Yr": 0.01},
+				cloudinfo.Memory: {"OnDemand": 0.005, "Commit1Yr": 0.003, "Commit3Yr": 0.002},
+			},
+			check: func(commitments []cloudinfo.CommitmentPrice) {
+				if assert.Equal(t, 2, len(commitments)) {
+					assert.Equal(t, cloudinfo.Term1Year, commitments[0].Term)
+					assert.Equal(t, cloudinfo.PaymentNoUpfront, commitments[0].PaymentOption)
+					assert.InDelta(t, 0.0625, commitments[0].EffectiveHourly, 1e-9)
+					assert.Equal(t, cloudinfo.Term3Year, commitments[1].Term)
+					assert.InDelta(t, 0.035, commitments[1].EffectiveHourly, 1e-9)
+				}
+			},
+		},
+		{
+			name: "no commitment prices in the region",
+			price: map[string]map[string]float64{
+				cloudinfo.Cpu:    {"OnDemand": 0.04},
+				cloudinfo.Memory: {"OnDemand": 0.005},
+			},
+			check: func(commitments []cloudinfo.CommitmentPrice) {
+				assert.Nil(t, commitments, "the commitments should be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(commitmentPrices(test.price, &compute.MachineType{GuestCpus: 2, MemoryMb: 7680}))
+		})
+	}
+}
+
+func TestNewGceInfoer_Replay(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	// the credentials file only holds the project, credentials are not loaded in replay mode
+	gceInfoer, err := NewGceInfoer("testdata/credentials.json", "", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	assert.Equal(t, "replay-project", gceInfoer.projectId)
+
+	regions, err := gceInfoer.GetRegions(context.Background(), "compute")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, map[string]string{"europe-west3": "EU (Frankfurt)"}, regions)
+
+	zones, err := gceInfoer.GetZones(context.Background(), "europe-west3")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, []string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}, zones)
+
+	_, err = NewGceInfoer("testdata/fixtures/cloudbilling.googleapis.com-skus.json", "", replayer)
+	assert.NotNil(t, err, "credentials without a project should be rejected")
+}
+
+func TestGceInfoer_Conformance(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	gceInfoer, err := NewGceInfoer("testdata/credentials.json", "", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	cloudinfotest.RunConformance(t, gceInfoer, cloudinfotest.Options{
+		Regions: []string{"europe-west3"},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	billing "google.golang.org/api/cloudbilling/v1"
+)
+
+const (
+	// the network skus are kept in the price map of the regions as the prices of these pseudo devices
+	lbForwardingRule = "lb-forwarding-rule"
+	lbDataProcessing = "lb-data-processing"
+	interZoneEgress  = "inter-zone-egress"
+	// the internet egress prices are keyed by the start of their tiers in GiB instead of the usage type
+	internetEgress = "internet-egress"
+)
+
+// GetNetworkPrices returns the load balancing and the network egress prices in the given region
+// The prices are collected with the compute engine skus during initialization
+func (g *GceInfoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	if g.networkPrices == nil {
+		return cloudinfo.NetworkPrices{}, errors.New("network prices are not yet retrieved")
+	}
+	return g.networkPrices[region], nil
+}
+
+// networkDevice returns the pseudo device the network sku is priced as, false if the sku is not a collected network sku
+func networkDevice(sku *billing.Sku) (string, bool) {
+	switch sku.Category.ResourceGroup {
+	case "LoadBalancing":
+		switch {
+		case strings.HasPrefix(sku.Description, "Network Load Balancing: Forwarding Rule Minimum Service Charge"):
+			return lbForwardingRule, true
+		case strings.HasPrefix(sku.Description, "Network Load Balancing: Data Processing Charge"):
+			return lbDataProcessing, true
+		}
+	case "InterzoneEgress":
+		return interZoneEgress, true
+	case "PremiumInternetEgress":
+		// the egress to China and Australia is priced higher than to the rest of the world
+		if !strings.Contains(sku.Description, "to China") && !strings.Contains(sku.Description, "to Australia") {
+			return internetEgress, true
+		}
+	}
+	return "", false
+}
+
+// egressTierPrices returns the unit prices of the tiers of a sku keyed by the start of the tiers
+func egressTierPrices(pricingInfos []*billing.PricingInfo) (map[string]float64, error) {
+	if len(pricingInfos) != 1 {
+		return nil, errors.New("pricing info not parsable")
+	}
+	tiers := make(map[string]float64)
+	for _, tr := range pricingInfos[0].PricingExpression.TieredRates {
+		start := strconv.FormatFloat(tr.StartUsageAmount, 'f', -1, 64)
+		tiers[start] = float64(tr.UnitPrice.Units) + float64(tr.UnitPrice.Nanos)*1e-9
+	}
+	return tiers, nil
+}
+
+// networkPrices collects the network prices per region from the prices of the skus
+func networkPrices(pricePerRegion map[string]map[string]map[string]float64) map[string]cloudinfo.NetworkPrices {
+	network := make(map[string]cloudinfo.NetworkPrices)
+	for region, price := range pricePerRegion {
+		prices := cloudinfo.NetworkPrices{
+			InterZonePerGb: price[interZoneEgress]["OnDemand"],
+			Currency:       cloudinfo.CurrencyUSD,
+		}
+		if hourly, ok := price[lbForwardingRule]["OnDemand"]; ok {
+			prices.LoadBalancers = []cloudinfo.LoadBalancerPrice{
+				{
+					Type:         "forwarding-rule",
+					PricePerHour: hourly,
+					PricePerGb:   price[lbDataProcessing]["OnDemand"],
+				},
+			}
+		}
+		for start, unitPrice := range price[internetEgress] {
+			startGb, err := strconv.ParseFloat(start, 64)
+			if err != nil {
+				continue
+			}
+			prices.InternetEgress = append(prices.InternetEgress, cloudinfo.EgressTier{StartGb: startGb, PricePerGb: unitPrice})
+		}
+		sort.Slice(prices.InternetEgress, func(i, j int) bool {
+			return prices.InternetEgress[i].StartGb < prices.InternetEgress[j].StartGb
+		})
+		for i := 0; i+1 < len(prices.InternetEgress); i++ {
+			prices.InternetEgress[i].EndGb = prices.InternetEgress[i+1].StartGb
+		}
+		network[region] = prices
+	}
+	return network
+}
@@ -0,0 +1,75 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// diskType describes a persistent disk type
+type diskType struct {
+	name     string
+	category string
+}
+
+// diskTypes maps the resource groups of the persistent disk capacity skus to disk types
+var diskTypes = map[string]diskType{
+	"PDStandard": {name: "pd-standard", category: cloudinfo.DiskTypeHdd},
+	"SSD":        {name: "pd-ssd", category: cloudinfo.DiskTypeSsd},
+}
+
+const (
+	// minDiskSize and maxDiskSize are the size limits of the persistent disks in GiB
+	minDiskSize = 10
+	maxDiskSize = 65536
+)
+
+// GetStorage returns the persistent disk types and their prices in the given region
+// The prices are collected with the compute engine skus during initialization, the IOPS are not charged separately
+func (g *GceInfoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	if g.storagePrices == nil {
+		return nil, errors.New("storage prices are not yet retrieved")
+	}
+	return g.storagePrices[region], nil
+}
+
+// storagePrices collects the persistent disk prices per region from the prices of the skus
+func storagePrices(pricePerRegion map[string]map[string]map[string]float64) map[string][]cloudinfo.StorageInfo {
+	storage := make(map[string][]cloudinfo.StorageInfo)
+	for region, price := range pricePerRegion {
+		for _, dt := range diskTypes {
+			gbPrice, ok := price[dt.name]["OnDemand"]
+			if !ok {
+				continue
+			}
+			storage[region] = append(storage[region], cloudinfo.StorageInfo{
+				Type:            dt.name,
+				Category:        dt.category,
+				PricePerGbMonth: gbPrice,
+				MinSize:         minDiskSize,
+				MaxSize:         maxDiskSize,
+				Currency:        cloudinfo.CurrencyUSD,
+			})
+		}
+		sort.Slice(storage[region], func(i, j int) bool {
+			return storage[region][i].Type < storage[region][j].Type
+		})
+	}
+	return storage
+}
@@ -0,0 +1,39 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// taxonomyRules classifies the machine types by their family and series, eg.: n1-highmem-4 is the 4 vCPU memory optimized
+// machine type of the 1st generation n1 family; the shared core machine types are sized by name (micro, small, medium)
+var taxonomyRules = []cloudinfo.TaxonomyRule{
+	cloudinfo.NewTaxonomyRule(`^(?P<family>[efg](?P<generation>[0-9]))-(?P<size>micro|small|medium)$`, "${family}", cloudinfo.CategoryBurstable),
+	familyRule("a", "[a-z]+", cloudinfo.CategoryAccelerated),
+	familyRule("c", "[a-z]+", cloudinfo.CategoryCompute),
+	familyRule("m", "[a-z]+", cloudinfo.CategoryMemory),
+	familyRule("[a-z]", "highcpu", cloudinfo.CategoryCompute),
+	familyRule("[a-z]", "highmem|megamem|ultramem", cloudinfo.CategoryMemory),
+	familyRule("[a-z]", "standard", cloudinfo.CategoryGeneral),
+}
+
+// familyRule creates the rule of the machine types of the given families and series
+func familyRule(families, series, category string) cloudinfo.TaxonomyRule {
+	return cloudinfo.NewTaxonomyRule(
+		fmt.Sprintf(`^(?P<family>(?:%s)(?P<generation>[0-9])[a-z]?)-(?:%s)-(?P<size>[0-9]+[a-z]?)$`, families, series), "${family}", category)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyRules(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		expected     cloudinfo.VmInfo
+	}{
+		{
+			instanceType: "n1-standard-2",
+			expected:     cloudinfo.VmInfo{Type: "n1-standard-2", Category: cloudinfo.CategoryGeneral, Family: "n1", Generation: 1, Size: "2"},
+		},
+		{
+			instanceType: "n2d-standard-32",
+			expected:     cloudinfo.VmInfo{Type: "n2d-standard-32", Category: cloudinfo.CategoryGeneral, Family: "n2d", Generation: 2, Size: "32"},
+		},
+		{
+			instanceType: "n1-highcpu-16",
+			expected:     cloudinfo.VmInfo{Type: "n1-highcpu-16", Category: cloudinfo.CategoryCompute, Family: "n1", Generation: 1, Size: "16"},
+		},
+		{
+			instanceType: "c2-standard-4",
+			expected:     cloudinfo.VmInfo{Type: "c2-standard-4", Category: cloudinfo.CategoryCompute, Family: "c2", Generation: 2, Size: "4"},
+		},
+		{
+			instanceType: "n1-highmem-4",
+			expected:     cloudinfo.VmInfo{Type: "n1-highmem-4", Category: cloudinfo.CategoryMemory, Family: "n1", Generation: 1, Size: "4"},
+		},
+		{
+			instanceType: "m1-ultramem-40",
+			expected:     cloudinfo.VmInfo{Type: "m1-ultramem-40", Category: cloudinfo.CategoryMemory, Family: "m1", Generation: 1, Size: "40"},
+		},
+		{
+			instanceType: "a2-highgpu-1g",
+			expected:     cloudinfo.VmInfo{Type: "a2-highgpu-1g", Category: cloudinfo.CategoryAccelerated, Family: "a2", Generation: 2, Size: "1g"},
+		},
+		{
+			instanceType: "f1-micro",
+			expected:     cloudinfo.VmInfo{Type: "f1-micro", Category: cloudinfo.CategoryBurstable, Family: "f1", Generation: 1, Size: "micro"},
+		},
+		{
+			instanceType: "e2-medium",
+			expected:     cloudinfo.VmInfo{Type: "e2-medium", Category: cloudinfo.CategoryBurstable, Family: "e2", Generation: 2, Size: "medium"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			assert.True(t, vm.Classify(taxonomyRules), "the instance type should be classified")
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+
+	vm := cloudinfo.VmInfo{Type: "custom-2-4096"}
+	assert.False(t, vm.Classify(taxonomyRules), "unknown instance types should not be classified")
+	assert.Equal(t, "", vm.Category)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+)
+
+// NetworkPrices holds the prices of the network products in a region
+type NetworkPrices struct {
+	// LoadBalancers lists the load balancer types of the region
+	LoadBalancers []LoadBalancerPrice `json:"loadBalancers"`
+	// InterZonePerGb is the price of a GB transferred between the zones of the region
+	InterZonePerGb float64 `json:"interZonePerGb"`
+	// InternetEgress lists the tiers of the monthly data transfer to the internet, ordered by volume
+	InternetEgress []EgressTier `json:"internetEgress"`
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency"`
+}
+
+// LoadBalancerPrice describes the prices of a load balancer type
+type LoadBalancerPrice struct {
+	// Type is the provider specific name of the load balancer type (eg.: application, network, forwarding-rule, standard)
+	Type string `json:"type"`
+	// PricePerHour is the price of a load balancer (or load balancing rule) per hour
+	PricePerHour float64 `json:"pricePerHour"`
+	// PricePerGb is the price of a GB processed by the load balancer, 0 if not charged
+	PricePerGb float64 `json:"pricePerGb"`
+	// PricePerCapacityUnitHour is the price of a capacity unit (eg.: aws LCU) per hour, 0 if not charged
+	PricePerCapacityUnitHour float64 `json:"pricePerCapacityUnitHour"`
+}
+
+// EgressTier holds the price of the data transferred to the internet within a range of the monthly volume
+type EgressTier struct {
+	// StartGb is the monthly volume in GB the tier starts at
+	StartGb float64 `json:"startGb"`
+	// EndGb is the monthly volume in GB the tier ends at, 0 if the tier is not bounded
+	EndGb float64 `json:"endGb"`
+	// PricePerGb is the price of a GB transferred within the tier
+	PricePerGb float64 `json:"pricePerGb"`
+}
+
+// ConvertNetworkCurrency returns the network prices converted to the given currency
+// An empty currency leaves the prices in the source currency
+func ConvertNetworkCurrency(ctx context.Context, prices NetworkPrices, currency string, rater ExchangeRater) (NetworkPrices, error) {
+	if currency == "" {
+		return prices, nil
+	}
+
+	c := newConverter(ctx, currency, rater)
+	rate, err := c.rate(prices.Currency)
+	if err != nil {
+		return NetworkPrices{}, err
+	}
+
+	converted := NetworkPrices{
+		InterZonePerGb: prices.InterZonePerGb * rate,
+		Currency:       c.currency,
+	}
+	for _, lb := range prices.LoadBalancers {
+		lb.PricePerHour *= rate
+		lb.PricePerGb *= rate
+		lb.PricePerCapacityUnitHour *= rate
+		converted.LoadBalancers = append(converted.LoadBalancers, lb)
+	}
+	for _, tier := range prices.InternetEgress {
+		tier.PricePerGb *= rate
+		converted.InternetEgress = append(converted.InternetEgress, tier)
+	}
+	return converted, nil
+}
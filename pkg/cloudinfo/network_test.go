@@ -0,0 +1,45 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertNetworkCurrency(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	prices := NetworkPrices{
+		LoadBalancers:  []LoadBalancerPrice{{Type: "application", PricePerHour: 0.025, PricePerCapacityUnitHour: 0.008}},
+		InterZonePerGb: 0.01,
+		InternetEgress: []EgressTier{{StartGb: 0, EndGb: 10240, PricePerGb: 0.09}},
+		Currency:       CurrencyUSD,
+	}
+
+	converted, err := ConvertNetworkCurrency(context.Background(), prices, "EUR", rates)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, NetworkPrices{
+		LoadBalancers:  []LoadBalancerPrice{{Type: "application", PricePerHour: 0.0125, PricePerCapacityUnitHour: 0.004}},
+		InterZonePerGb: 0.005,
+		InternetEgress: []EgressTier{{StartGb: 0, EndGb: 10240, PricePerGb: 0.045}},
+		Currency:       "EUR",
+	}, converted)
+	assert.Equal(t, 0.025, prices.LoadBalancers[0].PricePerHour, "the source prices should be left untouched")
+
+	_, err = ConvertNetworkCurrency(context.Background(), prices, "HUF", rates)
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
@@ -38,6 +38,7 @@ func (oci *OCI) NewContainerEngineClient() (client *ContainerEngine, err error)
 		return client, err
 	}
 
+	oci.setTransport(&oClient.BaseClient)
 	client.client = &oClient
 	client.oci = oci
 	client.CompartmentOCID = *oci.Tenancy.Id
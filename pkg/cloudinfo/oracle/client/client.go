@@ -15,8 +15,14 @@
 package client
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
+	"net/http"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
 	"github.com/oracle/oci-go-sdk/common"
 	"github.com/oracle/oci-go-sdk/identity"
 	"github.com/sirupsen/logrus"
@@ -24,21 +30,30 @@ import (
 
 // OCI is for managing OCI API calls
 type OCI struct {
-	config  common.ConfigurationProvider
-	logger  *logrus.Logger
-	Tenancy identity.Tenancy
+	config    common.ConfigurationProvider
+	transport http.RoundTripper
+	logger    *logrus.Logger
+	Tenancy   identity.Tenancy
 }
 
 // NewOCI creates a new OCI and gets and caches tenancy info
-func NewOCI(configFileLocation string) (oci *OCI, err error) {
+// The API calls are sent through the given transport if it's not nil, the credentials of the config file are not
+// loaded if the transport replays fixtures
+func NewOCI(configFileLocation string, transport http.RoundTripper) (oci *OCI, err error) {
 	config, err := common.ConfigurationProviderFromFile(configFileLocation, "")
 	if err != nil {
 		return
 	}
+	if recorder.IsReplaying(transport) {
+		if config, err = newReplayConfigurationProvider(config); err != nil {
+			return
+		}
+	}
 
 	oci = &OCI{
-		config: config,
-		logger: logrus.New(),
+		config:    config,
+		transport: transport,
+		logger:    logrus.New(),
 	}
 
 	_, err = oci.GetTenancy()
@@ -77,6 +92,54 @@ func (oci *OCI) ChangeRegion(regionName string) (err error) {
 	return
 }
 
+// replayConfigurationProvider provides the tenancy and the region of a config with a throwaway user and key
+// The SDK clients refuse to be created without a key, though the requests served from fixtures are not authenticated
+type replayConfigurationProvider struct {
+	common.ConfigurationProvider
+	key *rsa.PrivateKey
+}
+
+// newReplayConfigurationProvider creates a configuration provider for replaying fixtures out of a config,
+// only the tenancy and the region of the config are read
+func newReplayConfigurationProvider(config common.ConfigurationProvider) (common.ConfigurationProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	return replayConfigurationProvider{ConfigurationProvider: config, key: key}, nil
+}
+
+// UserOCID returns a placeholder user
+func (p replayConfigurationProvider) UserOCID() (string, error) {
+	return "replay", nil
+}
+
+// KeyFingerprint returns a placeholder fingerprint
+func (p replayConfigurationProvider) KeyFingerprint() (string, error) {
+	return "replay", nil
+}
+
+// KeyID returns the id of the throwaway key
+func (p replayConfigurationProvider) KeyID() (string, error) {
+	tenancy, err := p.TenancyOCID()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/replay/replay", tenancy), nil
+}
+
+// PrivateRSAKey returns the throwaway key
+func (p replayConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.key, nil
+}
+
+// setTransport makes the SDK client send its requests through the transport of the OCI, if any
+func (oci *OCI) setTransport(c *common.BaseClient) {
+	if oci.transport != nil {
+		c.HTTPClient = &http.Client{Transport: oci.transport}
+	}
+}
+
 // SetLogger sets a logrus logger
 func (oci *OCI) SetLogger(logger *logrus.Logger) {
 
@@ -37,6 +37,7 @@ func (oci *OCI) NewComputeClient() (client *Compute, err error) {
 		return client, err
 	}
 
+	oci.setTransport(&oClient.BaseClient)
 	client.client = &oClient
 	client.oci = oci
 
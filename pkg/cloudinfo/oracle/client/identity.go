@@ -38,6 +38,7 @@ func (oci *OCI) NewIdentityClient() (client *Identity, err error) {
 		return client, err
 	}
 
+	oci.setTransport(&oClient.BaseClient)
 	client.client = &oClient
 	client.oci = oci
 
@@ -17,6 +17,7 @@ package oracle
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 
@@ -27,21 +28,33 @@ import (
 // Infoer encapsulates the data and operations needed to access external resources
 type Infoer struct {
 	client         *client.OCI
+	httpClient     *http.Client
 	shapeSpecs     map[string]ShapeSpecs
 	cloudInfoCache map[string]ITRACloudInfo
 }
 
 // ShapeSpecs representation the specs of a certain type of virtual machine
+// The cpus of the shapes are OCPUs (physical cores), the shapes are priced per OCPU
 type ShapeSpecs struct {
 	PartNumber string
 	Cpus       float64 `json:"cpusPerVm"`
 	Mem        float64 `json:"memPerVm"`
 	NtwPerf    string  `json:"NtwPerf"`
+	// LocalDisks is the number of the local nvme disks of the DenseIO shapes
+	LocalDisks int `json:"localDisks"`
+	// LocalDiskSize is the size of a local disk in GB as published by Oracle (eg.: 3.2 TB)
+	LocalDiskSize float64 `json:"localDiskSize"`
 }
 
 const (
 	cpu    = "cpu"
 	memory = "memory"
+
+	// vcpusPerOcpu is the number of vCPUs (hardware threads) of an OCPU
+	vcpusPerOcpu = 2
+
+	// gibPerGb converts the decimal GB sizes of the shape specs to GiB
+	gibPerGb = 1e9 / (1 << 30)
 )
 
 var regionNames = map[string]string{
@@ -63,24 +76,26 @@ var shapeSpecs = map[string]ShapeSpecs{
 	"VM.Standard1.16": ShapeSpecs{PartNumber: "B88317", Mem: 112, Cpus: 16, NtwPerf: "4.8 Gbps"},
 	"VM.Standard2.16": ShapeSpecs{PartNumber: "B88514", Mem: 240, Cpus: 16, NtwPerf: "16.4 Gbps"},
 	"VM.Standard2.24": ShapeSpecs{PartNumber: "B88514", Mem: 320, Cpus: 24, NtwPerf: "24.6 Gbps"},
-	"VM.DenseIO1.4":   ShapeSpecs{PartNumber: "B88316", Mem: 60, Cpus: 4, NtwPerf: "1.2 Gbps"},
-	"VM.DenseIO1.8":   ShapeSpecs{PartNumber: "B88316", Mem: 60, Cpus: 8, NtwPerf: "2.4 Gbps"},
-	"VM.DenseIO2.8":   ShapeSpecs{PartNumber: "B88516", Mem: 120, Cpus: 8, NtwPerf: "8.2 Gbps"},
-	"VM.DenseIO1.16":  ShapeSpecs{PartNumber: "B88316", Mem: 120, Cpus: 16, NtwPerf: "4.8 Gbps"},
-	"VM.DenseIO2.16":  ShapeSpecs{PartNumber: "B88516", Mem: 240, Cpus: 16, NtwPerf: "16.4 Gbps"},
-	"VM.DenseIO2.24":  ShapeSpecs{PartNumber: "B88516", Mem: 320, Cpus: 24, NtwPerf: "24.6 Gbps"},
+	"VM.DenseIO1.4":   ShapeSpecs{PartNumber: "B88316", Mem: 60, Cpus: 4, NtwPerf: "1.2 Gbps", LocalDisks: 1, LocalDiskSize: 3200},
+	"VM.DenseIO1.8":   ShapeSpecs{PartNumber: "B88316", Mem: 60, Cpus: 8, NtwPerf: "2.4 Gbps", LocalDisks: 2, LocalDiskSize: 3200},
+	"VM.DenseIO2.8":   ShapeSpecs{PartNumber: "B88516", Mem: 120, Cpus: 8, NtwPerf: "8.2 Gbps", LocalDisks: 1, LocalDiskSize: 6400},
+	"VM.DenseIO1.16":  ShapeSpecs{PartNumber: "B88316", Mem: 120, Cpus: 16, NtwPerf: "4.8 Gbps", LocalDisks: 4, LocalDiskSize: 3200},
+	"VM.DenseIO2.16":  ShapeSpecs{PartNumber: "B88516", Mem: 240, Cpus: 16, NtwPerf: "16.4 Gbps", LocalDisks: 2, LocalDiskSize: 6400},
+	"VM.DenseIO2.24":  ShapeSpecs{PartNumber: "B88516", Mem: 320, Cpus: 24, NtwPerf: "24.6 Gbps", LocalDisks: 4, LocalDiskSize: 6400},
 }
 
 // NewInfoer creates a new instance of the infoer
-func NewInfoer(configFileLocation string) (*Infoer, error) {
+// The OCI and ITRA APIs are accessed through the given transport if it's not nil (see the recorder package)
+func NewInfoer(configFileLocation string, transport http.RoundTripper) (*Infoer, error) {
 
-	oci, err := client.NewOCI(configFileLocation)
+	oci, err := client.NewOCI(configFileLocation, transport)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Infoer{
 		client:     oci,
+		httpClient: &http.Client{Transport: transport},
 		shapeSpecs: shapeSpecs,
 	}, nil
 }
@@ -127,6 +142,7 @@ func (i *Infoer) Initialize(ctx context.Context) (prices map[string]map[string]c
 
 			price := prices[region][product.Type]
 			price.OnDemandPrice = shapePrice
+			price.Currency = cloudinfo.CurrencyUSD
 			prices[region][product.Type] = price
 			log.WithField("region", region).Debugf("price info added: [machinetype=%s, price=%v]", product.Type, price)
 		}
@@ -162,8 +178,8 @@ func (i *Infoer) GetAttributeValues(ctx context.Context, service, attribute stri
 			switch attribute {
 			case cpu:
 				attr = cloudinfo.AttrValue{
-					Value:    specs.Cpus,
-					StrValue: fmt.Sprintf("%v", specs.Cpus),
+					Value:    specs.Cpus * vcpusPerOcpu,
+					StrValue: fmt.Sprintf("%v", specs.Cpus*vcpusPerOcpu),
 				}
 			case memory:
 				attr = cloudinfo.AttrValue{
@@ -231,21 +247,29 @@ func (i *Infoer) GetProducts(ctx context.Context, service, regionId string) (pro
 	products = make([]cloudinfo.VmInfo, 0)
 	for _, shape := range shapes {
 		s := i.shapeSpecs[shape]
-		ntwMapper := newNetworkMapper()
-		ntwPerfCat, err := ntwMapper.MapNetworkPerf(fmt.Sprint(s.NtwPerf))
+		bandwidth, _, err := cloudinfo.ParseBandwidth(s.NtwPerf)
 		if err != nil {
-			logger.Extract(ctx).WithError(err).Debug("could not get network performance category")
+			logger.Extract(ctx).WithError(err).Debug("could not get network bandwidth")
 		}
 
-		products = append(products, cloudinfo.VmInfo{
+		vm := cloudinfo.VmInfo{
 			Type:       shape,
 			NtwPerf:    s.NtwPerf,
-			NtwPerfCat: ntwPerfCat,
-			Cpus:       s.Cpus,
+			Bandwidth:  bandwidth,
+			Cpus:       s.Cpus * vcpusPerOcpu,
+			CpuCores:   s.Cpus,
 			Mem:        s.Mem,
 			Zones:      zones,
-			Attributes: cloudinfo.Attributes(fmt.Sprint(s.Cpus), fmt.Sprint(s.Mem), ntwPerfCat),
-		})
+			Attributes: cloudinfo.Attributes(fmt.Sprint(s.Cpus*vcpusPerOcpu), fmt.Sprint(s.Mem)),
+			Arch:       cloudinfo.ArchX86,
+		}
+		if s.LocalDisks > 0 {
+			vm.LocalDisks = s.LocalDisks
+			vm.LocalDiskSize = s.LocalDiskSize * gibPerGb
+			vm.LocalDiskType = cloudinfo.DiskTypeNvme
+		}
+		vm.Classify(taxonomyRules)
+		products = append(products, vm)
 	}
 
 	return
@@ -312,7 +336,7 @@ func (i *Infoer) HasShortLivedPriceInfo() bool {
 func (i *Infoer) GetServices() ([]cloudinfo.ServiceDescriber, error) {
 	services := []cloudinfo.ServiceDescriber{
 		cloudinfo.NewService("compute"),
-		cloudinfo.NewService("oke")}
+		cloudinfo.NewKubernetesService("oke", cloudinfo.NewFreeControlPlane())}
 	return services, nil
 }
 
@@ -328,7 +352,7 @@ func (i *Infoer) GetService(ctx context.Context, service string) (cloudinfo.Serv
 			return sd, nil
 		}
 	}
-	return nil, fmt.Errorf("the service [%s] is not supported", service)
+	return nil, cloudinfo.NewNotFoundError("the service [%s] is not supported", service)
 }
 
 // HasImages - Oracle support images
@@ -0,0 +1,83 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/cloudinfotest"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInfoer_Replay(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	// the config only holds the tenancy and the region, credentials are not loaded in replay mode
+	infoer, err := NewInfoer("testdata/config", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+	assert.Equal(t, "ocid1.tenancy.oc1..replay", *infoer.client.Tenancy.Id)
+
+	regions, err := infoer.GetRegions(context.Background(), "compute")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, map[string]string{"eu-frankfurt-1": "EU (Frankfurt)"}, regions)
+
+	zones, err := infoer.GetZones(context.Background(), "eu-frankfurt-1")
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, []string{"Wdni:EU-FRANKFURT-1-AD-1", "Wdni:EU-FRANKFURT-1-AD-2", "Wdni:EU-FRANKFURT-1-AD-3"}, zones)
+}
+
+func TestInfoer_Conformance(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	infoer, err := NewInfoer("testdata/config", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	cloudinfotest.RunConformance(t, infoer, cloudinfotest.Options{
+		Regions: []string{"eu-frankfurt-1"},
+	})
+}
+
+func TestInfoer_GetProducts(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	infoer, err := NewInfoer("testdata/config", replayer)
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	products, err := infoer.GetProducts(context.Background(), "compute", "eu-frankfurt-1")
+	assert.Nil(t, err, "the error should be nil")
+	for _, vm := range products {
+		if vm.Type == "VM.DenseIO2.8" {
+			assert.Equal(t, 1, vm.LocalDisks)
+			// the 6.4 TB disk of the shape specs
+			assert.InDelta(t, 5960.46, vm.LocalDiskSize, 0.01, "the local disk size should be given in GiB")
+			return
+		}
+	}
+	t.Errorf("the products should list VM.DenseIO2.8: %v", products)
+}
@@ -19,7 +19,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"github.com/banzaicloud/cloudinfo/pkg/logger"
 )
@@ -60,7 +59,7 @@ func (i *Infoer) GetCloudInfoFromITRA(ctx context.Context, partNumber string) (i
 	logger.Extract(ctx).Debugf("getting product info for PN[%s]", partNumber)
 
 	url := fmt.Sprintf("https://itra.oraclecloud.com/itas/.anon/myservices/api/v1/products?partNumber=%s", partNumber)
-	resp, err := http.Get(url)
+	resp, err := i.httpClient.Get(url)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,60 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfoer_GetCloudInfoFromITRA(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+
+	tests := []struct {
+		name       string
+		partNumber string
+		check      func(info ITRACloudInfo, err error)
+	}{
+		{
+			name:       "retrieve the recorded product info",
+			partNumber: "B88317",
+			check: func(info ITRACloudInfo, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, "B88317", info.PartNumber)
+				assert.Equal(t, 0.0638, info.GetPrice("PAY_AS_YOU_GO"))
+			},
+		},
+		{
+			name:       "no product info recorded for the part number",
+			partNumber: "B88514",
+			check: func(info ITRACloudInfo, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := Infoer{httpClient: &http.Client{Transport: replayer}}
+			test.check(infoer.GetCloudInfoFromITRA(context.Background(), test.partNumber))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// GetNetworkPrices returns no network prices, the load balancers and the data transfer are not priced for oracle yet
+func (i *Infoer) GetNetworkPrices(ctx context.Context, region string) (cloudinfo.NetworkPrices, error) {
+	return cloudinfo.NetworkPrices{Currency: cloudinfo.CurrencyUSD}, nil
+}
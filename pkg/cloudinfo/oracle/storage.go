@@ -0,0 +1,48 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+const (
+	// blockVolumePartNumber is the ITRA part number of the block volume storage (priced per GB-month)
+	blockVolumePartNumber = "B91961"
+	// minBlockVolumeSize and maxBlockVolumeSize are the size limits of the block volumes in GiB
+	minBlockVolumeSize = 50
+	maxBlockVolumeSize = 32768
+)
+
+// GetStorage returns the block volume storage and its price, the block volumes are priced the same in every region
+func (i *Infoer) GetStorage(ctx context.Context, region string) ([]cloudinfo.StorageInfo, error) {
+	info, err := i.GetCloudInfoFromITRA(ctx, blockVolumePartNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return []cloudinfo.StorageInfo{
+		{
+			Type:            "block-volume",
+			Category:        cloudinfo.DiskTypeSsd,
+			PricePerGbMonth: info.GetPrice("PAY_AS_YOU_GO"),
+			MinSize:         minBlockVolumeSize,
+			MaxSize:         maxBlockVolumeSize,
+			Currency:        cloudinfo.CurrencyUSD,
+		},
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfoer_GetStorage(t *testing.T) {
+	replayer, err := recorder.NewTransport(recorder.ModeReplay, "testdata/fixtures", nil)
+	if err != nil {
+		t.Fatalf("failed to load fixtures; [%s]", err.Error())
+	}
+	infoer := Infoer{httpClient: &http.Client{Transport: replayer}}
+
+	storage, err := infoer.GetStorage(context.Background(), "eu-frankfurt-1")
+	assert.Nil(t, err, "the error should be nil")
+	if assert.Equal(t, 1, len(storage)) {
+		assert.Equal(t, "block-volume", storage[0].Type)
+		assert.Equal(t, cloudinfo.DiskTypeSsd, storage[0].Category)
+		assert.Equal(t, 0.0255, storage[0].PricePerGbMonth)
+		assert.Equal(t, cloudinfo.CurrencyUSD, storage[0].Currency)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+)
+
+// taxonomyRules classifies the virtual machine and bare metal shapes by their series, eg.: VM.DenseIO2.8 is the 8 OCPU shape
+// of the 2nd generation DenseIO family
+var taxonomyRules = []cloudinfo.TaxonomyRule{
+	seriesRule("Standard", cloudinfo.CategoryGeneral),
+	seriesRule("HPC", cloudinfo.CategoryCompute),
+	seriesRule("DenseIO", cloudinfo.CategoryStorage),
+	seriesRule("GPU", cloudinfo.CategoryAccelerated),
+}
+
+// seriesRule creates the rule of the shapes of the given series
+func seriesRule(series, category string) cloudinfo.TaxonomyRule {
+	return cloudinfo.NewTaxonomyRule(
+		fmt.Sprintf(`^(?:VM|BM)\.(?P<family>%s(?P<generation>[0-9]+))\.(?P<size>[0-9]+)$`, series), "${family}", category)
+}
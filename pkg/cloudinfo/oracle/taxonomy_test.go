@@ -0,0 +1,61 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/cloudinfo/pkg/cloudinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaxonomyRules(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		expected     cloudinfo.VmInfo
+	}{
+		{
+			instanceType: "VM.Standard2.1",
+			expected:     cloudinfo.VmInfo{Type: "VM.Standard2.1", Category: cloudinfo.CategoryGeneral, Family: "Standard2", Generation: 2, Size: "1"},
+		},
+		{
+			instanceType: "BM.Standard1.36",
+			expected:     cloudinfo.VmInfo{Type: "BM.Standard1.36", Category: cloudinfo.CategoryGeneral, Family: "Standard1", Generation: 1, Size: "36"},
+		},
+		{
+			instanceType: "VM.DenseIO2.8",
+			expected:     cloudinfo.VmInfo{Type: "VM.DenseIO2.8", Category: cloudinfo.CategoryStorage, Family: "DenseIO2", Generation: 2, Size: "8"},
+		},
+		{
+			instanceType: "BM.HPC2.36",
+			expected:     cloudinfo.VmInfo{Type: "BM.HPC2.36", Category: cloudinfo.CategoryCompute, Family: "HPC2", Generation: 2, Size: "36"},
+		},
+		{
+			instanceType: "VM.GPU3.1",
+			expected:     cloudinfo.VmInfo{Type: "VM.GPU3.1", Category: cloudinfo.CategoryAccelerated, Family: "GPU3", Generation: 3, Size: "1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			vm := cloudinfo.VmInfo{Type: test.instanceType}
+			assert.True(t, vm.Classify(taxonomyRules), "the instance type should be classified")
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+
+	vm := cloudinfo.VmInfo{Type: "VM.Standard.E2.1"}
+	assert.False(t, vm.Classify(taxonomyRules), "unknown instance types should not be classified")
+	assert.Equal(t, "", vm.Category)
+}
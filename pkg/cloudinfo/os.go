@@ -0,0 +1,105 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+const (
+	// OsLinux is the default operating system, the on demand and spot prices are given for linux
+	OsLinux = "linux"
+
+	// OsWindows is windows server with license included
+	OsWindows = "windows"
+
+	// OsRhel is red hat enterprise linux with license included
+	OsRhel = "rhel"
+
+	// OsSuse is suse linux enterprise server with license included
+	OsSuse = "suse"
+
+	// OsWindowsSqlWeb is windows server with sql server web edition
+	OsWindowsSqlWeb = "windows-sql-web"
+
+	// OsWindowsSqlStandard is windows server with sql server standard edition
+	OsWindowsSqlStandard = "windows-sql-standard"
+
+	// OsWindowsSqlEnterprise is windows server with sql server enterprise edition
+	OsWindowsSqlEnterprise = "windows-sql-enterprise"
+)
+
+// OsPrices holds the on demand prices per operating system / license, keyed by the Os constants
+type OsPrices map[string]float64
+
+// Oses returns the supported operating system / license values
+func Oses() []string {
+	return []string{OsLinux, OsWindows, OsRhel, OsSuse, OsWindowsSqlWeb, OsWindowsSqlStandard, OsWindowsSqlEnterprise}
+}
+
+// With returns a copy of the prices extended with the price of the given operating system
+// the receiver is left untouched as prices may be shared between instance types
+func (op OsPrices) With(os string, price float64) OsPrices {
+	prices := make(OsPrices, len(op)+1)
+	for k, v := range op {
+		prices[k] = v
+	}
+	prices[os] = price
+	return prices
+}
+
+// validateOs checks that the prices of the operating system / license can be available for the products of a provider
+// The providers publishing the prices of other operating systems list them in the OsPrices of the products, the
+// products of the rest only have linux prices
+func validateOs(provider string, details []ProductDetails, os string) error {
+	if os == "" || os == OsLinux {
+		return nil
+	}
+	if !Contains(Oses(), os) {
+		return NewInvalidArgumentError("unsupported operating system: [%s]", os)
+	}
+	for _, d := range details {
+		if len(d.OsPrices) > 0 {
+			return nil
+		}
+	}
+	if len(details) > 0 {
+		return NewInvalidArgumentError("only linux prices are available for the provider: [%s]", provider)
+	}
+	return nil
+}
+
+// SelectOs returns the product details priced for the given operating system / license
+// The on demand price is replaced by the price of the operating system, products without such price are left out
+// Spot prices, spot statistics and commitment prices are only collected for linux, so they are dropped for the rest of the operating systems
+func SelectOs(details []ProductDetails, os string) ([]ProductDetails, error) {
+	if os == "" || os == OsLinux {
+		return details, nil
+	}
+	if !Contains(Oses(), os) {
+		return nil, NewInvalidArgumentError("unsupported operating system: [%s]", os)
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		price, ok := d.OsPrices[os]
+		if !ok {
+			continue
+		}
+		d.OnDemandPrice = price
+		d.SpotPrice = nil
+		d.SpotInfo = nil
+		d.SpotStats = nil
+		d.Commitments = nil
+		selected = append(selected, d)
+	}
+	return selected, nil
+}
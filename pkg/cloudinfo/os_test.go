@@ -0,0 +1,109 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOsPrices_With(t *testing.T) {
+	linux := OsPrices{OsLinux: 0.1}
+	prices := linux.With(OsWindows, 0.2)
+
+	assert.Equal(t, OsPrices{OsLinux: 0.1, OsWindows: 0.2}, prices)
+	assert.Equal(t, OsPrices{OsLinux: 0.1}, linux, "the receiver should be left untouched")
+	assert.Equal(t, OsPrices{OsSuse: 0.3}, OsPrices(nil).With(OsSuse, 0.3))
+}
+
+func TestSelectOs(t *testing.T) {
+	details := []ProductDetails{
+		{
+			VmInfo: VmInfo{
+				Type:          "m5.large",
+				OnDemandPrice: 0.1,
+				OsPrices:      OsPrices{OsLinux: 0.1, OsWindows: 0.2},
+				SpotStats:     map[string]SpotStats{"eu-west-1a": {Min: 0.02, Max: 0.04, Mean: 0.03, P50: 0.03, P90: 0.04, Samples: 3}},
+			},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.03}},
+		},
+		{
+			VmInfo: VmInfo{
+				Type:          "a1.large",
+				OnDemandPrice: 0.05,
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		os    string
+		check func(details []ProductDetails, err error)
+	}{
+		{
+			name: "linux prices are returned by default",
+			os:   "",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name: "products are priced for the selected operating system",
+			os:   OsWindows,
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(selected)) {
+					assert.Equal(t, "m5.large", selected[0].Type)
+					assert.Equal(t, 0.2, selected[0].OnDemandPrice)
+					assert.Nil(t, selected[0].SpotInfo, "spot prices are given for linux only")
+					assert.Nil(t, selected[0].SpotStats, "spot statistics are given for linux only")
+				}
+				assert.Equal(t, 0.1, details[0].OnDemandPrice, "the original details should be left untouched")
+			},
+		},
+		{
+			name: "unsupported operating system",
+			os:   "plan9",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectOs(details, test.os))
+		})
+	}
+}
+
+func TestValidateOs(t *testing.T) {
+	linux := []ProductDetails{{VmInfo: VmInfo{Type: "n1-standard-1", OnDemandPrice: 0.05}}}
+	windows := []ProductDetails{
+		{VmInfo: VmInfo{Type: "a1.large", OnDemandPrice: 0.05}},
+		{VmInfo: VmInfo{Type: "m5.large", OnDemandPrice: 0.1, OsPrices: OsPrices{OsLinux: 0.1, OsWindows: 0.2}}},
+	}
+
+	assert.Nil(t, validateOs("google", linux, ""))
+	assert.Nil(t, validateOs("google", linux, OsLinux))
+	assert.Nil(t, validateOs("amazon", windows, OsWindows))
+	assert.Nil(t, validateOs("amazon", windows, OsRhel), "the products without such price are left out")
+	assert.Nil(t, validateOs("amazon", nil, OsWindows), "a region without products has no prices to tell")
+	assert.IsType(t, InvalidArgumentError{}, validateOs("amazon", windows, "plan9"))
+	assert.EqualError(t, validateOs("google", linux, OsWindows), "only linux prices are available for the provider: [google]")
+	assert.IsType(t, InvalidArgumentError{}, validateOs("oracle", linux, OsRhel))
+}
@@ -0,0 +1,155 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// SortPrice orders the products by their on demand price
+	SortPrice = "price"
+	// SortPricePerCpu orders the products by their on demand price per vCPU
+	SortPricePerCpu = "pricePerCpu"
+
+	// OrderAsc is the ascending sort order
+	OrderAsc = "asc"
+	// OrderDesc is the descending sort order
+	OrderDesc = "desc"
+
+	// cursorSeparator separates the sort field, the sort order, the sort key and the instance type in the cursors
+	cursorSeparator = "|"
+)
+
+// productOrder orders the product details by a sort key, the instance type breaks the ties
+type productOrder struct {
+	sortBy string
+	key    func(ProductDetails) float64
+	desc   bool
+}
+
+// newProductOrder creates the order of the given sort field and direction, an empty field orders by the instance type
+func newProductOrder(sortBy, order string) (productOrder, error) {
+	po := productOrder{sortBy: sortBy}
+	switch sortBy {
+	case "":
+		po.key = func(ProductDetails) float64 { return 0 }
+	case SortPrice:
+		po.key = func(d ProductDetails) float64 { return d.OnDemandPrice }
+	case SortPricePerCpu:
+		po.key = func(d ProductDetails) float64 { return d.OnDemandPrice / d.Cpus }
+	default:
+		return po, NewInvalidArgumentError("unsupported sort field: [%s]", sortBy)
+	}
+	switch order {
+	case "", OrderAsc:
+	case OrderDesc:
+		po.desc = true
+	default:
+		return po, NewInvalidArgumentError("unsupported sort order: [%s]", order)
+	}
+	return po, nil
+}
+
+// direction returns the sort order of the products
+func (po productOrder) direction() string {
+	if po.desc {
+		return OrderDesc
+	}
+	return OrderAsc
+}
+
+// less compares the positions of two products given by their sort key and instance type
+func (po productOrder) less(key1 float64, type1 string, key2 float64, type2 string) bool {
+	if key1 != key2 {
+		return (key1 < key2) != po.desc
+	}
+	return type1 < type2
+}
+
+// PageProducts orders the product details by the given field and direction, and returns the page of at most limit
+// products following the cursor together with the cursor of the next page (empty on the last page)
+// The products are returned as they are if neither the order nor the page is given
+func PageProducts(details []ProductDetails, sortBy, order, cursor, limit string) ([]ProductDetails, string, error) {
+	if sortBy == "" && order == "" && cursor == "" && limit == "" {
+		return details, "", nil
+	}
+	po, err := newProductOrder(sortBy, order)
+	if err != nil {
+		return nil, "", err
+	}
+	size := 0
+	if limit != "" {
+		if size, err = strconv.Atoi(limit); err != nil || size <= 0 {
+			return nil, "", NewInvalidArgumentError("invalid limit: [%s]", limit)
+		}
+	}
+
+	sorted := make([]ProductDetails, len(details))
+	copy(sorted, details)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return po.less(po.key(sorted[i]), sorted[i].Type, po.key(sorted[j]), sorted[j].Type)
+	})
+
+	start := 0
+	if cursor != "" {
+		key, instanceType, err := decodeCursor(cursor, po)
+		if err != nil {
+			return nil, "", err
+		}
+		// the products after the last one of the previous page, even if that product has been removed since
+		start = sort.Search(len(sorted), func(i int) bool {
+			return po.less(key, instanceType, po.key(sorted[i]), sorted[i].Type)
+		})
+	}
+	page := sorted[start:]
+	if size == 0 || len(page) <= size {
+		return page, "", nil
+	}
+	page = page[:size]
+	last := page[size-1]
+	return page, encodeCursor(po, po.key(last), last.Type), nil
+}
+
+// encodeCursor creates an opaque cursor pointing after the product with the given sort key and instance type in the
+// order of the products
+func encodeCursor(po productOrder, key float64, instanceType string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		po.sortBy, po.direction(), strconv.FormatFloat(key, 'g', -1, 64), instanceType}, cursorSeparator)))
+}
+
+// decodeCursor returns the sort key and the instance type of the product the cursor points after
+// Cursors created for another order of the products are rejected
+func decodeCursor(cursor string, po productOrder) (float64, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", NewInvalidArgumentError("invalid cursor: [%s]", cursor)
+	}
+	parts := strings.SplitN(string(raw), cursorSeparator, 4)
+	if len(parts) != 4 {
+		return 0, "", NewInvalidArgumentError("invalid cursor: [%s]", cursor)
+	}
+	if parts[0] != po.sortBy || parts[1] != po.direction() {
+		return 0, "", NewInvalidArgumentError("the cursor belongs to another sort field or order: [%s]", cursor)
+	}
+	key, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, "", NewInvalidArgumentError("invalid cursor: [%s]", cursor)
+	}
+	return key, parts[3], nil
+}
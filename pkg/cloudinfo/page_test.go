@@ -0,0 +1,165 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func types(details []ProductDetails) []string {
+	var t []string
+	for _, d := range details {
+		t = append(t, d.Type)
+	}
+	return t
+}
+
+func TestPageProducts(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "m5.xlarge", Cpus: 4, OnDemandPrice: 0.192}},
+		{VmInfo: VmInfo{Type: "c5.large", Cpus: 2, OnDemandPrice: 0.085}},
+		{VmInfo: VmInfo{Type: "m5.large", Cpus: 2, OnDemandPrice: 0.096}},
+		{VmInfo: VmInfo{Type: "a1.large", Cpus: 2, OnDemandPrice: 0.051}},
+		{VmInfo: VmInfo{Type: "c5.xlarge", Cpus: 4, OnDemandPrice: 0.17}},
+	}
+	byType := productOrder{}
+	byPrice := productOrder{sortBy: SortPrice}
+
+	tests := []struct {
+		name   string
+		sortBy string
+		order  string
+		cursor string
+		limit  string
+		check  func(page []ProductDetails, next string, err error)
+	}{
+		{
+			name: "the products are returned as they are without order and page",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, page)
+				assert.Equal(t, "", next)
+			},
+		},
+		{
+			name:   "sorted by price",
+			sortBy: SortPrice,
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"a1.large", "c5.large", "m5.large", "c5.xlarge", "m5.xlarge"}, types(page))
+				assert.Equal(t, "", next)
+			},
+		},
+		{
+			name:   "sorted by price per cpu in descending order, ties broken by type",
+			sortBy: SortPricePerCpu,
+			order:  OrderDesc,
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"m5.large", "m5.xlarge", "c5.large", "c5.xlarge", "a1.large"}, types(page))
+			},
+		},
+		{
+			name:  "first page ordered by type",
+			limit: "2",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"a1.large", "c5.large"}, types(page))
+				assert.Equal(t, encodeCursor(byType, 0, "c5.large"), next)
+			},
+		},
+		{
+			name:   "next page",
+			sortBy: SortPrice,
+			cursor: encodeCursor(byPrice, 0.085, "c5.large"),
+			limit:  "2",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"m5.large", "c5.xlarge"}, types(page))
+				assert.Equal(t, encodeCursor(byPrice, 0.17, "c5.xlarge"), next)
+			},
+		},
+		{
+			name:   "last page",
+			sortBy: SortPrice,
+			cursor: encodeCursor(byPrice, 0.17, "c5.xlarge"),
+			limit:  "2",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"m5.xlarge"}, types(page))
+				assert.Equal(t, "", next)
+			},
+		},
+		{
+			name:   "the cursor of a removed product",
+			sortBy: SortPrice,
+			cursor: encodeCursor(byPrice, 0.09, "c6.large"),
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"m5.large", "c5.xlarge", "m5.xlarge"}, types(page))
+			},
+		},
+		{
+			name:   "unsupported sort field",
+			sortBy: "memory",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, page, "the page should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:   "invalid cursor",
+			cursor: "not a cursor",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, page, "the page should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:   "the cursor of another sort order",
+			sortBy: SortPrice,
+			order:  OrderDesc,
+			cursor: encodeCursor(byPrice, 0.085, "c5.large"),
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, page, "the page should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:   "the cursor of another sort field",
+			sortBy: SortPricePerCpu,
+			cursor: encodeCursor(byPrice, 0.085, "c5.large"),
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, page, "the page should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:  "invalid limit",
+			limit: "0",
+			check: func(page []ProductDetails, next string, err error) {
+				assert.Nil(t, page, "the page should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(PageProducts(details, test.sortBy, test.order, test.cursor, test.limit))
+		})
+	}
+}
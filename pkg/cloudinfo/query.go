@@ -0,0 +1,111 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+)
+
+// ProductQuery holds the filters, the currency and the price bounds of a product query, formatted as the query
+// parameters of the products endpoint; empty values don't filter the products
+type ProductQuery struct {
+	// Types selects the products of the given types, every type if nil
+	Types           []string
+	Category        string
+	Family          string
+	Generation      string
+	Size            string
+	Burst           string
+	MinBandwidth    string
+	Resources       ResourceFilter
+	NetworkCategory string
+	CurrentGen      string
+	Zone            string
+	Os              string
+	PricingModel    string
+	PaymentOption   string
+	Currency        string
+	MaxPrice        string
+	MaxSpotPrice    string
+}
+
+// QueryProducts retrieves the product details of a region selected by the query, with the prices in the currency of
+// the query; the price bounds are applied after the currency conversion, paging is left to the caller
+func (cpi *CachingCloudInfo) QueryProducts(ctx context.Context, provider, service, region string, query ProductQuery, rater ExchangeRater) ([]ProductDetails, error) {
+	details, err := cpi.GetProductDetails(ctx, provider, service, region)
+	if err != nil {
+		return nil, err
+	}
+	// the operating systems are told by the prices of every product of the region
+	if err := validateOs(provider, details, query.Os); err != nil {
+		return nil, err
+	}
+	if query.Types != nil {
+		var selected []ProductDetails
+		for _, d := range details {
+			if Contains(query.Types, d.Type) {
+				selected = append(selected, d)
+			}
+		}
+		details = selected
+	}
+	details, err = SelectTaxonomy(details, query.Category, query.Family, query.Generation, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectBurst(details, query.Burst)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectMinBandwidth(details, query.MinBandwidth)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectResources(details, query.Resources)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectNetworkCategory(details, query.NetworkCategory)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectCurrentGen(details, query.CurrentGen)
+	if err != nil {
+		return nil, err
+	}
+	if query.Zone != "" {
+		if err := cpi.ValidateZones(ctx, provider, region, []string{query.Zone}); err != nil {
+			return nil, err
+		}
+		details = SelectZone(details, query.Zone)
+	}
+	if query.Os != "" && query.Os != OsLinux && query.PricingModel != "" && query.PricingModel != PricingModelOnDemand {
+		// the commitment prices are only collected for linux
+		return nil, NewInvalidArgumentError("commitment prices are only available for linux: [%s]", query.Os)
+	}
+	details, err = SelectOs(details, query.Os)
+	if err != nil {
+		return nil, err
+	}
+	details, err = SelectPricingModel(details, query.PricingModel, query.PaymentOption)
+	if err != nil {
+		return nil, err
+	}
+	details, err = ConvertCurrency(ctx, details, query.Currency, rater)
+	if err != nil {
+		return nil, err
+	}
+	return SelectMaxPrice(details, query.MaxPrice, query.MaxSpotPrice)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachingCloudInfo_QueryProducts(t *testing.T) {
+	store := cache.New(5*time.Minute, 10*time.Minute)
+	info, _ := NewCachingCloudInfo(10*time.Second, store, map[string]CloudInfoer{"dummy": &DummyCloudInfoer{}}, DefaultNetworkCategories())
+	store.Set(info.getVmKey("dummy", "compute", "dummyRegion"), []VmInfo{
+		{Type: "small", Cpus: 1, Mem: 2, OnDemandPrice: 0.02},
+		{Type: "large", Cpus: 8, Mem: 32, OnDemandPrice: 0.4},
+	}, 0)
+	store.Set(info.getPriceKey("dummy", "dummyRegion", "large"), Price{
+		OnDemandPrice: 0.4,
+		SpotPrice:     SpotPriceInfo{"dummyZone1": 0.12},
+	}, 0)
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+
+	tests := []struct {
+		name    string
+		query   ProductQuery
+		checker func(details []ProductDetails, err error)
+	}{
+		{
+			name:  "empty query",
+			query: ProductQuery{},
+			checker: func(details []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, details, 2)
+			},
+		},
+		{
+			name:  "types and resources",
+			query: ProductQuery{Types: []string{"small", "large"}, Resources: ResourceFilter{MinCpu: "2"}},
+			checker: func(details []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, details, 1)
+				assert.Equal(t, "large", details[0].Type)
+			},
+		},
+		{
+			name:  "no types",
+			query: ProductQuery{Types: []string{}},
+			checker: func(details []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Empty(t, details)
+			},
+		},
+		{
+			name:  "zone",
+			query: ProductQuery{Zone: "dummyZone2", MaxSpotPrice: "0.2"},
+			checker: func(details []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Empty(t, details, "the spot prices of the other zones should be left out")
+			},
+		},
+		{
+			name:  "unknown zone",
+			query: ProductQuery{Zone: "dummyZone3"},
+			checker: func(details []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:  "price bounds in the converted currency",
+			query: ProductQuery{Currency: "EUR", MaxPrice: "0.1"},
+			checker: func(details []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, details, 1)
+				assert.Equal(t, "small", details[0].Type)
+				assert.Equal(t, "EUR", details[0].Currency)
+				assert.InDelta(t, 0.01, details[0].OnDemandPrice, 1e-9)
+			},
+		},
+		{
+			name:  "commitment prices for windows",
+			query: ProductQuery{Os: OsWindows, PricingModel: PricingModelCommitment1Year},
+			checker: func(details []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:  "windows prices of a provider with linux prices only",
+			query: ProductQuery{Os: OsWindows},
+			checker: func(details []ProductDetails, err error) {
+				assert.EqualError(t, err, "only linux prices are available for the provider: [dummy]")
+			},
+		},
+		{
+			name:  "invalid filter",
+			query: ProductQuery{Resources: ResourceFilter{MinMem: "x"}},
+			checker: func(details []ProductDetails, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.checker(info.QueryProducts(context.Background(), "dummy", "compute", "dummyRegion", test.query, rates))
+		})
+	}
+
+	_, err := info.QueryProducts(context.Background(), "dummy", "compute", "otherRegion", ProductQuery{}, rates)
+	assert.IsType(t, NotYetAvailableError{}, err)
+}
@@ -0,0 +1,326 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefaultRecommendations is the number of the recommendations returned if not limited otherwise
+	DefaultRecommendations = 5
+
+	// pairCandidates is the number of the cheapest instance types the two node pool combinations are built from
+	pairCandidates = 5
+)
+
+// RecommendationRequest describes the resources of a cluster and the constraints of its node pools
+type RecommendationRequest struct {
+	// SumCpu is the total number of vCPUs the node pools should provide
+	SumCpu float64 `json:"sumCpu"`
+	// SumMem is the total memory (GiB) the node pools should provide
+	SumMem float64 `json:"sumMem"`
+	// MinNodes is the minimum number of the nodes (1 by default)
+	MinNodes int `json:"minNodes"`
+	// MaxNodes is the maximum number of the nodes, unbounded if 0
+	MaxNodes int `json:"maxNodes"`
+	// SpotRatio is the ratio of the nodes running on spot instances between 0 and 1, the spot nodes are rounded down
+	SpotRatio float64 `json:"spotRatio"`
+	// Zones are the zones of the region the nodes run in, every zone if empty
+	Zones []string `json:"zones"`
+	// Includes lists the instance types or families the node pools are chosen from, every product if empty
+	Includes []string `json:"includes"`
+	// Excludes lists the instance types or families left out of the node pools
+	Excludes []string `json:"excludes"`
+	// Limit is the maximum number of the recommendations (DefaultRecommendations if 0)
+	Limit int `json:"limit"`
+}
+
+// validate checks the request and fills the defaults
+func (req *RecommendationRequest) validate() error {
+	if req.SumCpu < 0 || req.SumMem < 0 || (req.SumCpu == 0 && req.SumMem == 0) {
+		return NewInvalidArgumentError("the requested cpu and memory should not be negative, and at least one of them should be positive")
+	}
+	if req.MinNodes < 0 || req.MaxNodes < 0 {
+		return NewInvalidArgumentError("the node counts should not be negative")
+	}
+	if req.MinNodes == 0 {
+		req.MinNodes = 1
+	}
+	if req.MaxNodes > 0 && req.MinNodes > req.MaxNodes {
+		return NewInvalidArgumentError("the minimum node count is above the maximum: [%d] > [%d]", req.MinNodes, req.MaxNodes)
+	}
+	if req.SpotRatio < 0 || req.SpotRatio > 1 {
+		return NewInvalidArgumentError("the spot ratio should be between 0 and 1: [%v]", req.SpotRatio)
+	}
+	if req.Limit < 0 {
+		return NewInvalidArgumentError("the limit should not be negative: [%d]", req.Limit)
+	}
+	if req.Limit == 0 {
+		req.Limit = DefaultRecommendations
+	}
+	return nil
+}
+
+// NodePool is a group of nodes of the same instance type
+type NodePool struct {
+	// Type is the instance type of the nodes
+	Type string `json:"type"`
+	// Cpus is the number of vCPUs of a node
+	Cpus float64 `json:"cpusPerNode"`
+	// Mem is the memory of a node in GiB
+	Mem float64 `json:"memPerNode"`
+	// OnDemandNodes is the number of the on demand nodes
+	OnDemandNodes int `json:"onDemandNodes"`
+	// SpotNodes is the number of the spot nodes
+	SpotNodes int `json:"spotNodes"`
+	// OnDemandPrice is the hourly on demand price of a node
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// SpotPrice is the hourly spot price of a node, the mean of the zones with a spot price
+	SpotPrice float64 `json:"spotPrice"`
+	// SpotZones lists the zones the spot price is given for
+	SpotZones []string `json:"spotZones,omitempty"`
+}
+
+// nodes returns the number of the nodes in the pool
+func (np NodePool) nodes() int {
+	return np.OnDemandNodes + np.SpotNodes
+}
+
+// Recommendation is a combination of node pools providing the requested resources
+type Recommendation struct {
+	// NodePools are the node pools of the recommendation
+	NodePools []NodePool `json:"nodePools"`
+	// Nodes is the number of the nodes in the node pools
+	Nodes int `json:"nodes"`
+	// Cpu is the total number of vCPUs of the nodes
+	Cpu float64 `json:"cpu"`
+	// Mem is the total memory of the nodes in GiB
+	Mem float64 `json:"mem"`
+	// OnDemandCost is the hourly cost of the on demand nodes
+	OnDemandCost float64 `json:"onDemandCost"`
+	// SpotCost is the hourly cost of the spot nodes
+	SpotCost float64 `json:"spotCost"`
+	// Cost is the total hourly cost of the nodes
+	Cost float64 `json:"cost"`
+	// Reasons explain why the instance types were chosen
+	Reasons []string `json:"reasons"`
+}
+
+// RejectedType is an instance type left out of the recommendations
+type RejectedType struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// candidate is an instance type the node pools can be built from
+type candidate struct {
+	ProductDetails
+	spotPrice float64
+	spotZones []string
+}
+
+// Recommend returns the cheapest combinations of node pools providing the requested resources, ranked by their hourly
+// cost, and the instance types that were left out with the reason. Every instance type is recommended in a node pool of
+// its own, and the cheapest ones are also combined in pairs sharing the resources to spread the nodes across types
+func Recommend(details []ProductDetails, req RecommendationRequest) ([]Recommendation, []RejectedType, error) {
+	if err := req.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	candidates, rejected := selectCandidates(details, req)
+
+	recommendations := make([]Recommendation, 0)
+	var singles []Recommendation
+	byType := make(map[string]candidate, len(candidates))
+	for _, c := range candidates {
+		byType[c.Type] = c
+		pool, reason := newNodePool(c, req.SumCpu, req.SumMem, req.MinNodes, req.SpotRatio)
+		if req.MaxNodes > 0 && pool.nodes() > req.MaxNodes {
+			rejected = append(rejected, RejectedType{Type: c.Type,
+				Reason: fmt.Sprintf("needs %d nodes, more than the maximum of %d", pool.nodes(), req.MaxNodes)})
+			continue
+		}
+		singles = append(singles, newRecommendation([]NodePool{pool}, reason))
+	}
+	sortRecommendations(singles)
+	recommendations = append(recommendations, singles...)
+
+	// the pairs are built from the cheapest instance types, each type provides half of the resources
+	pairs := singles
+	if len(pairs) > pairCandidates {
+		pairs = pairs[:pairCandidates]
+	}
+	for i := 0; i < len(pairs); i++ {
+		for j := i + 1; j < len(pairs); j++ {
+			c1, c2 := byType[pairs[i].NodePools[0].Type], byType[pairs[j].NodePools[0].Type]
+			minNodes := (req.MinNodes + 1) / 2
+			pool1, reason1 := newNodePool(c1, req.SumCpu/2, req.SumMem/2, minNodes, req.SpotRatio)
+			pool2, reason2 := newNodePool(c2, req.SumCpu/2, req.SumMem/2, req.MinNodes-minNodes, req.SpotRatio)
+			if req.MaxNodes > 0 && pool1.nodes()+pool2.nodes() > req.MaxNodes {
+				continue
+			}
+			recommendations = append(recommendations, newRecommendation([]NodePool{pool1, pool2}, reason1, reason2,
+				fmt.Sprintf("%s and %s share the resources to spread the nodes across instance types", c1.Type, c2.Type)))
+		}
+	}
+
+	sortRecommendations(recommendations)
+	if len(recommendations) > req.Limit {
+		recommendations = recommendations[:req.Limit]
+	}
+	sort.Slice(rejected, func(i, j int) bool {
+		return rejected[i].Type < rejected[j].Type
+	})
+	return recommendations, rejected, nil
+}
+
+// selectCandidates returns the products the node pools can be built from, and the rejected ones with the reason
+func selectCandidates(details []ProductDetails, req RecommendationRequest) ([]candidate, []RejectedType) {
+	var candidates []candidate
+	rejected := make([]RejectedType, 0)
+	for _, d := range details {
+		reject := func(format string, args ...interface{}) {
+			rejected = append(rejected, RejectedType{Type: d.Type, Reason: fmt.Sprintf(format, args...)})
+		}
+		if matchesAny(d, req.Excludes) {
+			reject("excluded")
+			continue
+		}
+		if len(req.Includes) > 0 && !matchesAny(d, req.Includes) {
+			reject("not included")
+			continue
+		}
+		if d.OnDemandPrice <= 0 {
+			reject("no on demand price")
+			continue
+		}
+		if !offeredIn(d, req.Zones) {
+			reject("not available in the requested zones")
+			continue
+		}
+
+		c := candidate{ProductDetails: d}
+		if len(d.SpotInfo) > 0 {
+			prices := make(SpotPriceInfo)
+			for _, zp := range d.SpotInfo {
+				prices[zp.Zone] = zp.Price
+			}
+			aggregate, err := AggregateSpotPrices(prices, req.Zones, SpotStrategyMean, 0)
+			if err != nil {
+				reject("%s", err)
+				continue
+			}
+			if len(aggregate.Zones) == 0 {
+				// the spot prices tell the zones the instance type is available in
+				reject("not available in the requested zones")
+				continue
+			}
+			c.spotPrice = aggregate.Price
+			for _, zp := range aggregate.Zones {
+				c.spotZones = append(c.spotZones, zp.Zone)
+			}
+		}
+		if req.SpotRatio > 0 && c.spotPrice <= 0 {
+			reject("no spot price")
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates, rejected
+}
+
+// matchesAny checks whether the instance type or the family of the product is in the list, case insensitively
+func matchesAny(d ProductDetails, types []string) bool {
+	for _, t := range types {
+		if strings.EqualFold(d.Type, t) || (d.Family != "" && strings.EqualFold(d.Family, t)) {
+			return true
+		}
+	}
+	return false
+}
+
+// newNodePool sizes the node pool of the candidate providing the given resources with at least minNodes nodes, and
+// explains the size of the pool
+func newNodePool(c candidate, cpu, mem float64, minNodes int, spotRatio float64) (NodePool, string) {
+	cpuNodes := int(math.Ceil(cpu / c.Cpus))
+	memNodes := int(math.Ceil(mem / c.Mem))
+
+	nodes, bound := cpuNodes, "cpu"
+	if memNodes > nodes {
+		nodes, bound = memNodes, "memory"
+	}
+	if minNodes > nodes {
+		nodes, bound = minNodes, "the minimum node count"
+	}
+	spotNodes := int(math.Floor(float64(nodes) * spotRatio))
+
+	pool := NodePool{
+		Type:          c.Type,
+		Cpus:          c.Cpus,
+		Mem:           c.Mem,
+		OnDemandNodes: nodes - spotNodes,
+		SpotNodes:     spotNodes,
+		OnDemandPrice: c.OnDemandPrice,
+		SpotPrice:     c.spotPrice,
+		SpotZones:     c.spotZones,
+	}
+	reason := fmt.Sprintf("%d %s nodes (%v vCPUs, %v GiB each) are needed for %v vCPUs and %v GiB, the node count is bound by %s",
+		nodes, c.Type, c.Cpus, c.Mem, cpu, mem, bound)
+	if spotNodes > 0 {
+		reason += fmt.Sprintf("; %d of them run on spot instances at %v per hour instead of %v", spotNodes, c.spotPrice, c.OnDemandPrice)
+	}
+	return pool, reason
+}
+
+// newRecommendation sums up the resources and the costs of the node pools
+func newRecommendation(pools []NodePool, reasons ...string) Recommendation {
+	r := Recommendation{NodePools: pools, Reasons: reasons}
+	for _, p := range pools {
+		r.Nodes += p.nodes()
+		r.Cpu += p.Cpus * float64(p.nodes())
+		r.Mem += p.Mem * float64(p.nodes())
+		r.OnDemandCost += p.OnDemandPrice * float64(p.OnDemandNodes)
+		r.SpotCost += p.SpotPrice * float64(p.SpotNodes)
+	}
+	r.Cost = r.OnDemandCost + r.SpotCost
+	return r
+}
+
+// sortRecommendations ranks the recommendations by their cost, then by their node count and their instance types
+func sortRecommendations(recommendations []Recommendation) {
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		ri, rj := recommendations[i], recommendations[j]
+		if ri.Cost != rj.Cost {
+			return ri.Cost < rj.Cost
+		}
+		if ri.Nodes != rj.Nodes {
+			return ri.Nodes < rj.Nodes
+		}
+		return poolTypes(ri) < poolTypes(rj)
+	})
+}
+
+// poolTypes returns the instance types of the node pools of the recommendation
+func poolTypes(r Recommendation) string {
+	var types []string
+	for _, p := range r.NodePools {
+		types = append(types, p.Type)
+	}
+	return strings.Join(types, ",")
+}
@@ -0,0 +1,131 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecommend(t *testing.T) {
+	details := []ProductDetails{
+		{
+			VmInfo:   VmInfo{Type: "m5.large", Family: "m5", Cpus: 2, Mem: 8, OnDemandPrice: 0.096},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.035}, {Zone: "eu-west-1b", Price: 0.045}},
+		},
+		{
+			VmInfo:   VmInfo{Type: "c5.xlarge", Family: "c5", Cpus: 4, Mem: 8, OnDemandPrice: 0.17},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 0.06}},
+		},
+		{
+			VmInfo:   VmInfo{Type: "x1.32xlarge", Family: "x1", Cpus: 128, Mem: 1952, OnDemandPrice: 16.006},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1a", Price: 4.8}},
+		},
+		{
+			VmInfo:   VmInfo{Type: "r5.large", Family: "r5", Cpus: 2, Mem: 16, OnDemandPrice: 0.126},
+			SpotInfo: []ZonePrice{{Zone: "eu-west-1c", Price: 0.04}},
+		},
+		{
+			VmInfo: VmInfo{Type: "t2.micro", Family: "t2", Cpus: 1, Mem: 1, OnDemandPrice: 0.0126},
+		},
+		{
+			VmInfo: VmInfo{Type: "n1-standard-2", Family: "n1", Cpus: 2, Mem: 7.5, OnDemandPrice: 0.095, Zones: []string{"eu-west-1c"}},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		req   RecommendationRequest
+		check func(recommendations []Recommendation, rejected []RejectedType, err error)
+	}{
+		{
+			name: "ranked single and paired node pools",
+			req: RecommendationRequest{SumCpu: 8, SumMem: 16, SpotRatio: 0.5, Zones: []string{"eu-west-1a", "eu-west-1b"},
+				Excludes: []string{"x1"}},
+			check: func(recommendations []Recommendation, rejected []RejectedType, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, recommendations, 3)
+
+				assert.Equal(t, "c5.xlarge", poolTypes(recommendations[0]))
+				assert.Equal(t, 2, recommendations[0].Nodes)
+				assert.Equal(t, 1, recommendations[0].NodePools[0].SpotNodes)
+				assert.InDelta(t, 0.23, recommendations[0].Cost, 1e-9)
+
+				assert.Equal(t, "m5.large", poolTypes(recommendations[1]))
+				assert.Equal(t, 4, recommendations[1].Nodes)
+				assert.InDelta(t, 0.04, recommendations[1].NodePools[0].SpotPrice, 1e-9)
+				assert.InDelta(t, 0.272, recommendations[1].Cost, 1e-9)
+				assert.Equal(t, []string{"eu-west-1a", "eu-west-1b"}, recommendations[1].NodePools[0].SpotZones)
+
+				assert.Equal(t, "c5.xlarge,m5.large", poolTypes(recommendations[2]))
+				assert.Equal(t, 3, recommendations[2].Nodes)
+				assert.InDelta(t, 0.306, recommendations[2].Cost, 1e-9)
+				assert.Len(t, recommendations[2].Reasons, 3)
+
+				assert.Equal(t, []RejectedType{
+					{Type: "n1-standard-2", Reason: "not available in the requested zones"},
+					{Type: "r5.large", Reason: "not available in the requested zones"},
+					{Type: "t2.micro", Reason: "no spot price"},
+					{Type: "x1.32xlarge", Reason: "excluded"},
+				}, rejected)
+			},
+		},
+		{
+			name: "node count limits",
+			req:  RecommendationRequest{SumCpu: 8, SumMem: 16, MinNodes: 3, MaxNodes: 3, Includes: []string{"m5.large", "c5"}},
+			check: func(recommendations []Recommendation, rejected []RejectedType, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Len(t, recommendations, 1)
+				assert.Equal(t, "c5.xlarge", poolTypes(recommendations[0]))
+				assert.Equal(t, 3, recommendations[0].Nodes, "the node count is bound by the minimum")
+				assert.Contains(t, rejected, RejectedType{Type: "m5.large", Reason: "needs 4 nodes, more than the maximum of 3"})
+				assert.Contains(t, rejected, RejectedType{Type: "t2.micro", Reason: "not included"})
+			},
+		},
+		{
+			name: "on demand node pools in the requested zones",
+			req:  RecommendationRequest{SumCpu: 8, SumMem: 16, Zones: []string{"eu-west-1a"}, Includes: []string{"n1", "t2"}},
+			check: func(recommendations []Recommendation, rejected []RejectedType, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Len(t, recommendations, 1) {
+					assert.Equal(t, "t2.micro", poolTypes(recommendations[0]))
+				}
+				assert.Contains(t, rejected, RejectedType{Type: "n1-standard-2", Reason: "not available in the requested zones"})
+			},
+		},
+		{
+			name: "invalid spot ratio",
+			req:  RecommendationRequest{SumCpu: 8, SpotRatio: 2},
+			check: func(recommendations []Recommendation, rejected []RejectedType, err error) {
+				assert.Nil(t, recommendations, "the recommendations should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name: "missing resources",
+			req:  RecommendationRequest{MinNodes: 3},
+			check: func(recommendations []Recommendation, rejected []RejectedType, err error) {
+				assert.Nil(t, recommendations, "the recommendations should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(Recommend(details, test.req))
+		})
+	}
+}
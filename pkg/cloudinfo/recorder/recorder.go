@@ -0,0 +1,319 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package recorder provides an HTTP transport that records the traffic of the provider SDKs into fixture files,
+// and replays the recorded responses so that scrapes can run offline, without credentials
+package recorder
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode tells whether the transport records or replays the HTTP traffic
+type Mode string
+
+const (
+	// ModeRecord forwards the requests and stores the responses as fixtures
+	ModeRecord Mode = "record"
+
+	// ModeReplay serves the responses from the stored fixtures, no request leaves the process
+	ModeReplay Mode = "replay"
+)
+
+// volatileParams are the query / form parameters left out from the fixtures:
+// they change on every request (signatures, nonces, timestamps), hold credentials or only format the response
+var volatileParams = map[string]bool{
+	"AccessKeyId":      true,
+	"Signature":        true,
+	"SignatureNonce":   true,
+	"Timestamp":        true,
+	"StartTime":        true,
+	"EndTime":          true,
+	"X-Amz-Credential": true,
+	"X-Amz-Date":       true,
+	"X-Amz-Signature":  true,
+	"access_token":     true,
+	"alt":              true,
+	"key":              true,
+	"prettyPrint":      true,
+}
+
+// ParseMode converts the string representation into a Mode
+func ParseMode(mode string) (Mode, error) {
+	switch Mode(mode) {
+	case ModeRecord, ModeReplay:
+		return Mode(mode), nil
+	default:
+		return "", fmt.Errorf("unsupported recorder mode: [%s]", mode)
+	}
+}
+
+// Transport is an http.RoundTripper recording / replaying HTTP interactions to / from the fixture directory
+type Transport struct {
+	mode     Mode
+	dir      string
+	next     http.RoundTripper
+	mu       sync.Mutex
+	fixtures map[string]*Fixture
+}
+
+// Fixture is a recorded HTTP interaction, stored as a JSON file
+type Fixture struct {
+	Request  FixtureRequest  `json:"request"`
+	Response FixtureResponse `json:"response"`
+}
+
+// FixtureRequest holds the parts of a request the responses are matched by
+type FixtureRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// FixtureResponse holds a recorded response
+type FixtureResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// NewTransport creates a new recorder transport using the given fixture directory
+// In record mode the requests are sent through next (http.DefaultTransport if nil)
+// In replay mode every fixture of the directory is loaded upfront
+func NewTransport(mode Mode, dir string, next http.RoundTripper) (*Transport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &Transport{
+		mode:     mode,
+		dir:      dir,
+		next:     next,
+		fixtures: make(map[string]*Fixture),
+	}
+
+	switch mode {
+	case ModeRecord:
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, err
+		}
+	case ModeReplay:
+		if err := t.load(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported recorder mode: [%s]", mode)
+	}
+
+	return t, nil
+}
+
+// IsReplaying tells whether the round tripper is a transport replaying fixtures
+// Providers skip authentication in this case as no request reaches the cloud provider
+func IsReplaying(rt http.RoundTripper) bool {
+	t, ok := rt.(*Transport)
+	return ok && t.mode == ModeReplay
+}
+
+// NewHTTPTransport returns an *http.Transport delegating every request to the given round tripper
+// It's meant for SDKs that only accept the concrete transport type
+func NewHTTPTransport(rt http.RoundTripper) *http.Transport {
+	t := &http.Transport{}
+	t.RegisterProtocol("http", rt)
+	t.RegisterProtocol("https", rt)
+	return t
+}
+
+// RoundTrip records or replays the request depending on the mode of the transport
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fr, err := newFixtureRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.mode == ModeReplay {
+		t.mu.Lock()
+		f, ok := t.fixtures[fr.key()]
+		t.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("no fixture recorded for request: %s %s", fr.Method, fr.URL)
+		}
+		return f.Response.toResponse(req), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := http.Header{}
+	for k, v := range resp.Header {
+		if k != "Set-Cookie" {
+			header[k] = v
+		}
+	}
+
+	if err := t.save(&Fixture{
+		Request: *fr,
+		Response: FixtureResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       string(body),
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// load reads the fixtures of the directory and indexes them by their request
+func (t *Transport) load() error {
+	files, err := filepath.Glob(filepath.Join(t.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var f Fixture
+		if err := json.Unmarshal(content, &f); err != nil {
+			return fmt.Errorf("invalid fixture [%s]: %s", file, err)
+		}
+		// hand written fixtures are normalized the same way as the incoming requests
+		f.Request.Body = canonicalBody(f.Request.Body)
+		t.fixtures[f.Request.key()] = &f
+	}
+	return nil
+}
+
+// save writes the fixture into the directory, the file name is derived from the request
+func (t *Transport) save(f *Fixture) error {
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(f.Request.URL)
+	if err != nil {
+		return err
+	}
+	sum := sha1.Sum([]byte(f.Request.key()))
+	name := fmt.Sprintf("%s-%s.json", u.Host, hex.EncodeToString(sum[:])[:12])
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.fixtures[f.Request.key()] = f
+	return ioutil.WriteFile(filepath.Join(t.dir, name), content, 0644)
+}
+
+// newFixtureRequest extracts the matching parts of the request; the body of the request is restored
+func newFixtureRequest(req *http.Request) (*FixtureRequest, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	u := *req.URL
+	u.RawQuery = canonicalQuery(u.Query())
+	u.Fragment = ""
+
+	return &FixtureRequest{
+		Method: req.Method,
+		URL:    u.String(),
+		Body:   canonicalBody(string(body)),
+	}, nil
+}
+
+// key identifies the request among the fixtures
+func (fr *FixtureRequest) key() string {
+	u, err := url.Parse(fr.URL)
+	if err == nil {
+		u.RawQuery = canonicalQuery(u.Query())
+		return fmt.Sprintf("%s %s\n%s", strings.ToUpper(fr.Method), u.String(), fr.Body)
+	}
+	return fmt.Sprintf("%s %s\n%s", strings.ToUpper(fr.Method), fr.URL, fr.Body)
+}
+
+// toResponse builds the http response served to the request
+func (fr *FixtureResponse) toResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	for k, v := range fr.Header {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fr.StatusCode, http.StatusText(fr.StatusCode)),
+		StatusCode:    fr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(fr.Body)),
+		ContentLength: int64(len(fr.Body)),
+		Request:       req,
+	}
+}
+
+// canonicalQuery encodes the query parameters in a stable order, leaving out the volatile ones
+func canonicalQuery(values url.Values) string {
+	for param := range values {
+		if volatileParams[param] {
+			values.Del(param)
+		}
+	}
+	return values.Encode()
+}
+
+// canonicalBody normalizes JSON and form encoded bodies so that semantically equal bodies match
+func canonicalBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	var content interface{}
+	if err := json.Unmarshal([]byte(body), &content); err == nil {
+		if normalized, err := json.Marshal(content); err == nil {
+			return string(normalized)
+		}
+	}
+
+	if values, err := url.ParseQuery(body); err == nil && strings.Contains(body, "=") && !strings.ContainsAny(body, " \n") {
+		return canonicalQuery(values)
+	}
+
+	return body
+}
@@ -0,0 +1,162 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  string
+		check func(mode Mode, err error)
+	}{
+		{
+			name: "record mode",
+			mode: "record",
+			check: func(mode Mode, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, ModeRecord, mode)
+			},
+		},
+		{
+			name: "replay mode",
+			mode: "replay",
+			check: func(mode Mode, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, ModeReplay, mode)
+			},
+		},
+		{
+			name: "unsupported mode",
+			mode: "rewind",
+			check: func(mode Mode, err error) {
+				assert.EqualError(t, err, "unsupported recorder mode: [rewind]")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(ParseMode(test.mode))
+		})
+	}
+}
+
+func TestTransport_RecordReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatalf("failed to create fixture directory; [%s]", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"path":"%s","region":"%s","body":%q}`, r.URL.Path, r.URL.Query().Get("region"), string(body))
+	}))
+
+	recorder, err := NewTransport(ModeRecord, dir, nil)
+	if err != nil {
+		t.Fatalf("failed to create recorder; [%s]", err.Error())
+	}
+	recorded := doRequest(t, recorder, "POST", server.URL+"/products?region=eu-west-1&Signature=abc", `{"b":2,"a":1}`)
+	server.Close()
+	assert.Equal(t, 1, calls)
+
+	replayer, err := NewTransport(ModeReplay, dir, nil)
+	if err != nil {
+		t.Fatalf("failed to create replayer; [%s]", err.Error())
+	}
+	assert.True(t, IsReplaying(replayer))
+	assert.False(t, IsReplaying(recorder))
+
+	tests := []struct {
+		name  string
+		url   string
+		body  string
+		check func(body string, err error)
+	}{
+		{
+			name: "replay the recorded response, volatile parameters and body formatting are ignored",
+			url:  server.URL + "/products?Signature=xyz&region=eu-west-1",
+			body: `{"a": 1, "b": 2}`,
+			check: func(body string, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, recorded, body)
+			},
+		},
+		{
+			name: "no fixture for the request",
+			url:  server.URL + "/products?region=us-east-1",
+			body: `{"a":1,"b":2}`,
+			check: func(body string, err error) {
+				assert.NotNil(t, err, "the error should not be nil")
+				assert.Contains(t, err.Error(), "no fixture recorded for request: POST")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", test.url, strings.NewReader(test.body))
+			resp, err := replayer.RoundTrip(req)
+			if err != nil {
+				test.check("", err)
+				return
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			test.check(string(body), nil)
+		})
+	}
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	replayer := &Transport{mode: ModeReplay, fixtures: map[string]*Fixture{}}
+	fr := FixtureRequest{Method: "GET", URL: "https://example.com/prices?partNumber=B88317"}
+	replayer.fixtures[fr.key()] = &Fixture{
+		Request:  fr,
+		Response: FixtureResponse{StatusCode: http.StatusOK, Body: "replayed"},
+	}
+
+	client := &http.Client{Transport: NewHTTPTransport(replayer)}
+	resp, err := client.Get("https://example.com/prices?partNumber=B88317")
+	if err != nil {
+		t.Fatalf("failed to get response; [%s]", err.Error())
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, "replayed", string(body))
+}
+
+// doRequest sends the request through the transport and returns the body of the response
+func doRequest(t *testing.T, rt http.RoundTripper, method, url, body string) string {
+	req, _ := http.NewRequest(method, url, strings.NewReader(body))
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("failed to send request; [%s]", err.Error())
+	}
+	defer resp.Body.Close()
+	content, _ := ioutil.ReadAll(resp.Body)
+	return string(content)
+}
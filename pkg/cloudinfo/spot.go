@@ -0,0 +1,159 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const (
+	// SpotStrategyMean aggregates the spot prices as the mean of the zones with a spot price
+	SpotStrategyMean = "mean"
+
+	// SpotStrategyMin aggregates the spot prices as the lowest zone price
+	SpotStrategyMin = "min"
+
+	// SpotStrategyMax aggregates the spot prices as the highest zone price
+	SpotStrategyMax = "max"
+
+	// SpotStrategyMedian aggregates the spot prices as the median of the zone prices
+	SpotStrategyMedian = "median"
+
+	// SpotStrategyCheapest aggregates the spot prices as the mean of the N cheapest zones
+	SpotStrategyCheapest = "cheapest"
+)
+
+// SpotStrategies returns the supported spot price aggregation strategies
+func SpotStrategies() []string {
+	return []string{SpotStrategyMean, SpotStrategyMin, SpotStrategyMax, SpotStrategyMedian, SpotStrategyCheapest}
+}
+
+// SpotAggregate is the spot price of an instance type aggregated over the zones of a region
+type SpotAggregate struct {
+	// Strategy is the strategy the zone prices are aggregated with
+	Strategy string `json:"strategy"`
+	// Price is the aggregated hourly spot price, 0 if none of the zones has a spot price
+	Price float64 `json:"price"`
+	// Zones lists the zones that contributed to the aggregated price, ordered by price
+	Zones []ZonePrice `json:"zones"`
+	// MissingZones lists the requested zones without a spot price, they are left out of the aggregation
+	MissingZones []string `json:"missingZones"`
+	// UpdatedAt is the time the spot prices were retrieved from the provider, zero if unknown
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Age is the age of the spot prices in seconds, 0 if unknown
+	Age float64 `json:"ageSeconds"`
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency"`
+}
+
+// AggregateSpotPrices aggregates the spot prices of the given zones with the strategy (mean by default)
+// Zones without a spot price don't contribute to the price, every priced zone is aggregated if no zones are given and
+// a zone given more than once is aggregated once; count is the number of the zones aggregated by the cheapest strategy
+func AggregateSpotPrices(prices SpotPriceInfo, zones []string, strategy string, count int) (SpotAggregate, error) {
+	if strategy == "" {
+		strategy = SpotStrategyMean
+	}
+	if !Contains(SpotStrategies(), strategy) {
+		return SpotAggregate{}, NewInvalidArgumentError("unsupported spot price aggregation strategy: [%s]", strategy)
+	}
+	if strategy == SpotStrategyCheapest && count < 1 {
+		return SpotAggregate{}, NewInvalidArgumentError("the number of the cheapest zones should be positive: [%d]", count)
+	}
+
+	if len(zones) == 0 {
+		for zone := range prices {
+			zones = append(zones, zone)
+		}
+	}
+
+	aggregate := SpotAggregate{Strategy: strategy, Zones: make([]ZonePrice, 0), MissingZones: make([]string, 0)}
+	seen := make(map[string]bool, len(zones))
+	for _, zone := range zones {
+		if seen[zone] {
+			continue
+		}
+		seen[zone] = true
+		if price, ok := prices[zone]; ok {
+			aggregate.Zones = append(aggregate.Zones, ZonePrice{Zone: zone, Price: price})
+		} else {
+			aggregate.MissingZones = append(aggregate.MissingZones, zone)
+		}
+	}
+	sort.Slice(aggregate.Zones, func(i, j int) bool {
+		if aggregate.Zones[i].Price == aggregate.Zones[j].Price {
+			return aggregate.Zones[i].Zone < aggregate.Zones[j].Zone
+		}
+		return aggregate.Zones[i].Price < aggregate.Zones[j].Price
+	})
+
+	n := len(aggregate.Zones)
+	if n == 0 {
+		return aggregate, nil
+	}
+	switch strategy {
+	case SpotStrategyMean:
+		aggregate.Price = meanPrice(aggregate.Zones)
+	case SpotStrategyMin:
+		aggregate.Price = aggregate.Zones[0].Price
+	case SpotStrategyMax:
+		aggregate.Price = aggregate.Zones[n-1].Price
+	case SpotStrategyMedian:
+		aggregate.Price = aggregate.Zones[n/2].Price
+		if n%2 == 0 {
+			aggregate.Price = (aggregate.Zones[n/2-1].Price + aggregate.Zones[n/2].Price) / 2
+		}
+	case SpotStrategyCheapest:
+		// only the cheapest zones contribute, the rest is neither aggregated nor missing
+		if count < n {
+			aggregate.Zones = aggregate.Zones[:count]
+		}
+		aggregate.Price = meanPrice(aggregate.Zones)
+	}
+	return aggregate, nil
+}
+
+// meanPrice returns the mean of the zone prices
+func meanPrice(zonePrices []ZonePrice) float64 {
+	var sum float64
+	for _, zp := range zonePrices {
+		sum += zp.Price
+	}
+	return sum / float64(len(zonePrices))
+}
+
+// ConvertSpotCurrency returns the aggregated spot price converted to the given currency
+// An empty currency leaves the price in the source currency
+func ConvertSpotCurrency(ctx context.Context, aggregate SpotAggregate, currency string, rater ExchangeRater) (SpotAggregate, error) {
+	if currency == "" {
+		return aggregate, nil
+	}
+
+	c := newConverter(ctx, currency, rater)
+	rate, err := c.rate(aggregate.Currency)
+	if err != nil {
+		return SpotAggregate{}, err
+	}
+
+	aggregate.Currency = c.currency
+	aggregate.Price *= rate
+	zones := make([]ZonePrice, len(aggregate.Zones))
+	for i, zp := range aggregate.Zones {
+		zones[i] = ZonePrice{Zone: zp.Zone, Price: zp.Price * rate}
+	}
+	aggregate.Zones = zones
+	return aggregate, nil
+}
@@ -0,0 +1,148 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateSpotPrices(t *testing.T) {
+	prices := SpotPriceInfo{"zone-a": 0.04, "zone-b": 0.01, "zone-c": 0.03, "zone-d": 0.02}
+
+	tests := []struct {
+		name     string
+		zones    []string
+		strategy string
+		count    int
+		check    func(aggregate SpotAggregate, err error)
+	}{
+		{
+			name:     "the mean of the priced zones is the default",
+			zones:    []string{"zone-a", "zone-b", "zone-e"},
+			strategy: "",
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, SpotStrategyMean, aggregate.Strategy)
+				assert.InDelta(t, 0.025, aggregate.Price, 1e-9)
+				assert.Equal(t, []ZonePrice{{Zone: "zone-b", Price: 0.01}, {Zone: "zone-a", Price: 0.04}}, aggregate.Zones)
+				assert.Equal(t, []string{"zone-e"}, aggregate.MissingZones)
+			},
+		},
+		{
+			name:     "duplicated zones are aggregated once",
+			zones:    []string{"zone-a", "zone-a", "zone-b", "zone-e", "zone-e"},
+			strategy: SpotStrategyMedian,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.InDelta(t, 0.025, aggregate.Price, 1e-9)
+				assert.Equal(t, []ZonePrice{{Zone: "zone-b", Price: 0.01}, {Zone: "zone-a", Price: 0.04}}, aggregate.Zones)
+				assert.Equal(t, []string{"zone-e"}, aggregate.MissingZones)
+			},
+		},
+		{
+			name:     "every priced zone is aggregated without zones",
+			strategy: SpotStrategyMax,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, 0.04, aggregate.Price)
+				assert.Len(t, aggregate.Zones, 4)
+				assert.Empty(t, aggregate.MissingZones)
+			},
+		},
+		{
+			name:     "min",
+			zones:    []string{"zone-a", "zone-c"},
+			strategy: SpotStrategyMin,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, 0.03, aggregate.Price)
+			},
+		},
+		{
+			name:     "median of an even number of zones",
+			strategy: SpotStrategyMedian,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.InDelta(t, 0.025, aggregate.Price, 1e-9)
+			},
+		},
+		{
+			name:     "median of an odd number of zones",
+			zones:    []string{"zone-a", "zone-b", "zone-c"},
+			strategy: SpotStrategyMedian,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, 0.03, aggregate.Price)
+			},
+		},
+		{
+			name:     "only the cheapest zones contribute",
+			strategy: SpotStrategyCheapest,
+			count:    2,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.InDelta(t, 0.015, aggregate.Price, 1e-9)
+				assert.Equal(t, []ZonePrice{{Zone: "zone-b", Price: 0.01}, {Zone: "zone-d", Price: 0.02}}, aggregate.Zones)
+			},
+		},
+		{
+			name:     "no priced zones",
+			zones:    []string{"zone-e"},
+			strategy: SpotStrategyMean,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, float64(0), aggregate.Price)
+				assert.Empty(t, aggregate.Zones)
+			},
+		},
+		{
+			name:     "the number of the cheapest zones is missing",
+			strategy: SpotStrategyCheapest,
+			check: func(aggregate SpotAggregate, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:     "unsupported strategy",
+			strategy: "mode",
+			check: func(aggregate SpotAggregate, err error) {
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(AggregateSpotPrices(prices, test.zones, test.strategy, test.count))
+		})
+	}
+}
+
+func TestConvertSpotCurrency(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	aggregate := SpotAggregate{Price: 0.02, Zones: []ZonePrice{{Zone: "zone-a", Price: 0.02}}, Currency: CurrencyUSD}
+
+	converted, err := ConvertSpotCurrency(context.Background(), aggregate, "eur", rates)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, "EUR", converted.Currency)
+	assert.Equal(t, 0.01, converted.Price)
+	assert.Equal(t, []ZonePrice{{Zone: "zone-a", Price: 0.01}}, converted.Zones)
+	assert.Equal(t, 0.02, aggregate.Zones[0].Price, "the source prices should not change")
+
+	_, err = ConvertSpotCurrency(context.Background(), aggregate, "HUF", rates)
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
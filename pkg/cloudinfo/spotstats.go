@@ -0,0 +1,179 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// SpotPricePoint is a spot price in effect from a point in time
+type SpotPricePoint struct {
+	Timestamp time.Time
+	Price     float64
+}
+
+// SpotPriceHistory holds the spot price points of an instance type per zone
+type SpotPriceHistory map[string][]SpotPricePoint
+
+// Add inserts a price point into the history of the zone keeping the points ordered by their timestamps,
+// a point with the timestamp of an already known point replaces it
+func (h SpotPriceHistory) Add(zone string, timestamp time.Time, price float64) {
+	points := h[zone]
+	i := sort.Search(len(points), func(i int) bool { return !points[i].Timestamp.Before(timestamp) })
+	if i < len(points) && points[i].Timestamp.Equal(timestamp) {
+		points[i].Price = price
+		return
+	}
+	points = append(points, SpotPricePoint{})
+	copy(points[i+1:], points[i:])
+	points[i] = SpotPricePoint{Timestamp: timestamp, Price: price}
+	h[zone] = points
+}
+
+// Latest returns the latest spot price of every zone
+func (h SpotPriceHistory) Latest() SpotPriceInfo {
+	latest := make(SpotPriceInfo, len(h))
+	for zone, points := range h {
+		if len(points) > 0 {
+			latest[zone] = points[len(points)-1].Price
+		}
+	}
+	return latest
+}
+
+// Since returns a copy of the history without the points superseded before the given time,
+// the point in effect at the given time is kept
+func (h SpotPriceHistory) Since(from time.Time) SpotPriceHistory {
+	since := make(SpotPriceHistory, len(h))
+	for zone, points := range h {
+		first := 0
+		for i, p := range points {
+			if p.Timestamp.After(from) {
+				break
+			}
+			first = i
+		}
+		since[zone] = append([]SpotPricePoint(nil), points[first:]...)
+	}
+	return since
+}
+
+// Stats returns the statistics of the spot prices per zone between from and to
+func (h SpotPriceHistory) Stats(from, to time.Time) map[string]SpotStats {
+	stats := make(map[string]SpotStats, len(h))
+	for zone, points := range h {
+		if len(points) > 0 {
+			stats[zone] = NewSpotStats(points, from, to)
+		}
+	}
+	return stats
+}
+
+// SpotStats describes the spot prices of an instance type in a zone over the look-back window
+type SpotStats struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	// Volatility is the coefficient of variation (standard deviation / mean) of the prices, 0 for stable prices
+	Volatility float64 `json:"volatility"`
+	// Samples is the number of the price points in effect during the look-back window
+	Samples int `json:"samples"`
+}
+
+// weightedPrice is a spot price weighted by the time it was in effect
+type weightedPrice struct {
+	price  float64
+	weight float64
+}
+
+// NewSpotStats computes the statistics of the price points between from and to, every price is weighted by the time
+// it was in effect: from its timestamp (or from) until the timestamp of the next point (or to).
+// The prices are weighted equally if none of them was in effect for a measurable time
+func NewSpotStats(points []SpotPricePoint, from, to time.Time) SpotStats {
+	sorted := append([]SpotPricePoint(nil), points...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Timestamp.Before(sorted[j].Timestamp) })
+
+	var prices []weightedPrice
+	var total float64
+	for i, p := range sorted {
+		start, end := p.Timestamp, to
+		if start.Before(from) {
+			start = from
+		}
+		if i+1 < len(sorted) && sorted[i+1].Timestamp.Before(end) {
+			end = sorted[i+1].Timestamp
+		}
+		if end.After(start) {
+			weight := end.Sub(start).Seconds()
+			prices = append(prices, weightedPrice{price: p.Price, weight: weight})
+			total += weight
+		}
+	}
+	if total == 0 {
+		prices = prices[:0]
+		for _, p := range sorted {
+			prices = append(prices, weightedPrice{price: p.Price, weight: 1})
+		}
+		total = float64(len(prices))
+	}
+	sort.SliceStable(prices, func(i, j int) bool { return prices[i].price < prices[j].price })
+
+	var sum float64
+	for _, p := range prices {
+		sum += p.price * p.weight
+	}
+	stats := SpotStats{
+		Min:     prices[0].price,
+		Max:     prices[len(prices)-1].price,
+		Mean:    sum / total,
+		P50:     percentile(prices, total, 50),
+		P90:     percentile(prices, total, 90),
+		Samples: len(prices),
+	}
+	if stats.Mean > 0 {
+		var squares float64
+		for _, p := range prices {
+			squares += (p.price - stats.Mean) * (p.price - stats.Mean) * p.weight
+		}
+		stats.Volatility = math.Sqrt(squares/total) / stats.Mean
+	}
+	return stats
+}
+
+// percentile returns the lowest of the prices sorted by value that was in effect for at least p percent of the time
+func percentile(sorted []weightedPrice, total float64, p float64) float64 {
+	var cumulative float64
+	for _, wp := range sorted {
+		cumulative += wp.weight
+		if cumulative >= p/100*total {
+			return wp.price
+		}
+	}
+	return sorted[len(sorted)-1].price
+}
+
+// convert returns the statistics with the prices multiplied by the exchange rate
+func (s SpotStats) convert(rate float64) SpotStats {
+	s.Min *= rate
+	s.Max *= rate
+	s.Mean *= rate
+	s.P50 *= rate
+	s.P90 *= rate
+	return s
+}
@@ -0,0 +1,99 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpotPriceHistory_Latest(t *testing.T) {
+	now := time.Now()
+	history := make(SpotPriceHistory)
+	history.Add("zone-a", now, 0.03)
+	history.Add("zone-a", now.Add(-time.Hour), 0.01)
+	history.Add("zone-b", time.Time{}, 0.02)
+	history.Add("zone-b", time.Time{}, 0.04)
+
+	assert.Equal(t, SpotPriceInfo{"zone-a": 0.03, "zone-b": 0.04}, history.Latest())
+	assert.Len(t, history["zone-b"], 1, "the point with a known timestamp should be replaced")
+}
+
+func TestSpotPriceHistory_Since(t *testing.T) {
+	now := time.Now()
+	history := make(SpotPriceHistory)
+	history.Add("zone-a", now.Add(-3*time.Hour), 0.01)
+	history.Add("zone-a", now.Add(-2*time.Hour), 0.02)
+	history.Add("zone-a", now.Add(-time.Hour), 0.03)
+
+	since := history.Since(now.Add(-90 * time.Minute))
+	assert.Equal(t, []SpotPricePoint{
+		{Timestamp: now.Add(-2 * time.Hour), Price: 0.02},
+		{Timestamp: now.Add(-time.Hour), Price: 0.03},
+	}, since["zone-a"], "the point in effect at the start should be kept")
+
+	since.Add("zone-a", now, 0.04)
+	assert.Len(t, history["zone-a"], 3, "the original history should not change")
+}
+
+func TestNewSpotStats(t *testing.T) {
+	to := time.Now()
+	from := to.Add(-10 * time.Hour)
+	points := []SpotPricePoint{
+		{Timestamp: from.Add(-time.Hour), Price: 0.01},
+		{Timestamp: from.Add(time.Hour), Price: 0.05},
+		{Timestamp: from.Add(2 * time.Hour), Price: 0.02},
+	}
+
+	stats := NewSpotStats(points, from, to)
+	assert.Equal(t, 0.01, stats.Min)
+	assert.Equal(t, 0.05, stats.Max)
+	// 1h at 0.01, 1h at 0.05, 8h at 0.02
+	assert.InDelta(t, 0.022, stats.Mean, 1e-9)
+	assert.Equal(t, 0.02, stats.P50)
+	assert.Equal(t, 0.02, stats.P90)
+	assert.InDelta(t, 0.4454, stats.Volatility, 1e-4)
+	assert.Equal(t, 3, stats.Samples)
+
+	stable := NewSpotStats([]SpotPricePoint{{Timestamp: from, Price: 0.02}}, from, to)
+	assert.Equal(t, SpotStats{Min: 0.02, Max: 0.02, Mean: 0.02, P50: 0.02, P90: 0.02, Samples: 1}, stable)
+
+	superseded := NewSpotStats([]SpotPricePoint{
+		{Timestamp: from.Add(-2 * time.Hour), Price: 0.01},
+		{Timestamp: from.Add(-time.Hour), Price: 0.03},
+	}, from, to)
+	assert.Equal(t, SpotStats{Min: 0.03, Max: 0.03, Mean: 0.03, P50: 0.03, P90: 0.03, Samples: 1}, superseded,
+		"the prices superseded before the window should be ignored")
+
+	instant := NewSpotStats([]SpotPricePoint{{Timestamp: to, Price: 0.02}, {Timestamp: to, Price: 0.04}}, from, to)
+	assert.InDelta(t, 0.03, instant.Mean, 1e-9, "the prices should be weighted equally")
+	assert.Equal(t, 2, instant.Samples)
+}
+
+func TestSpotPriceHistory_Stats(t *testing.T) {
+	to := time.Now()
+	history := make(SpotPriceHistory)
+	history.Add("zone-a", to.Add(-3*time.Hour), 0.02)
+	history.Add("zone-a", to.Add(-time.Hour), 0.05)
+
+	stats := history.Stats(to.Add(-4*time.Hour), to)
+	assert.Len(t, stats, 1)
+	assert.InDelta(t, 0.03, stats["zone-a"].Mean, 1e-9)
+	assert.Equal(t, 0.02, stats["zone-a"].P50)
+	assert.Equal(t, 0.05, stats["zone-a"].P90)
+	assert.Equal(t, 2, stats["zone-a"].Samples)
+}
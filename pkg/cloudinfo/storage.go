@@ -0,0 +1,61 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+)
+
+// StorageInfo describes a block storage volume type and its prices in a region
+type StorageInfo struct {
+	// Type is the provider specific name of the volume type (eg.: gp2, pd-ssd, Premium_LRS, cloud_ssd)
+	Type string `json:"type"`
+	// Tier is the size tier of the volume type, only set if the volumes are billed per size tier (azure managed disks)
+	Tier string `json:"tier,omitempty"`
+	// Category is the media the volumes are backed by (ssd or hdd)
+	Category string `json:"category"`
+	// PricePerGbMonth is the monthly price of a GiB of provisioned capacity; for size tiers the price of the tier spread over its size
+	PricePerGbMonth float64 `json:"pricePerGbMonth"`
+	// PricePerIopsMonth is the monthly price of a provisioned IOPS, 0 if the IOPS are not charged separately
+	PricePerIopsMonth float64 `json:"pricePerIopsMonth"`
+	// MinSize is the minimum size of a volume in GiB, 0 if unknown
+	MinSize float64 `json:"minSize"`
+	// MaxSize is the maximum size of a volume in GiB (the size of the tier for size tiers), 0 if unknown
+	MaxSize float64 `json:"maxSize"`
+	// Currency is the ISO 4217 code of the currency the prices are given in
+	Currency string `json:"currency"`
+}
+
+// ConvertStorageCurrency returns the storage types with the prices converted to the given currency
+// An empty currency leaves the prices in the source currency
+func ConvertStorageCurrency(ctx context.Context, storage []StorageInfo, currency string, rater ExchangeRater) ([]StorageInfo, error) {
+	if currency == "" {
+		return storage, nil
+	}
+
+	c := newConverter(ctx, currency, rater)
+	converted := make([]StorageInfo, 0, len(storage))
+	for _, s := range storage {
+		rate, err := c.rate(s.Currency)
+		if err != nil {
+			return nil, err
+		}
+		s.Currency = c.currency
+		s.PricePerGbMonth *= rate
+		s.PricePerIopsMonth *= rate
+		converted = append(converted, s)
+	}
+	return converted, nil
+}
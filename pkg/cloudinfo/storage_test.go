@@ -0,0 +1,39 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertStorageCurrency(t *testing.T) {
+	rates := &StaticExchangeRates{Base: CurrencyUSD, Rates: map[string]float64{"EUR": 0.5}}
+	storage := []StorageInfo{
+		{Type: "io1", Category: DiskTypeSsd, PricePerGbMonth: 0.125, PricePerIopsMonth: 0.065, Currency: CurrencyUSD},
+	}
+
+	converted, err := ConvertStorageCurrency(context.Background(), storage, "EUR", rates)
+	assert.Nil(t, err, "the error should be nil")
+	assert.Equal(t, []StorageInfo{
+		{Type: "io1", Category: DiskTypeSsd, PricePerGbMonth: 0.0625, PricePerIopsMonth: 0.0325, Currency: "EUR"},
+	}, converted)
+	assert.Equal(t, 0.125, storage[0].PricePerGbMonth, "the source prices should be left untouched")
+
+	_, err = ConvertStorageCurrency(context.Background(), storage, "HUF", rates)
+	assert.IsType(t, InvalidArgumentError{}, err)
+}
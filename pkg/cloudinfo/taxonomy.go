@@ -0,0 +1,120 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CategoryGeneral is the category of the general purpose instance types
+	CategoryGeneral = "general"
+
+	// CategoryCompute is the category of the compute optimized instance types
+	CategoryCompute = "compute"
+
+	// CategoryMemory is the category of the memory optimized instance types
+	CategoryMemory = "memory"
+
+	// CategoryStorage is the category of the storage optimized instance types (local disks)
+	CategoryStorage = "storage"
+
+	// CategoryAccelerated is the category of the instance types with gpus or fpgas
+	CategoryAccelerated = "accelerated"
+
+	// CategoryBurstable is the category of the shared core and burstable instance types
+	CategoryBurstable = "burstable"
+)
+
+// Categories returns the supported instance type categories
+func Categories() []string {
+	return []string{CategoryGeneral, CategoryCompute, CategoryMemory, CategoryStorage, CategoryAccelerated, CategoryBurstable}
+}
+
+// TaxonomyRule classifies the instance types matching its pattern
+// The generation and the size of the instance type are taken from the "generation" and "size" named groups of the pattern
+type TaxonomyRule struct {
+	// Pattern matches the names of the instance types the rule applies to
+	Pattern *regexp.Regexp
+	// Family is the template of the family expanded with the groups of the pattern (see regexp.Expand)
+	Family string
+	// Category is the category of the matching instance types
+	Category string
+}
+
+// NewTaxonomyRule creates a rule of the given category from a pattern and a family template, it panics if the pattern is invalid
+func NewTaxonomyRule(pattern, family, category string) TaxonomyRule {
+	return TaxonomyRule{
+		Pattern:  regexp.MustCompile(pattern),
+		Family:   family,
+		Category: category,
+	}
+}
+
+// Classify fills the category, family, generation and size of the instance type by the first matching rule
+// It returns false and leaves the instance type unclassified if none of the rules match
+func (vm *VmInfo) Classify(rules []TaxonomyRule) bool {
+	for _, rule := range rules {
+		match := rule.Pattern.FindStringSubmatchIndex(vm.Type)
+		if match == nil {
+			continue
+		}
+
+		vm.Category = rule.Category
+		vm.Family = string(rule.Pattern.ExpandString(nil, rule.Family, vm.Type, match))
+		vm.Generation, _ = strconv.Atoi(string(rule.Pattern.ExpandString(nil, "${generation}", vm.Type, match)))
+		vm.Size = string(rule.Pattern.ExpandString(nil, "${size}", vm.Type, match))
+		return true
+	}
+	return false
+}
+
+// SelectTaxonomy returns the product details matching the given category, family, generation and size
+// Empty values match every product, the family and the size are compared case insensitively
+func SelectTaxonomy(details []ProductDetails, category, family, generation, size string) ([]ProductDetails, error) {
+	if category == "" && family == "" && generation == "" && size == "" {
+		return details, nil
+	}
+	if category != "" && !Contains(Categories(), category) {
+		return nil, NewInvalidArgumentError("unsupported category: [%s]", category)
+	}
+	gen := 0
+	if generation != "" {
+		var err error
+		if gen, err = strconv.Atoi(generation); err != nil {
+			return nil, NewInvalidArgumentError("invalid generation: [%s]", generation)
+		}
+	}
+
+	selected := make([]ProductDetails, 0, len(details))
+	for _, d := range details {
+		if category != "" && d.Category != category {
+			continue
+		}
+		if family != "" && !strings.EqualFold(d.Family, family) {
+			continue
+		}
+		if generation != "" && d.Generation != gen {
+			continue
+		}
+		if size != "" && !strings.EqualFold(d.Size, size) {
+			continue
+		}
+		selected = append(selected, d)
+	}
+	return selected, nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVmInfo_Classify(t *testing.T) {
+	rules := []TaxonomyRule{
+		NewTaxonomyRule(`^(?P<family>t(?P<generation>[0-9])[a-z]*)\.(?P<size>[a-z0-9]+)$`, "${family}", CategoryBurstable),
+		NewTaxonomyRule(`^(?P<family>m(?P<generation>[0-9])[a-z]*)\.(?P<size>[a-z0-9]+)$`, "${family}", CategoryGeneral),
+		NewTaxonomyRule(`^Standard_(?P<series>[A-Z]+)(?P<size>[0-9]+)[a-z]*$`, "${series}", CategoryGeneral),
+	}
+
+	tests := []struct {
+		name       string
+		vm         VmInfo
+		classified bool
+		expected   VmInfo
+	}{
+		{
+			name:       "the first matching rule classifies the instance type",
+			vm:         VmInfo{Type: "m5d.large", Cpus: 2},
+			classified: true,
+			expected:   VmInfo{Type: "m5d.large", Cpus: 2, Category: CategoryGeneral, Family: "m5d", Generation: 5, Size: "large"},
+		},
+		{
+			name:       "the family is expanded from the template",
+			vm:         VmInfo{Type: "Standard_B1ms"},
+			classified: true,
+			expected:   VmInfo{Type: "Standard_B1ms", Category: CategoryGeneral, Family: "B", Size: "1"},
+		},
+		{
+			name:       "instance types matching no rule are left unclassified",
+			vm:         VmInfo{Type: "x1.16xlarge"},
+			classified: false,
+			expected:   VmInfo{Type: "x1.16xlarge"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vm := test.vm
+			assert.Equal(t, test.classified, vm.Classify(rules))
+			assert.Equal(t, test.expected, vm)
+		})
+	}
+}
+
+func TestSelectTaxonomy(t *testing.T) {
+	details := []ProductDetails{
+		{VmInfo: VmInfo{Type: "m5.large", Category: CategoryGeneral, Family: "m5", Generation: 5, Size: "large"}},
+		{VmInfo: VmInfo{Type: "m4.large", Category: CategoryGeneral, Family: "m4", Generation: 4, Size: "large"}},
+		{VmInfo: VmInfo{Type: "c5.xlarge", Category: CategoryCompute, Family: "c5", Generation: 5, Size: "xlarge"}},
+		{VmInfo: VmInfo{Type: "mac1.metal"}},
+	}
+
+	tests := []struct {
+		name                               string
+		category, family, generation, size string
+		check                              func(selected []ProductDetails, err error)
+	}{
+		{
+			name: "every product is returned without filters",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, details, selected)
+			},
+		},
+		{
+			name:       "products are filtered by category and generation",
+			category:   CategoryGeneral,
+			generation: "5",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(selected)) {
+					assert.Equal(t, "m5.large", selected[0].Type)
+				}
+			},
+		},
+		{
+			name:   "family and size are compared case insensitively",
+			family: "C5",
+			size:   "XLarge",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				if assert.Equal(t, 1, len(selected)) {
+					assert.Equal(t, "c5.xlarge", selected[0].Type)
+				}
+			},
+		},
+		{
+			name:     "unsupported category",
+			category: "quantum",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+		{
+			name:       "invalid generation",
+			generation: "fifth",
+			check: func(selected []ProductDetails, err error) {
+				assert.Nil(t, selected, "the details should be nil")
+				assert.IsType(t, InvalidArgumentError{}, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(SelectTaxonomy(details, test.category, test.family, test.generation, test.size))
+		})
+	}
+}
@@ -26,6 +26,24 @@ const (
 	// Cpu represents the cpu attribute for the product info
 	Cpu = "cpu"
 
+	// NetworkPerfCategory represents the network performance category attribute of the product info
+	NetworkPerfCategory = "NetworkPerfCategory"
+
+	// ArchX86 is the 64 bit x86 cpu architecture
+	ArchX86 = "x86_64"
+
+	// ArchArm64 is the 64 bit arm cpu architecture
+	ArchArm64 = "arm64"
+
+	// DiskTypeSsd represents local ssd disks
+	DiskTypeSsd = "ssd"
+
+	// DiskTypeNvme represents local nvme ssd disks
+	DiskTypeNvme = "nvme"
+
+	// DiskTypeHdd represents local hdd disks
+	DiskTypeHdd = "hdd"
+
 	// VmKeyTemplate format for generating vm cache keys
 	VmKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/vms"
 
@@ -44,11 +62,20 @@ const (
 	// StatusKeyTemplate format for generating status cache keys
 	StatusKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/status/"
 
+	// ShortLivedStatusKeyTemplate format for generating the cache keys of the last renewal of the short lived prices
+	ShortLivedStatusKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/short-lived-status/"
+
 	// ImageKeyTemplate format for generating image cache keys
 	ImageKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/images"
 
 	// VersionKeyTemplate format for generating kubernetes version cache keys
 	VersionKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/versions"
+
+	// StorageKeyTemplate format for generating block storage cache keys
+	StorageKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/storage"
+
+	// NetworkKeyTemplate format for generating network price cache keys
+	NetworkKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/network"
 )
 
 // CloudInfoer lists operations for retrieving cloud provider information
@@ -102,6 +129,12 @@ type CloudInfoer interface {
 
 	// GetServiceAttributes retrieves the attribute values supported by the given service in the given region for the given attribute
 	GetServiceAttributes(region, service, attribute string) (AttrValues, error)
+
+	// GetStorage retrieves the block storage volume types and their prices in the given region
+	GetStorage(ctx context.Context, region string) ([]StorageInfo, error)
+
+	// GetNetworkPrices retrieves the load balancer and data transfer prices in the given region
+	GetNetworkPrices(ctx context.Context, region string) (NetworkPrices, error)
 }
 
 // CloudInfo is the main entry point for retrieving vm type characteristics and pricing information on different cloud providers
@@ -162,12 +195,6 @@ var (
 	NTW_EXTRA = "extra"
 )
 
-// NetworkPerfMapper operations related  to mapping between virtual machines to network performance categories
-type NetworkPerfMapper interface {
-	// MapNetworkPerf gets the network performance category for the given
-	MapNetworkPerf(ntwPerf string) (string, error)
-}
-
 // ProductStorer interface collects the necessary cache operations
 type ProductStorer interface {
 	Get(k string) (interface{}, bool)
@@ -193,7 +220,7 @@ type ProductDetails struct {
 	// Embedded struct!
 	VmInfo
 
-	// Burst this is derived for now
+	// Burst signals whether the instance type is burstable, see BurstInfo for the details
 	Burst bool `json:"burst,omitempty"`
 
 	// ZonePrice holds spot price information per zone
@@ -219,6 +246,9 @@ func newProductDetails(vm VmInfo) *ProductDetails {
 type ServiceDescriber interface {
 	// ServiceName abstracts the name assembly for the service
 	ServiceName() string
+
+	// ControlPlane returns the fee of the managed kubernetes control plane, nil if the service has no control plane
+	ControlPlane() *ControlPlaneFee
 }
 
 // ImageDescriber is a placeholder interface for image information
@@ -231,7 +261,8 @@ type ImageDescriber interface {
 // Service represents a service supported by a given provider.
 // it's intended to implement the ServiceDescriber interface
 type Service struct {
-	Service string `json:"service"`
+	Service         string           `json:"service"`
+	ControlPlaneFee *ControlPlaneFee `json:"controlPlaneFee,omitempty"`
 }
 
 // ServiceName returns the service name
@@ -239,11 +270,70 @@ func (s Service) ServiceName() string {
 	return s.Service
 }
 
+// ControlPlane returns the fee of the managed kubernetes control plane of the service
+func (s Service) ControlPlane() *ControlPlaneFee {
+	return s.ControlPlaneFee
+}
+
 // NewService creates a new servicedescriptor struct
 func NewService(name string) Service {
 	return Service{Service: name}
 }
 
+// NewKubernetesService creates a new servicedescriptor struct for a managed kubernetes service
+func NewKubernetesService(name string, fee ControlPlaneFee) Service {
+	return Service{Service: name, ControlPlaneFee: &fee}
+}
+
+// ServiceOf returns the service struct of a service descriptor
+func ServiceOf(sd ServiceDescriber) Service {
+	return Service{Service: sd.ServiceName(), ControlPlaneFee: sd.ControlPlane()}
+}
+
+const (
+	// ControlPlaneFree is the model of the control planes provided free of charge
+	ControlPlaneFree = "free"
+	// ControlPlaneHourly is the model of the control planes charged per cluster per hour
+	ControlPlaneHourly = "hourly"
+	// ControlPlaneFreeTier is the model of the control planes charged per cluster per hour above a number of free clusters
+	ControlPlaneFreeTier = "free-tier"
+)
+
+// ControlPlaneFee describes how the managed control plane of a kubernetes cluster is billed
+type ControlPlaneFee struct {
+	// Model is the billing model of the control plane: free, hourly or free-tier
+	Model string `json:"model"`
+	// PricePerHour is the hourly price of the control plane of a cluster, 0 for free control planes
+	PricePerHour float64 `json:"pricePerHour"`
+	// FreeClusters is the number of clusters per billing account whose control plane is not charged (free-tier only)
+	FreeClusters int `json:"freeClusters,omitempty"`
+	// Currency is the ISO 4217 code of the currency the price is given in
+	Currency string `json:"currency"`
+}
+
+// NewFreeControlPlane creates the fee of a control plane provided free of charge
+func NewFreeControlPlane() ControlPlaneFee {
+	return ControlPlaneFee{Model: ControlPlaneFree, Currency: CurrencyUSD}
+}
+
+// ConvertControlPlaneCurrency returns the control plane fee converted to the given currency
+// An empty currency or a nil fee is returned as it is
+func ConvertControlPlaneCurrency(ctx context.Context, fee *ControlPlaneFee, currency string, rater ExchangeRater) (*ControlPlaneFee, error) {
+	if currency == "" || fee == nil {
+		return fee, nil
+	}
+
+	c := newConverter(ctx, currency, rater)
+	rate, err := c.rate(fee.Currency)
+	if err != nil {
+		return nil, err
+	}
+	converted := *fee
+	converted.PricePerHour *= rate
+	converted.Currency = c.currency
+	return &converted, nil
+}
+
 // ProviderDescriber describes a provider
 type ProviderDescriber interface {
 	// ProviderName returns the name of the provider
@@ -0,0 +1,167 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+const (
+	// UnitVcpu is the unit of the cpu of the products: virtual cpus (hardware threads), not physical cores
+	UnitVcpu = "vCPU"
+
+	// UnitGiB is the unit of the memory, the gpu memory and the local disk size of the products
+	UnitGiB = "GiB"
+
+	// UnitGbps is the unit of the network bandwidth of the products
+	UnitGbps = "Gbps"
+
+	// UnitHour is the billing period of the product prices, every price is given per hour
+	UnitHour = "hour"
+
+	// MaxMemPerCpu is the highest memory (GiB) per vCPU a product is accepted with, a higher ratio signals that the
+	// memory is given in a different unit (eg.: MiB)
+	MaxMemPerCpu = 128
+)
+
+// ResourceUnits describes the units the resources and the prices of the products are given in
+type ResourceUnits struct {
+	Cpu       string `json:"cpu"`
+	Memory    string `json:"memory"`
+	GpuMemory string `json:"gpuMemory"`
+	LocalDisk string `json:"localDisk"`
+	Bandwidth string `json:"bandwidth"`
+	Price     string `json:"price"`
+}
+
+// Units returns the units of the products, the providers convert their values to these units
+func Units() ResourceUnits {
+	return ResourceUnits{
+		Cpu:       UnitVcpu,
+		Memory:    UnitGiB,
+		GpuMemory: UnitGiB,
+		LocalDisk: UnitGiB,
+		Bandwidth: UnitGbps,
+		Price:     UnitHour,
+	}
+}
+
+// AttrUnit returns the unit of the values of an attribute, empty for unknown attributes
+func AttrUnit(attribute string) string {
+	switch attribute {
+	case Cpu:
+		return UnitVcpu
+	case Memory:
+		return UnitGiB
+	}
+	return ""
+}
+
+// Validate checks that the resources and the prices of the product are given in the units of the unit model
+func (vm VmInfo) Validate() error {
+	if vm.Cpus <= 0 {
+		return fmt.Errorf("the cpu of [%s] should be a positive number of vCPUs: %v", vm.Type, vm.Cpus)
+	}
+	if vm.Mem <= 0 {
+		return fmt.Errorf("the memory of [%s] should be positive: %v", vm.Type, vm.Mem)
+	}
+	if vm.Mem/vm.Cpus > MaxMemPerCpu {
+		return fmt.Errorf("the memory of [%s] should be given in GiB: %v GiB for %v vCPUs", vm.Type, vm.Mem, vm.Cpus)
+	}
+	if vm.CpuCores > vm.Cpus {
+		return fmt.Errorf("the physical cores of [%s] should not outnumber its vCPUs: %v", vm.Type, vm.CpuCores)
+	}
+	if vm.Gpus < 0 || vm.GpuMem < 0 || vm.LocalDiskSize < 0 || vm.Bandwidth < 0 {
+		return fmt.Errorf("the resources of [%s] should not be negative", vm.Type)
+	}
+	if vm.OnDemandPrice < 0 {
+		return fmt.Errorf("the hourly price of [%s] should not be negative: %v", vm.Type, vm.OnDemandPrice)
+	}
+	for zone, price := range vm.SpotPrice {
+		if price < 0 {
+			return fmt.Errorf("the hourly spot price of [%s] in [%s] should not be negative: %v", vm.Type, zone, price)
+		}
+	}
+	return nil
+}
+
+var (
+	quantityPattern = regexp.MustCompile(`^([+-]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][+-]?[0-9]+)?)([a-zA-Z]*)$`)
+
+	// quantitySuffixes holds the multipliers of the Kubernetes quantity suffixes
+	quantitySuffixes = map[string]float64{
+		"":   1,
+		"m":  1e-3,
+		"k":  1e3,
+		"M":  1e6,
+		"G":  1e9,
+		"T":  1e12,
+		"P":  1e15,
+		"E":  1e18,
+		"Ki": math.Exp2(10),
+		"Mi": math.Exp2(20),
+		"Gi": math.Exp2(30),
+		"Ti": math.Exp2(40),
+		"Pi": math.Exp2(50),
+		"Ei": math.Exp2(60),
+	}
+)
+
+// ParseQuantity parses a Kubernetes style quantity (eg.: 500m, 2, 4Gi, 1.5G) into its value, the suffix of the quantity
+// is reported back so that the caller can tell plain numbers apart
+func ParseQuantity(quantity string) (float64, string, error) {
+	match := quantityPattern.FindStringSubmatch(quantity)
+	if match == nil {
+		return 0, "", fmt.Errorf("invalid quantity: [%s]", quantity)
+	}
+	multiplier, ok := quantitySuffixes[match[2]]
+	if !ok {
+		return 0, "", fmt.Errorf("invalid quantity suffix: [%s]", quantity)
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid quantity: [%s]", quantity)
+	}
+	return value * multiplier, match[2], nil
+}
+
+// ParseResource parses the quantity of a resource into the unit of the resource (vCPU or GiB, see AttrUnit)
+// Memory quantities with a suffix are given in bytes as in Kubernetes (eg.: 512Mi is 0.5 GiB), plain numbers in GiB
+func ParseResource(attribute, quantity string) (float64, error) {
+	value, suffix, err := ParseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+	if attribute == Memory && suffix != "" {
+		value = value / math.Exp2(30)
+	}
+	return value, nil
+}
+
+// validAttrValues returns the positive attribute values with their string value formatted from the value in the unit
+// of the attribute; the providers may describe the values in their own format (eg.: "3.75 GiB")
+func validAttrValues(values AttrValues) AttrValues {
+	valid := make(AttrValues, 0, len(values))
+	for _, v := range values {
+		if v.Value <= 0 {
+			continue
+		}
+		valid = append(valid, AttrValue{Value: v.Value, StrValue: fmt.Sprint(v.Value)})
+	}
+	return valid
+}
@@ -0,0 +1,92 @@
+// Copyright © 2018 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVmInfo_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		vm    VmInfo
+		valid bool
+	}{
+		{name: "valid product", vm: VmInfo{Type: "m5.large", Cpus: 2, Mem: 8, OnDemandPrice: 0.096}, valid: true},
+		{name: "physical cores", vm: VmInfo{Type: "VM.Standard2.1", Cpus: 2, CpuCores: 1, Mem: 15}, valid: true},
+		{name: "high memory product", vm: VmInfo{Type: "x1e.xlarge", Cpus: 4, Mem: 122}, valid: true},
+		{name: "missing cpu", vm: VmInfo{Type: "m5.large", Mem: 8}},
+		{name: "missing memory", vm: VmInfo{Type: "m5.large", Cpus: 2}},
+		{name: "memory in MiB", vm: VmInfo{Type: "n1-standard-1", Cpus: 1, Mem: 3840}},
+		{name: "more cores than vCPUs", vm: VmInfo{Type: "VM.Standard2.1", Cpus: 1, CpuCores: 2, Mem: 15}},
+		{name: "negative bandwidth", vm: VmInfo{Type: "m5.large", Cpus: 2, Mem: 8, Bandwidth: -1}},
+		{name: "negative price", vm: VmInfo{Type: "m5.large", Cpus: 2, Mem: 8, OnDemandPrice: -0.1}},
+		{name: "negative spot price", vm: VmInfo{Type: "m5.large", Cpus: 2, Mem: 8, SpotPrice: SpotPriceInfo{"eu-west-1a": -0.1}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.vm.Validate()
+			if test.valid {
+				assert.Nil(t, err, "the error should be nil")
+			} else {
+				assert.NotNil(t, err, "the error should not be nil")
+			}
+		})
+	}
+}
+
+func TestAttrUnit(t *testing.T) {
+	assert.Equal(t, UnitVcpu, AttrUnit(Cpu))
+	assert.Equal(t, UnitGiB, AttrUnit(Memory))
+	assert.Equal(t, "", AttrUnit("gpu"))
+}
+
+func TestValidAttrValues(t *testing.T) {
+	values := validAttrValues(AttrValues{{Value: 3.75, StrValue: "3.75 GiB"}, {Value: 0, StrValue: "NA"}, {Value: 16, StrValue: "16"}})
+
+	assert.Equal(t, AttrValues{{Value: 3.75, StrValue: "3.75"}, {Value: 16, StrValue: "16"}}, values)
+}
+
+func TestParseResource(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		quantity  string
+		value     float64
+		valid     bool
+	}{
+		{name: "plain cpu", attribute: Cpu, quantity: "4", value: 4, valid: true},
+		{name: "milli cpu", attribute: Cpu, quantity: "500m", value: 0.5, valid: true},
+		{name: "plain memory in GiB", attribute: Memory, quantity: "3.75", value: 3.75, valid: true},
+		{name: "binary memory", attribute: Memory, quantity: "512Mi", value: 0.5, valid: true},
+		{name: "decimal memory", attribute: Memory, quantity: "2G", value: 2e9 / (1 << 30), valid: true},
+		{name: "exponent", attribute: Cpu, quantity: "1e1", value: 10, valid: true},
+		{name: "unknown suffix", attribute: Memory, quantity: "4GB"},
+		{name: "not a number", attribute: Cpu, quantity: "many"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := ParseResource(test.attribute, test.quantity)
+			if test.valid {
+				assert.Nil(t, err, "the error should be nil")
+				assert.InDelta(t, test.value, value, 1e-9)
+			} else {
+				assert.NotNil(t, err, "the error should not be nil")
+			}
+		})
+	}
+}